@@ -2,6 +2,7 @@ package app
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/ryvasa/go-super-farmer/internal/delivery/scheduler"
 	"github.com/ryvasa/go-super-farmer/pkg/env"
 	"github.com/ryvasa/go-super-farmer/pkg/messages"
 	pb "github.com/ryvasa/go-super-farmer/proto/generated"
@@ -9,11 +10,13 @@ import (
 )
 
 type App struct {
-	Router       *gin.Engine
-	Env          *env.Env
-	DB           *gorm.DB
-	RabbitMQ     messages.RabbitMQ
-	ReportClient pb.ReportServiceClient
+	Router            *gin.Engine
+	Env               *env.Env
+	DB                *gorm.DB
+	RabbitMQ          messages.RabbitMQ
+	ReportClient      pb.ReportServiceClient
+	PriceScheduler    *scheduler.PriceScheduler
+	ForecastEvaluator *scheduler.ForecastEvaluator
 }
 
 func NewApp(
@@ -22,12 +25,16 @@ func NewApp(
 	db *gorm.DB,
 	rabbitMQ messages.RabbitMQ,
 	reportClient pb.ReportServiceClient,
+	priceScheduler *scheduler.PriceScheduler,
+	forecastEvaluator *scheduler.ForecastEvaluator,
 ) *App {
 	return &App{
-		Router:       router,
-		Env:          env,
-		DB:           db,
-		RabbitMQ:     rabbitMQ,
-		ReportClient: reportClient,
+		Router:            router,
+		Env:               env,
+		DB:                db,
+		RabbitMQ:          rabbitMQ,
+		ReportClient:      reportClient,
+		PriceScheduler:    priceScheduler,
+		ForecastEvaluator: forecastEvaluator,
 	}
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"os"
 	"os/signal"
 	"syscall"
@@ -32,6 +33,11 @@ func main() {
 
 	logrus.Log.Infof("API service started on port %s", app.Env.Server.Port)
 
+	// Start the price scheduler and the forecast evaluator
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	go app.PriceScheduler.Run(schedulerCtx)
+	go app.ForecastEvaluator.Run(schedulerCtx)
+
 	// Graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -40,6 +46,7 @@ func main() {
 	logrus.Log.Info("Shutting down server...")
 
 	// Cleanup
+	stopScheduler()
 	if app.RabbitMQ != nil {
 		app.RabbitMQ.Close()
 	}
@@ -17,6 +17,15 @@ type Handlers struct {
 	HarvestHandler       handler_interface.HarvestHandler
 	SaleHandler          handler_interface.SaleHandler
 	ForecastsHandler     handler_interface.ForecastsHandler
+	APIKeyHandler        handler_interface.APIKeyHandler
+	PolicyHandler        handler_interface.PolicyHandler
+	OfficerHandler       handler_interface.OfficerHandler
+	AnalystHandler       handler_interface.AnalystHandler
+	PriceAlertHandler    handler_interface.PriceAlertHandler
+	UnitHandler          handler_interface.UnitHandler
+	CurrencyHandler      handler_interface.CurrencyHandler
+	PriceIndexHandler    handler_interface.PriceIndexHandler
+	PriceStreamHandler   handler_interface.PriceStreamHandler
 }
 
 func NewHandlers(
@@ -32,6 +41,15 @@ func NewHandlers(
 	harvestHandler handler_interface.HarvestHandler,
 	saleHandler handler_interface.SaleHandler,
 	forecastsHandler handler_interface.ForecastsHandler,
+	apiKeyHandler handler_interface.APIKeyHandler,
+	policyHandler handler_interface.PolicyHandler,
+	officerHandler handler_interface.OfficerHandler,
+	analystHandler handler_interface.AnalystHandler,
+	priceAlertHandler handler_interface.PriceAlertHandler,
+	unitHandler handler_interface.UnitHandler,
+	currencyHandler handler_interface.CurrencyHandler,
+	priceIndexHandler handler_interface.PriceIndexHandler,
+	priceStreamHandler handler_interface.PriceStreamHandler,
 ) *Handlers {
 	return &Handlers{
 		RoleHandler:          roleHandler,
@@ -48,5 +66,14 @@ func NewHandlers(
 		HarvestHandler:       harvestHandler,
 		SaleHandler:          saleHandler,
 		ForecastsHandler:     forecastsHandler,
+		APIKeyHandler:        apiKeyHandler,
+		PolicyHandler:        policyHandler,
+		OfficerHandler:       officerHandler,
+		AnalystHandler:       analystHandler,
+		PriceAlertHandler:    priceAlertHandler,
+		UnitHandler:          unitHandler,
+		CurrencyHandler:      currencyHandler,
+		PriceIndexHandler:    priceIndexHandler,
+		PriceStreamHandler:   priceStreamHandler,
 	}
 }
@@ -0,0 +1,47 @@
+package handler_implementation
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+type AnalystHandlerImpl struct {
+	uc usecase_interface.AnalystUsecase
+}
+
+func NewAnalystHandler(uc usecase_interface.AnalystUsecase) handler_interface.AnalystHandler {
+	return &AnalystHandlerImpl{uc}
+}
+
+func (h *AnalystHandlerImpl) GetMarketOverview(c *gin.Context) {
+	commodityID, err := uuid.Parse(c.Param("commodity_id"))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	overview, err := h.uc.GetMarketOverview(c, commodityID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, overview)
+}
+
+func (h *AnalystHandlerImpl) GetPriceComparison(c *gin.Context) {
+	commodityID, err := uuid.Parse(c.Param("commodity_id"))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	comparison, err := h.uc.GetPriceComparison(c, commodityID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, comparison)
+}
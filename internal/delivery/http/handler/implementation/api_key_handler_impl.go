@@ -0,0 +1,76 @@
+package handler_implementation
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+type APIKeyHandlerImpl struct {
+	uc       usecase_interface.APIKeyUsecase
+	authUtil utils.AuthUtil
+}
+
+func NewAPIKeyHandler(uc usecase_interface.APIKeyUsecase, authUtil utils.AuthUtil) handler_interface.APIKeyHandler {
+	return &APIKeyHandlerImpl{uc, authUtil}
+}
+
+func (h *APIKeyHandlerImpl) CreateAPIKey(c *gin.Context) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	var req dto.APIKeyCreateDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	apiKey, err := h.uc.CreateAPIKey(c, userID, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusCreated, apiKey)
+}
+
+func (h *APIKeyHandlerImpl) GetAPIKeys(c *gin.Context) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	apiKeys, err := h.uc.GetAPIKeys(c, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, apiKeys)
+}
+
+func (h *APIKeyHandlerImpl) RevokeAPIKey(c *gin.Context) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	err = h.uc.RevokeAPIKey(c, userID, id)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, nil)
+}
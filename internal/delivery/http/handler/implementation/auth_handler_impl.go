@@ -1,6 +1,8 @@
 package handler_implementation
 
 import (
+	"errors"
+	"io"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -11,11 +13,12 @@ import (
 )
 
 type AuthHandlerImpl struct {
-	uc usecase_interface.AuthUsecase
+	uc       usecase_interface.AuthUsecase
+	authUtil utils.AuthUtil
 }
 
-func NewAuthHandler(uc usecase_interface.AuthUsecase) handler_interface.AuthHandler {
-	return &AuthHandlerImpl{uc}
+func NewAuthHandler(uc usecase_interface.AuthUsecase, authUtil utils.AuthUtil) handler_interface.AuthHandler {
+	return &AuthHandlerImpl{uc, authUtil}
 }
 
 func (h *AuthHandlerImpl) Login(c *gin.Context) {
@@ -24,6 +27,7 @@ func (h *AuthHandlerImpl) Login(c *gin.Context) {
 		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
 		return
 	}
+	req.IP = c.ClientIP()
 	auth, err := h.uc.Login(c, &req)
 	if err != nil {
 		utils.ErrorResponse(c, err)
@@ -52,6 +56,7 @@ func (h *AuthHandlerImpl) VerifyOTP(c *gin.Context) {
 		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
 		return
 	}
+	req.IP = c.ClientIP()
 	err := h.uc.VerifyOTP(c, &req)
 	if err != nil {
 		utils.ErrorResponse(c, err)
@@ -59,3 +64,153 @@ func (h *AuthHandlerImpl) VerifyOTP(c *gin.Context) {
 	}
 	utils.SuccessResponse(c, http.StatusOK, nil)
 }
+
+func (h *AuthHandlerImpl) RefreshToken(c *gin.Context) {
+	var req dto.AuthRefreshDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	auth, err := h.uc.RefreshToken(c, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, auth)
+}
+
+func (h *AuthHandlerImpl) Logout(c *gin.Context) {
+	claims, err := h.authUtil.GetAuthClaims(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	// body is optional, logout without it only revokes the access token
+	var req dto.AuthLogoutDTO
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	err = h.uc.Logout(c, claims, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, nil)
+}
+
+func (h *AuthHandlerImpl) UnlockAccount(c *gin.Context) {
+	var req dto.AuthUnlockDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	err := h.uc.UnlockAccount(c, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, nil)
+}
+
+func (h *AuthHandlerImpl) ForgotPassword(c *gin.Context) {
+	var req dto.AuthForgotPasswordDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	err := h.uc.ForgotPassword(c, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, nil)
+}
+
+func (h *AuthHandlerImpl) ResetPassword(c *gin.Context) {
+	var req dto.AuthResetPasswordDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	req.IP = c.ClientIP()
+	err := h.uc.ResetPassword(c, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, nil)
+}
+
+func (h *AuthHandlerImpl) VerifyMFA(c *gin.Context) {
+	var req dto.AuthMFAVerifyDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	req.IP = c.ClientIP()
+	auth, err := h.uc.VerifyMFA(c, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, auth)
+}
+
+func (h *AuthHandlerImpl) SetupTOTP(c *gin.Context) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	setup, err := h.uc.SetupTOTP(c, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, setup)
+}
+
+func (h *AuthHandlerImpl) EnableTOTP(c *gin.Context) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	var req dto.AuthTOTPCodeDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	codes, err := h.uc.EnableTOTP(c, userID, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, codes)
+}
+
+func (h *AuthHandlerImpl) DisableTOTP(c *gin.Context) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	role, err := h.authUtil.GetAuthRole(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	var req dto.AuthTOTPCodeDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	err = h.uc.DisableTOTP(c, userID, role, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, nil)
+}
@@ -0,0 +1,65 @@
+package handler_implementation
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+type CurrencyHandlerImpl struct {
+	uc usecase_interface.CurrencyUsecase
+}
+
+func NewCurrencyHandler(uc usecase_interface.CurrencyUsecase) handler_interface.CurrencyHandler {
+	return &CurrencyHandlerImpl{uc}
+}
+
+func (h *CurrencyHandlerImpl) GetCurrencies(c *gin.Context) {
+	currencies, err := h.uc.GetCurrencies(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, currencies)
+}
+
+func (h *CurrencyHandlerImpl) CreateCurrency(c *gin.Context) {
+	var req dto.CurrencyCreateDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	currency, err := h.uc.CreateCurrency(c, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusCreated, currency)
+}
+
+func (h *CurrencyHandlerImpl) GetExchangeRates(c *gin.Context) {
+	rates, err := h.uc.GetExchangeRates(c, c.Param("code"))
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, rates)
+}
+
+func (h *CurrencyHandlerImpl) CreateExchangeRate(c *gin.Context) {
+	var req dto.ExchangeRateCreateDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	rate, err := h.uc.CreateExchangeRate(c, c.Param("code"), &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusCreated, rate)
+}
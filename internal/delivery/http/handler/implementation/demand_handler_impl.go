@@ -13,11 +13,12 @@ import (
 )
 
 type DemandHandlerImpl struct {
-	uc usecase_interface.DemandUsecase
+	uc         usecase_interface.DemandUsecase
+	conversion usecase_interface.ConversionUsecase
 }
 
-func NewDemandHandler(uc usecase_interface.DemandUsecase) handler_interface.DemandHandler {
-	return &DemandHandlerImpl{uc}
+func NewDemandHandler(uc usecase_interface.DemandUsecase, conversion usecase_interface.ConversionUsecase) handler_interface.DemandHandler {
+	return &DemandHandlerImpl{uc, conversion}
 }
 
 func (h *DemandHandlerImpl) CreateDemand(c *gin.Context) {
@@ -40,6 +41,12 @@ func (h *DemandHandlerImpl) GetAllDemands(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertDemands(c, params, demands...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, demands)
 }
 
@@ -55,6 +62,12 @@ func (h *DemandHandlerImpl) GetDemandByID(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertDemands(c, params, demand); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, demand)
 }
 
@@ -69,6 +82,12 @@ func (h *DemandHandlerImpl) GetDemandsByCommodityID(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertDemands(c, params, demands...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, demands)
 }
 
@@ -83,6 +102,12 @@ func (h *DemandHandlerImpl) GetDemandsByCityID(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertDemands(c, params, demands...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, demands)
 }
 
@@ -135,5 +160,11 @@ func (h *DemandHandlerImpl) GetDemandHistoryByCommodityIDAndCityID(c *gin.Contex
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertDemandHistories(c, params, demands...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, demands)
 }
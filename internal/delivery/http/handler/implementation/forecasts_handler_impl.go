@@ -3,21 +3,24 @@ package handler_implementation
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
 	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
 	"github.com/ryvasa/go-super-farmer/pkg/logrus"
 	"github.com/ryvasa/go-super-farmer/utils"
 )
 
 type ForecastsHandlerImpl struct {
-	usecase usecase_interface.ForecastsUsecase
+	usecase  usecase_interface.ForecastsUsecase
+	authUtil utils.AuthUtil
 }
 
-func NewForecastsHandler(usecase usecase_interface.ForecastsUsecase) handler_interface.ForecastsHandler {
-	return &ForecastsHandlerImpl{usecase}
+func NewForecastsHandler(usecase usecase_interface.ForecastsUsecase, authUtil utils.AuthUtil) handler_interface.ForecastsHandler {
+	return &ForecastsHandlerImpl{usecase, authUtil}
 }
 
 func (h *ForecastsHandlerImpl) GetForecastsByCommodityIDAndCityID(c *gin.Context) {
@@ -43,3 +46,160 @@ func (h *ForecastsHandlerImpl) GetForecastsByCommodityIDAndCityID(c *gin.Context
 
 	utils.SuccessResponse(c, http.StatusOK, forecasts)
 }
+
+// CreateForecastJob answers with the pending job right away, its result is polled with GetForecastJob
+func (h *ForecastsHandlerImpl) CreateForecastJob(c *gin.Context) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	var req dto.ForecastJobCreateDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+
+	forecast, err := h.usecase.CreateForecastJob(c, userID, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusAccepted, forecast)
+}
+
+func (h *ForecastsHandlerImpl) GetForecastJob(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+
+	forecast, err := h.usecase.GetForecastJob(c, id)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, forecast)
+}
+
+func (h *ForecastsHandlerImpl) GetForecastHistory(c *gin.Context) {
+	landCommodityID, err := uuid.Parse(c.Param("land_commodity_id"))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+
+	forecasts, err := h.usecase.GetForecastHistory(c, landCommodityID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, forecasts)
+}
+
+func (h *ForecastsHandlerImpl) GetForecastAccuracy(c *gin.Context) {
+	params := &dto.ForecastAccuracyParamsDTO{}
+	if err := getForecastFilter(c, &params.CommodityID, &params.CityID, &params.StartDate, &params.EndDate); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	accuracy, err := h.usecase.GetForecastAccuracy(c, params)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, accuracy)
+}
+
+func (h *ForecastsHandlerImpl) BacktestForecasts(c *gin.Context) {
+	params := &dto.ForecastBacktestParamsDTO{}
+	if err := getForecastFilter(c, &params.CommodityID, &params.CityID, &params.StartDate, &params.EndDate); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	if value := c.Query("horizon_weeks"); value != "" {
+		horizon, err := strconv.Atoi(value)
+		if err != nil {
+			utils.ErrorResponse(c, utils.NewBadRequestError("invalid horizon_weeks"))
+			return
+		}
+		params.HorizonWeeks = horizon
+	}
+
+	backtest, err := h.usecase.BacktestForecasts(c, params)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, backtest)
+}
+
+func (h *ForecastsHandlerImpl) GetForecastsByArea(c *gin.Context) {
+	params := &dto.AreaForecastParamsDTO{}
+	var err error
+	if value := c.Query("commodity_id"); value != "" {
+		if params.CommodityID, err = uuid.Parse(value); err != nil {
+			utils.ErrorResponse(c, utils.NewBadRequestError("invalid commodity_id"))
+			return
+		}
+	}
+	ids := []struct {
+		query string
+		value *int64
+	}{
+		{"city_id", &params.CityID},
+		{"province_id", &params.ProvinceID},
+	}
+	for _, id := range ids {
+		value := c.Query(id.query)
+		if value == "" {
+			continue
+		}
+		if *id.value, err = strconv.ParseInt(value, 10, 64); err != nil {
+			utils.ErrorResponse(c, utils.NewBadRequestError("invalid "+id.query))
+			return
+		}
+	}
+
+	forecast, err := h.usecase.GetForecastsByArea(c, params)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, forecast)
+}
+
+// getForecastFilter reads the commodity_id, city_id, start_date and end_date queries, the missing ones are left zero
+func getForecastFilter(c *gin.Context, commodityID *uuid.UUID, cityID *int64, startDate, endDate *time.Time) error {
+	var err error
+	if value := c.Query("commodity_id"); value != "" {
+		if *commodityID, err = uuid.Parse(value); err != nil {
+			return utils.NewBadRequestError("invalid commodity_id")
+		}
+	}
+	if value := c.Query("city_id"); value != "" {
+		if *cityID, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return utils.NewBadRequestError("invalid city_id")
+		}
+	}
+	dates := []struct {
+		query string
+		value *time.Time
+	}{
+		{"start_date", startDate},
+		{"end_date", endDate},
+	}
+	for _, date := range dates {
+		value := c.Query(date.query)
+		if value == "" {
+			continue
+		}
+		if *date.value, err = time.Parse("2006-01-02", value); err != nil {
+			return utils.NewBadRequestError("invalid " + date.query)
+		}
+	}
+	return nil
+}
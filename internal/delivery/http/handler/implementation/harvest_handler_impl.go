@@ -22,11 +22,12 @@ type HarvestHandlerImpl struct {
 	uc           usecase_interface.HarvestUsecase
 	reportClient pb.ReportServiceClient
 	minioClient  *minio.Client
+	authUtil     utils.AuthUtil
 }
 
 func NewHarvestHandler(uc usecase_interface.HarvestUsecase,
-	reportClient pb.ReportServiceClient, minioClient *minio.Client) handler_interface.HarvestHandler {
-	return &HarvestHandlerImpl{uc, reportClient, minioClient}
+	reportClient pb.ReportServiceClient, minioClient *minio.Client, authUtil utils.AuthUtil) handler_interface.HarvestHandler {
+	return &HarvestHandlerImpl{uc, reportClient, minioClient, authUtil}
 }
 
 func (h *HarvestHandlerImpl) CreateHarvest(c *gin.Context) {
@@ -35,7 +36,17 @@ func (h *HarvestHandlerImpl) CreateHarvest(c *gin.Context) {
 		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
 		return
 	}
-	harvest, err := h.uc.CreateHarvest(c, &req)
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	role, err := h.authUtil.GetAuthRole(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	harvest, err := h.uc.CreateHarvest(c, userID, role, &req)
 	if err != nil {
 		utils.ErrorResponse(c, err)
 		return
@@ -134,7 +145,17 @@ func (h *HarvestHandlerImpl) UpdateHarvest(c *gin.Context) {
 		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
 		return
 	}
-	updatedHarvest, err := h.uc.UpdateHarvest(c, id, &req)
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	role, err := h.authUtil.GetAuthRole(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	updatedHarvest, err := h.uc.UpdateHarvest(c, userID, role, id, &req)
 	if err != nil {
 		utils.ErrorResponse(c, err)
 		return
@@ -149,7 +170,17 @@ func (h *HarvestHandlerImpl) DeleteHarvest(c *gin.Context) {
 		return
 	}
 
-	err = h.uc.DeleteHarvest(c, id)
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	role, err := h.authUtil.GetAuthRole(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	err = h.uc.DeleteHarvest(c, userID, role, id)
 	if err != nil {
 		utils.ErrorResponse(c, err)
 		return
@@ -163,7 +194,17 @@ func (h *HarvestHandlerImpl) RestoreHarvest(c *gin.Context) {
 		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
 		return
 	}
-	restoredHarvest, err := h.uc.RestoreHarvest(c, id)
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	role, err := h.authUtil.GetAuthRole(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	restoredHarvest, err := h.uc.RestoreHarvest(c, userID, role, id)
 	if err != nil {
 		utils.ErrorResponse(c, err)
 		return
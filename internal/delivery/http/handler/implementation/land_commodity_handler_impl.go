@@ -14,11 +14,12 @@ import (
 )
 
 type LandCommodityHandlerImpl struct {
-	uc usecase_interface.LandCommodityUsecase
+	uc       usecase_interface.LandCommodityUsecase
+	authUtil utils.AuthUtil
 }
 
-func NewLandCommodityHandler(uc usecase_interface.LandCommodityUsecase) handler_interface.LandCommodityHandler {
-	return &LandCommodityHandlerImpl{uc}
+func NewLandCommodityHandler(uc usecase_interface.LandCommodityUsecase, authUtil utils.AuthUtil) handler_interface.LandCommodityHandler {
+	return &LandCommodityHandlerImpl{uc, authUtil}
 }
 
 func (h *LandCommodityHandlerImpl) CreateLandCommodity(c *gin.Context) {
@@ -27,7 +28,17 @@ func (h *LandCommodityHandlerImpl) CreateLandCommodity(c *gin.Context) {
 		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
 		return
 	}
-	landCommodity, err := h.uc.CreateLandCommodity(c, &req)
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	role, err := h.authUtil.GetAuthRole(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	landCommodity, err := h.uc.CreateLandCommodity(c, userID, role, &req)
 	if err != nil {
 		utils.ErrorResponse(c, err)
 		return
@@ -99,7 +110,17 @@ func (h *LandCommodityHandlerImpl) UpdateLandCommodity(c *gin.Context) {
 		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
 		return
 	}
-	updatedLandCommodity, err := h.uc.UpdateLandCommodity(c, id, &req)
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	role, err := h.authUtil.GetAuthRole(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	updatedLandCommodity, err := h.uc.UpdateLandCommodity(c, userID, role, id, &req)
 	if err != nil {
 		utils.ErrorResponse(c, err)
 		return
@@ -114,7 +135,17 @@ func (h *LandCommodityHandlerImpl) DeleteLandCommodity(c *gin.Context) {
 		return
 	}
 
-	err = h.uc.DeleteLandCommodity(c, id)
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	role, err := h.authUtil.GetAuthRole(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	err = h.uc.DeleteLandCommodity(c, userID, role, id)
 	if err != nil {
 		utils.ErrorResponse(c, err)
 		return
@@ -128,7 +159,17 @@ func (h *LandCommodityHandlerImpl) RestoreLandCommodity(c *gin.Context) {
 		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
 		return
 	}
-	restoredLandCommodity, err := h.uc.RestoreLandCommodity(c, id)
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	role, err := h.authUtil.GetAuthRole(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	restoredLandCommodity, err := h.uc.RestoreLandCommodity(c, userID, role, id)
 	if err != nil {
 		utils.ErrorResponse(c, err)
 		return
@@ -89,13 +89,18 @@ func (h *LandHandlerImpl) UpdateLand(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	role, err := h.authUtil.GetAuthRole(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
 
 	var req dto.LandUpdateDTO
 	if err := c.ShouldBindJSON(&req); err != nil {
 		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
 		return
 	}
-	updatedLand, err := h.uc.UpdateLand(c, userId, id, &req)
+	updatedLand, err := h.uc.UpdateLand(c, userId, role, id, &req)
 	if err != nil {
 		utils.ErrorResponse(c, err)
 		return
@@ -109,7 +114,17 @@ func (h *LandHandlerImpl) DeleteLand(c *gin.Context) {
 		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
 		return
 	}
-	err = h.uc.DeleteLand(c, id)
+	userId, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	role, err := h.authUtil.GetAuthRole(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	err = h.uc.DeleteLand(c, userId, role, id)
 	if err != nil {
 		utils.ErrorResponse(c, err)
 		return
@@ -123,7 +138,17 @@ func (h *LandHandlerImpl) RestoreLand(c *gin.Context) {
 		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
 		return
 	}
-	restoredLand, err := h.uc.RestoreLand(c, id)
+	userId, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	role, err := h.authUtil.GetAuthRole(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	restoredLand, err := h.uc.RestoreLand(c, userId, role, id)
 	if err != nil {
 		utils.ErrorResponse(c, err)
 		return
@@ -0,0 +1,54 @@
+package handler_implementation
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+type OfficerHandlerImpl struct {
+	uc       usecase_interface.OfficerUsecase
+	authUtil utils.AuthUtil
+}
+
+func NewOfficerHandler(uc usecase_interface.OfficerUsecase, authUtil utils.AuthUtil) handler_interface.OfficerHandler {
+	return &OfficerHandlerImpl{uc, authUtil}
+}
+
+func (h *OfficerHandlerImpl) AssignProvince(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	var req dto.OfficerProvinceDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	officer, err := h.uc.AssignProvince(c, id, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, officer)
+}
+
+func (h *OfficerHandlerImpl) GetDashboard(c *gin.Context) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	dashboard, err := h.uc.GetDashboard(c, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, dashboard)
+}
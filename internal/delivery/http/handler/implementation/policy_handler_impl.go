@@ -0,0 +1,69 @@
+package handler_implementation
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+type PolicyHandlerImpl struct {
+	uc usecase_interface.PolicyUsecase
+}
+
+func NewPolicyHandler(uc usecase_interface.PolicyUsecase) handler_interface.PolicyHandler {
+	return &PolicyHandlerImpl{uc: uc}
+}
+
+func (h *PolicyHandlerImpl) GetPolicies(c *gin.Context) {
+	policies, err := h.uc.GetPolicies(c, c.Query("subject"))
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, policies)
+}
+
+func (h *PolicyHandlerImpl) CreatePolicy(c *gin.Context) {
+	var req dto.PolicyDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	policy, err := h.uc.CreatePolicy(c, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusCreated, policy)
+}
+
+func (h *PolicyHandlerImpl) UpdatePolicy(c *gin.Context) {
+	var req dto.PolicyUpdateDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	policy, err := h.uc.UpdatePolicy(c, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, policy)
+}
+
+func (h *PolicyHandlerImpl) DeletePolicy(c *gin.Context) {
+	var req dto.PolicyDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	if err := h.uc.DeletePolicy(c, &req); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, gin.H{"message": "Policy deleted successfully"})
+}
@@ -0,0 +1,120 @@
+package handler_implementation
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+type PriceAlertHandlerImpl struct {
+	uc       usecase_interface.PriceAlertUsecase
+	authUtil utils.AuthUtil
+}
+
+func NewPriceAlertHandler(uc usecase_interface.PriceAlertUsecase, authUtil utils.AuthUtil) handler_interface.PriceAlertHandler {
+	return &PriceAlertHandlerImpl{uc, authUtil}
+}
+
+func (h *PriceAlertHandlerImpl) CreatePriceAlert(c *gin.Context) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	var req dto.PriceAlertCreateDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	alert, err := h.uc.CreatePriceAlert(c, userID, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusCreated, alert)
+}
+
+func (h *PriceAlertHandlerImpl) GetPriceAlerts(c *gin.Context) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	alerts, err := h.uc.GetPriceAlerts(c, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, alerts)
+}
+
+func (h *PriceAlertHandlerImpl) GetPriceAlertByID(c *gin.Context) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	alert, err := h.uc.GetPriceAlertByID(c, userID, id)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, alert)
+}
+
+func (h *PriceAlertHandlerImpl) UpdatePriceAlert(c *gin.Context) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	var req dto.PriceAlertUpdateDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	alert, err := h.uc.UpdatePriceAlert(c, userID, id, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, alert)
+}
+
+func (h *PriceAlertHandlerImpl) DeletePriceAlert(c *gin.Context) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	if err := h.uc.DeletePriceAlert(c, userID, id); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, gin.H{"message": "Price alert deleted successfully"})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/minio/minio-go/v7"
 	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
 	"github.com/ryvasa/go-super-farmer/internal/model/dto"
 	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
 	pb "github.com/ryvasa/go-super-farmer/proto/generated"
@@ -20,12 +21,14 @@ import (
 
 type PriceHandlerImpl struct {
 	uc           usecase_interface.PriceUsecase
+	conversion   usecase_interface.ConversionUsecase
 	reportClient pb.ReportServiceClient
 	minioClient  *minio.Client
+	authUtil     utils.AuthUtil
 }
 
-func NewPriceHandler(uc usecase_interface.PriceUsecase, reportClient pb.ReportServiceClient, minioClient *minio.Client) handler_interface.PriceHandler {
-	return &PriceHandlerImpl{uc, reportClient, minioClient}
+func NewPriceHandler(uc usecase_interface.PriceUsecase, conversion usecase_interface.ConversionUsecase, reportClient pb.ReportServiceClient, minioClient *minio.Client, authUtil utils.AuthUtil) handler_interface.PriceHandler {
+	return &PriceHandlerImpl{uc, conversion, reportClient, minioClient, authUtil}
 }
 
 func (h *PriceHandlerImpl) CreatePrice(c *gin.Context) {
@@ -34,6 +37,13 @@ func (h *PriceHandlerImpl) CreatePrice(c *gin.Context) {
 		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
 		return
 	}
+	changedBy, source, err := h.priceChangeAuthor(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	req.ChangedBy = &changedBy
+	req.Source = source
 	createdPrice, err := h.uc.CreatePrice(c, &req)
 	if err != nil {
 		utils.ErrorResponse(c, err)
@@ -53,6 +63,13 @@ func (h *PriceHandlerImpl) GetAllPrices(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		data, _ := prices.Data.([]*domain.Price)
+		if err := h.conversion.ConvertPrices(c, params, data...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, prices)
 }
 
@@ -67,6 +84,12 @@ func (h *PriceHandlerImpl) GetPriceByID(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertPrices(c, params, user); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, user)
 }
 
@@ -82,6 +105,12 @@ func (h *PriceHandlerImpl) GetPricesByCommodityID(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertPrices(c, params, prices...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, prices)
 }
 
@@ -97,6 +126,12 @@ func (h *PriceHandlerImpl) GetPricesByCityID(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertPrices(c, params, prices...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, prices)
 }
 
@@ -111,6 +146,23 @@ func (h *PriceHandlerImpl) UpdatePrice(c *gin.Context) {
 		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
 		return
 	}
+	changedBy, source, err := h.priceChangeAuthor(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	req.ChangedBy = &changedBy
+	req.Source = source
+	// A change with an effective date is kept until then, the schedule is returned instead of the price
+	if req.EffectiveAt != nil {
+		schedule, err := h.uc.SchedulePriceUpdate(c, id, changedBy, &req)
+		if err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+		utils.SuccessResponse(c, http.StatusAccepted, schedule)
+		return
+	}
 	updatedPrice, err := h.uc.UpdatePrice(c, id, &req)
 	if err != nil {
 		utils.ErrorResponse(c, err)
@@ -119,6 +171,22 @@ func (h *PriceHandlerImpl) UpdatePrice(c *gin.Context) {
 	utils.SuccessResponse(c, http.StatusOK, updatedPrice)
 }
 
+// priceChangeAuthor returns the user a price change is recorded for and whether it came through an API key
+func (h *PriceHandlerImpl) priceChangeAuthor(c *gin.Context) (uuid.UUID, string, error) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+	claims, err := h.authUtil.GetAuthClaims(c)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+	if _, ok := claims["api_key_id"]; ok {
+		return userID, domain.PriceSourceAPIKey, nil
+	}
+	return userID, domain.PriceSourceManual, nil
+}
+
 func (h *PriceHandlerImpl) DeletePrice(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
@@ -147,6 +215,162 @@ func (h *PriceHandlerImpl) RestorePrice(c *gin.Context) {
 	utils.SuccessResponse(c, http.StatusOK, restoredPrice)
 }
 
+func (h *PriceHandlerImpl) GetPriceReviews(c *gin.Context) {
+	reviews, err := h.uc.GetPriceReviews(c, c.Query("status"))
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, reviews)
+}
+
+func (h *PriceHandlerImpl) GetPriceReviewByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+
+	review, err := h.uc.GetPriceReviewByID(c, id)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, review)
+}
+
+func (h *PriceHandlerImpl) ApprovePriceReview(c *gin.Context) {
+	h.decidePriceReview(c, h.uc.ApprovePriceReview)
+}
+
+func (h *PriceHandlerImpl) RejectPriceReview(c *gin.Context) {
+	h.decidePriceReview(c, h.uc.RejectPriceReview)
+}
+
+func (h *PriceHandlerImpl) decidePriceReview(c *gin.Context, decide func(ctx context.Context, id uuid.UUID, reviewerID uuid.UUID, req *dto.PriceReviewDecisionDTO) (*domain.PriceReview, error)) {
+	reviewerID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+
+	// The note is optional, so is the body
+	var req dto.PriceReviewDecisionDTO
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+			return
+		}
+	}
+
+	review, err := decide(c, id, reviewerID, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, review)
+}
+
+func (h *PriceHandlerImpl) GetPriceSchedules(c *gin.Context) {
+	schedules, err := h.uc.GetPriceSchedules(c, c.Query("status"))
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, schedules)
+}
+
+func (h *PriceHandlerImpl) GetPriceScheduleByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+
+	schedule, err := h.uc.GetPriceScheduleByID(c, id)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, schedule)
+}
+
+func (h *PriceHandlerImpl) CancelPriceSchedule(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+
+	schedule, err := h.uc.CancelPriceSchedule(c, id)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, schedule)
+}
+
+// priceImportMaxSize bounds the uploaded file, the rows are bounded by the usecase
+const priceImportMaxSize = 5 << 20
+
+// ImportPrices takes a multipart "file" field, ?dry_run=true only returns the report.
+// The report is returned with 422 when a row is invalid since nothing was written then.
+func (h *PriceHandlerImpl) ImportPrices(c *gin.Context) {
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError("dry_run must be a boolean"))
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError("file is required"))
+		return
+	}
+	if fileHeader.Size > priceImportMaxSize {
+		utils.ErrorResponse(c, utils.NewBadRequestError("file is larger than 5MB"))
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	defer file.Close()
+	content, err := io.ReadAll(io.LimitReader(file, priceImportMaxSize))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	report, err := h.uc.ImportPrices(c, &dto.PriceImportDTO{
+		FileName:  fileHeader.Filename,
+		Content:   content,
+		DryRun:    dryRun,
+		ChangedBy: &userID,
+	})
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	if report.Invalid > 0 {
+		c.JSON(http.StatusUnprocessableEntity, utils.NewResponse(http.StatusUnprocessableEntity, false, "failed", report, nil))
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, report)
+}
+
 func (h *PriceHandlerImpl) GetPriceByCommodityIDAndCityID(c *gin.Context) {
 	commodityID, err := uuid.Parse(c.Param("commodity_id"))
 	if err != nil {
@@ -165,6 +389,12 @@ func (h *PriceHandlerImpl) GetPriceByCommodityIDAndCityID(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertPrices(c, params, price); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, price)
 }
 
@@ -185,9 +415,55 @@ func (h *PriceHandlerImpl) GetPricesHistoryByCommodityIDAndCityID(c *gin.Context
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertPriceHistories(c, params, priceHistory...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, priceHistory)
 }
 
+func (h *PriceHandlerImpl) GetPriceHistorySeries(c *gin.Context) {
+	commodityID, err := uuid.Parse(c.Param("commodity_id"))
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError("invalid commodity id"))
+		return
+	}
+	cityID, err := strconv.ParseInt(c.Param("city_id"), 10, 64)
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError("invalid city id"))
+		return
+	}
+
+	params := dto.PriceHistorySeriesParamsDTO{
+		CommodityID: commodityID,
+		CityID:      cityID,
+		Interval:    c.Query("interval"),
+	}
+	if value := c.Query("start_date"); value != "" {
+		params.StartDate, err = time.Parse("2006-01-02", value)
+		if err != nil {
+			utils.ErrorResponse(c, utils.NewBadRequestError("invalid start date"))
+			return
+		}
+	}
+	if value := c.Query("end_date"); value != "" {
+		params.EndDate, err = time.Parse("2006-01-02", value)
+		if err != nil {
+			utils.ErrorResponse(c, utils.NewBadRequestError("invalid end date"))
+			return
+		}
+	}
+
+	series, err := h.uc.GetPriceHistorySeries(c, &params)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, series)
+}
+
 func (h *PriceHandlerImpl) GetReportPricesHistoryByCommodityIDAndCityID(c *gin.Context) {
 	commodityID, err := uuid.Parse(c.Param("commodity_id"))
 	if err != nil {
@@ -0,0 +1,103 @@
+package handler_implementation
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+type PriceIndexHandlerImpl struct {
+	uc usecase_interface.PriceIndexUsecase
+}
+
+func NewPriceIndexHandler(uc usecase_interface.PriceIndexUsecase) handler_interface.PriceIndexHandler {
+	return &PriceIndexHandlerImpl{uc}
+}
+
+func (h *PriceIndexHandlerImpl) GetNationalPriceIndex(c *gin.Context) {
+	params, err := getPriceIndexParams(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	index, err := h.uc.GetPriceIndex(c, params)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, index)
+}
+
+func (h *PriceIndexHandlerImpl) GetProvincePriceIndex(c *gin.Context) {
+	params, err := getPriceIndexParams(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	params.ProvinceID, err = strconv.ParseInt(c.Param("province_id"), 10, 64)
+	if err != nil || params.ProvinceID <= 0 {
+		utils.ErrorResponse(c, utils.NewBadRequestError("invalid province id"))
+		return
+	}
+
+	index, err := h.uc.GetPriceIndex(c, params)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, index)
+}
+
+func (h *PriceIndexHandlerImpl) GetProvincePriceIndices(c *gin.Context) {
+	params, err := getPriceIndexParams(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	indices, err := h.uc.GetProvincePriceIndices(c, params)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, indices)
+}
+
+func getPriceIndexParams(c *gin.Context) (*dto.PriceIndexParamsDTO, error) {
+	commodityID, err := uuid.Parse(c.Param("commodity_id"))
+	if err != nil {
+		return nil, utils.NewBadRequestError("invalid commodity id")
+	}
+
+	params := &dto.PriceIndexParamsDTO{
+		CommodityID: commodityID,
+		Interval:    c.Query("interval"),
+	}
+	dates := []struct {
+		query string
+		value *time.Time
+	}{
+		{"start_date", &params.StartDate},
+		{"end_date", &params.EndDate},
+		{"base_start_date", &params.BaseStartDate},
+		{"base_end_date", &params.BaseEndDate},
+	}
+	for _, date := range dates {
+		value := c.Query(date.query)
+		if value == "" {
+			continue
+		}
+		if *date.value, err = time.Parse("2006-01-02", value); err != nil {
+			return nil, utils.NewBadRequestError("invalid " + date.query)
+		}
+	}
+	return params, nil
+}
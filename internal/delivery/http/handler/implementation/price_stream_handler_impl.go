@@ -0,0 +1,104 @@
+package handler_implementation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/pkg/logrus"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+const (
+	// proxies close idle connections, a comment line every so often keeps the stream open
+	priceStreamHeartbeat = 15 * time.Second
+	// how long the browser waits before reconnecting
+	priceStreamRetry = 3 * time.Second
+)
+
+type PriceStreamHandlerImpl struct {
+	uc usecase_interface.PriceStreamUsecase
+}
+
+func NewPriceStreamHandler(uc usecase_interface.PriceStreamUsecase) handler_interface.PriceStreamHandler {
+	return &PriceStreamHandlerImpl{uc}
+}
+
+func (h *PriceStreamHandlerImpl) StreamPrices(c *gin.Context) {
+	// EventSource sends Last-Event-ID on its own, other clients can use the query
+	params := &dto.PriceStreamParamsDTO{LastEventID: c.GetHeader("Last-Event-ID")}
+	if params.LastEventID == "" {
+		params.LastEventID = c.Query("last_event_id")
+	}
+	if value := c.Query("commodity_id"); value != "" {
+		commodityID, err := uuid.Parse(value)
+		if err != nil {
+			utils.ErrorResponse(c, utils.NewBadRequestError("invalid commodity id"))
+			return
+		}
+		params.CommodityID = commodityID
+	}
+	if value := c.Query("city_id"); value != "" {
+		cityID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || cityID <= 0 {
+			utils.ErrorResponse(c, utils.NewBadRequestError("invalid city id"))
+			return
+		}
+		params.CityID = cityID
+	}
+
+	// The request context is the one cancelled when the client goes away
+	replay, events, err := h.uc.Subscribe(c.Request.Context(), params)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	fmt.Fprintf(c.Writer, "retry: %d\n\n", priceStreamRetry.Milliseconds())
+	for _, event := range replay {
+		writePriceEvent(c.Writer, event)
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(priceStreamHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case event, ok := <-events:
+			// Closed when the client is gone or fell behind, in which case it reconnects and resumes
+			if !ok {
+				return
+			}
+			writePriceEvent(c.Writer, event)
+		case <-heartbeat.C:
+			fmt.Fprint(c.Writer, ": heartbeat\n\n")
+		}
+		c.Writer.Flush()
+	}
+}
+
+func writePriceEvent(w io.Writer, event *dto.PriceEventDTO) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		logrus.Log.Error("failed to encode price event: ", err)
+		return
+	}
+	if event.ID != uuid.Nil {
+		fmt.Fprintf(w, "id: %s\n", event.ID)
+	}
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+}
@@ -7,26 +7,40 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
 	"github.com/ryvasa/go-super-farmer/internal/model/dto"
 	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
 	"github.com/ryvasa/go-super-farmer/utils"
 )
 
 type SaleHandlerImpl struct {
-	uc usecase_interface.SaleUsecase
+	uc         usecase_interface.SaleUsecase
+	conversion usecase_interface.ConversionUsecase
+	authUtil   utils.AuthUtil
 }
 
-func NewSaleHandler(uc usecase_interface.SaleUsecase) handler_interface.SaleHandler {
-	return &SaleHandlerImpl{uc}
+func NewSaleHandler(uc usecase_interface.SaleUsecase, conversion usecase_interface.ConversionUsecase, authUtil utils.AuthUtil) handler_interface.SaleHandler {
+	return &SaleHandlerImpl{uc, conversion, authUtil}
 }
 
 func (h *SaleHandlerImpl) CreateSale(c *gin.Context) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	role, err := h.authUtil.GetAuthRole(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+
 	var req dto.SaleCreateDTO
 	if err := c.ShouldBindJSON(&req); err != nil {
 		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
 		return
 	}
-	sale, err := h.uc.CreateSale(c, &req)
+	sale, err := h.uc.CreateSale(c, userID, role, &req)
 	if err != nil {
 		utils.ErrorResponse(c, err)
 		return
@@ -46,6 +60,13 @@ func (h *SaleHandlerImpl) GetAllSales(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		data, _ := sales.Data.([]*domain.Sale)
+		if err := h.conversion.ConvertSales(c, params, data...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, sales)
 }
 
@@ -60,6 +81,12 @@ func (h *SaleHandlerImpl) GetSaleByID(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertSales(c, params, sale); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, sale)
 }
 
@@ -80,6 +107,13 @@ func (h *SaleHandlerImpl) GetSalesByCommodityID(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		data, _ := sales.Data.([]*domain.Sale)
+		if err := h.conversion.ConvertSales(c, params, data...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 
 	utils.SuccessResponse(c, http.StatusOK, sales)
 }
@@ -101,6 +135,41 @@ func (h *SaleHandlerImpl) GetSalesByCityID(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		data, _ := sales.Data.([]*domain.Sale)
+		if err := h.conversion.ConvertSales(c, params, data...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, sales)
+}
+
+func (h *SaleHandlerImpl) GetBuyerSales(c *gin.Context) {
+	userID, err := h.authUtil.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	pagination, err := utils.GetPaginationParams(c)
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+
+	sales, err := h.uc.GetSalesByBuyerID(c, pagination, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	if params := utils.GetConversionParams(c); params != nil {
+		data, _ := sales.Data.([]*domain.Sale)
+		if err := h.conversion.ConvertSales(c, params, data...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 
 	utils.SuccessResponse(c, http.StatusOK, sales)
 }
@@ -163,6 +232,13 @@ func (h *SaleHandlerImpl) GetAllDeletedSales(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		data, _ := sales.Data.([]*domain.Sale)
+		if err := h.conversion.ConvertSales(c, params, data...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, sales)
 }
 
@@ -177,5 +253,11 @@ func (h *SaleHandlerImpl) GetDeletedSaleByID(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertSales(c, params, sale); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, sale)
 }
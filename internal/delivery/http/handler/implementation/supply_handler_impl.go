@@ -13,11 +13,12 @@ import (
 )
 
 type SupplyHandlerImpl struct {
-	uc usecase_interface.SupplyUsecase
+	uc         usecase_interface.SupplyUsecase
+	conversion usecase_interface.ConversionUsecase
 }
 
-func NewSupplyHandler(uc usecase_interface.SupplyUsecase) handler_interface.SupplyHandler {
-	return &SupplyHandlerImpl{uc: uc}
+func NewSupplyHandler(uc usecase_interface.SupplyUsecase, conversion usecase_interface.ConversionUsecase) handler_interface.SupplyHandler {
+	return &SupplyHandlerImpl{uc: uc, conversion: conversion}
 }
 
 func (h *SupplyHandlerImpl) CreateSupply(c *gin.Context) {
@@ -40,6 +41,12 @@ func (h *SupplyHandlerImpl) GetAllSupply(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertSupplies(c, params, supplies...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, supplies)
 }
 
@@ -55,6 +62,12 @@ func (h *SupplyHandlerImpl) GetSupplyByID(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertSupplies(c, params, supply); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, supply)
 }
 
@@ -69,6 +82,12 @@ func (h *SupplyHandlerImpl) GetSupplyByCommodityID(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertSupplies(c, params, supplies...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, supplies)
 }
 
@@ -83,6 +102,12 @@ func (h *SupplyHandlerImpl) GetSupplyByCityID(c *gin.Context) {
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertSupplies(c, params, supplies...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, supplies)
 }
 
@@ -135,5 +160,11 @@ func (h *SupplyHandlerImpl) GetSupplyHistoryByCommodityIDAndCityID(c *gin.Contex
 		utils.ErrorResponse(c, err)
 		return
 	}
+	if params := utils.GetConversionParams(c); params != nil {
+		if err := h.conversion.ConvertSupplyHistories(c, params, supplies...); err != nil {
+			utils.ErrorResponse(c, err)
+			return
+		}
+	}
 	utils.SuccessResponse(c, http.StatusOK, supplies)
 }
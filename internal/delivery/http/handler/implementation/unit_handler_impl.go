@@ -0,0 +1,56 @@
+package handler_implementation
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+type UnitHandlerImpl struct {
+	uc usecase_interface.UnitUsecase
+}
+
+func NewUnitHandler(uc usecase_interface.UnitUsecase) handler_interface.UnitHandler {
+	return &UnitHandlerImpl{uc}
+}
+
+func (h *UnitHandlerImpl) GetUnits(c *gin.Context) {
+	units, err := h.uc.GetUnits(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, units)
+}
+
+func (h *UnitHandlerImpl) CreateUnit(c *gin.Context) {
+	var req dto.UnitCreateDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	unit, err := h.uc.CreateUnit(c, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusCreated, unit)
+}
+
+func (h *UnitHandlerImpl) UpdateUnit(c *gin.Context) {
+	var req dto.UnitUpdateDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	unit, err := h.uc.UpdateUnit(c, c.Param("code"), &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, unit)
+}
@@ -122,3 +122,109 @@ func (h *UserHandlerImpl) RestoreUser(c *gin.Context) {
 	}
 	utils.SuccessResponse(c, http.StatusOK, restoredUser)
 }
+
+func (h *UserHandlerImpl) GetMe(c *gin.Context) {
+	userID, err := h.utilsAuth.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	user, err := h.uc.GetUserByID(c, userID)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, user)
+}
+
+func (h *UserHandlerImpl) UpdateMe(c *gin.Context) {
+	userID, err := h.utilsAuth.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	var req dto.UserProfileUpdateDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	updatedUser, err := h.uc.UpdateProfile(c, userID, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, updatedUser)
+}
+
+func (h *UserHandlerImpl) ChangePassword(c *gin.Context) {
+	userID, err := h.utilsAuth.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	var req dto.UserChangePasswordDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	if err := h.uc.ChangePassword(c, userID, &req); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, gin.H{"message": "Password changed successfully"})
+}
+
+func (h *UserHandlerImpl) RequestEmailChange(c *gin.Context) {
+	userID, err := h.utilsAuth.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	var req dto.UserEmailChangeDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	if err := h.uc.RequestEmailChange(c, userID, &req); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, gin.H{"message": "OTP sent to the new email"})
+}
+
+func (h *UserHandlerImpl) ConfirmEmailChange(c *gin.Context) {
+	userID, err := h.utilsAuth.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	var req dto.UserEmailConfirmDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	updatedUser, err := h.uc.ConfirmEmailChange(c, userID, &req)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, updatedUser)
+}
+
+func (h *UserHandlerImpl) DeleteMe(c *gin.Context) {
+	userID, err := h.utilsAuth.GetAuthUserID(c)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	var req dto.UserDeleteAccountDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, utils.NewBadRequestError(err.Error()))
+		return
+	}
+	if err := h.uc.DeleteAccount(c, userID, &req); err != nil {
+		utils.ErrorResponse(c, err)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, gin.H{"message": "Account deleted successfully"})
+}
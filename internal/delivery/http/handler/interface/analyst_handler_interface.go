@@ -0,0 +1,8 @@
+package handler_interface
+
+import "github.com/gin-gonic/gin"
+
+type AnalystHandler interface {
+	GetMarketOverview(c *gin.Context)
+	GetPriceComparison(c *gin.Context)
+}
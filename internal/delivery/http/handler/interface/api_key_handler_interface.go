@@ -0,0 +1,9 @@
+package handler_interface
+
+import "github.com/gin-gonic/gin"
+
+type APIKeyHandler interface {
+	CreateAPIKey(c *gin.Context)
+	GetAPIKeys(c *gin.Context)
+	RevokeAPIKey(c *gin.Context)
+}
@@ -6,4 +6,13 @@ type AuthHandler interface {
 	Login(c *gin.Context)
 	SendOTP(c *gin.Context)
 	VerifyOTP(c *gin.Context)
+	RefreshToken(c *gin.Context)
+	Logout(c *gin.Context)
+	UnlockAccount(c *gin.Context)
+	ForgotPassword(c *gin.Context)
+	ResetPassword(c *gin.Context)
+	VerifyMFA(c *gin.Context)
+	SetupTOTP(c *gin.Context)
+	EnableTOTP(c *gin.Context)
+	DisableTOTP(c *gin.Context)
 }
@@ -0,0 +1,10 @@
+package handler_interface
+
+import "github.com/gin-gonic/gin"
+
+type CurrencyHandler interface {
+	GetCurrencies(c *gin.Context)
+	CreateCurrency(c *gin.Context)
+	GetExchangeRates(c *gin.Context)
+	CreateExchangeRate(c *gin.Context)
+}
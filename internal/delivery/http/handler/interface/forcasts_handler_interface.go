@@ -4,4 +4,10 @@ import "github.com/gin-gonic/gin"
 
 type ForecastsHandler interface {
 	GetForecastsByCommodityIDAndCityID(c *gin.Context)
+	CreateForecastJob(c *gin.Context)
+	GetForecastJob(c *gin.Context)
+	GetForecastHistory(c *gin.Context)
+	GetForecastAccuracy(c *gin.Context)
+	BacktestForecasts(c *gin.Context)
+	GetForecastsByArea(c *gin.Context)
 }
@@ -0,0 +1,8 @@
+package handler_interface
+
+import "github.com/gin-gonic/gin"
+
+type OfficerHandler interface {
+	AssignProvince(c *gin.Context)
+	GetDashboard(c *gin.Context)
+}
@@ -0,0 +1,10 @@
+package handler_interface
+
+import "github.com/gin-gonic/gin"
+
+type PolicyHandler interface {
+	GetPolicies(c *gin.Context)
+	CreatePolicy(c *gin.Context)
+	UpdatePolicy(c *gin.Context)
+	DeletePolicy(c *gin.Context)
+}
@@ -0,0 +1,11 @@
+package handler_interface
+
+import "github.com/gin-gonic/gin"
+
+type PriceAlertHandler interface {
+	CreatePriceAlert(c *gin.Context)
+	GetPriceAlerts(c *gin.Context)
+	GetPriceAlertByID(c *gin.Context)
+	UpdatePriceAlert(c *gin.Context)
+	DeletePriceAlert(c *gin.Context)
+}
@@ -13,8 +13,17 @@ type PriceHandler interface {
 	UpdatePrice(c *gin.Context)
 	DeletePrice(c *gin.Context)
 	RestorePrice(c *gin.Context)
+	ImportPrices(c *gin.Context)
+	GetPriceReviews(c *gin.Context)
+	GetPriceReviewByID(c *gin.Context)
+	ApprovePriceReview(c *gin.Context)
+	RejectPriceReview(c *gin.Context)
+	GetPriceSchedules(c *gin.Context)
+	GetPriceScheduleByID(c *gin.Context)
+	CancelPriceSchedule(c *gin.Context)
 	GetPriceByCommodityIDAndCityID(c *gin.Context)
 	GetPricesHistoryByCommodityIDAndCityID(c *gin.Context)
+	GetPriceHistorySeries(c *gin.Context)
 	GetReportPricesHistoryByCommodityIDAndCityID(c *gin.Context)
 	DownloadFileReport(c *gin.Context)
 }
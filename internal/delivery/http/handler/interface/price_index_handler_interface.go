@@ -0,0 +1,9 @@
+package handler_interface
+
+import "github.com/gin-gonic/gin"
+
+type PriceIndexHandler interface {
+	GetNationalPriceIndex(c *gin.Context)
+	GetProvincePriceIndex(c *gin.Context)
+	GetProvincePriceIndices(c *gin.Context)
+}
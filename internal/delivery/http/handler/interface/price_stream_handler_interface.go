@@ -0,0 +1,7 @@
+package handler_interface
+
+import "github.com/gin-gonic/gin"
+
+type PriceStreamHandler interface {
+	StreamPrices(c *gin.Context)
+}
@@ -8,6 +8,7 @@ type SaleHandler interface {
 	GetSaleByID(c *gin.Context)
 	GetSalesByCommodityID(c *gin.Context)
 	GetSalesByCityID(c *gin.Context)
+	GetBuyerSales(c *gin.Context)
 	UpdateSale(c *gin.Context)
 	DeleteSale(c *gin.Context)
 	RestoreSale(c *gin.Context)
@@ -0,0 +1,9 @@
+package handler_interface
+
+import "github.com/gin-gonic/gin"
+
+type UnitHandler interface {
+	GetUnits(c *gin.Context)
+	CreateUnit(c *gin.Context)
+	UpdateUnit(c *gin.Context)
+}
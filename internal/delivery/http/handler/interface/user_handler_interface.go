@@ -9,4 +9,10 @@ type UserHandler interface {
 	DeleteUser(c *gin.Context)
 	RestoreUser(c *gin.Context)
 	UpdateUser(c *gin.Context)
+	GetMe(c *gin.Context)
+	UpdateMe(c *gin.Context)
+	ChangePassword(c *gin.Context)
+	RequestEmailChange(c *gin.Context)
+	ConfirmEmailChange(c *gin.Context)
+	DeleteMe(c *gin.Context)
 }
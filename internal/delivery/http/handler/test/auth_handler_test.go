@@ -16,6 +16,7 @@ import (
 	"github.com/ryvasa/go-super-farmer/internal/model/dto"
 	mock_usecase "github.com/ryvasa/go-super-farmer/internal/usecase/mock"
 	"github.com/ryvasa/go-super-farmer/utils"
+	mock_utils "github.com/ryvasa/go-super-farmer/utils/mock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -38,7 +39,8 @@ func AuthHandlerSetUp(t *testing.T) (*gin.Engine, handler_interface.AuthHandler,
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	uc := mock_usecase.NewMockAuthUsecase(ctrl)
-	h := handler_implementation.NewAuthHandler(uc)
+	authUtil := mock_utils.NewMockAuthUtil(ctrl)
+	h := handler_implementation.NewAuthHandler(uc, authUtil)
 	r := gin.Default()
 
 	mocks := AuthHandlerMocks{
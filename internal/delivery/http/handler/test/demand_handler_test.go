@@ -63,7 +63,7 @@ func DemandHandlerSetUp(t *testing.T) (*gin.Engine, handler_interface.DemandHand
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	uc := mock_usecase.NewMockDemandUsecase(ctrl)
-	h := handler_implementation.NewDemandHandler(uc)
+	h := handler_implementation.NewDemandHandler(uc, mock_usecase.NewMockConversionUsecase(ctrl))
 	r := gin.Default()
 
 	demandID := uuid.New()
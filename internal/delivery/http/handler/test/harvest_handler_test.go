@@ -124,7 +124,7 @@ func TestHarvestHandler_CreateHarvest(t *testing.T) {
 	r.POST("/harvests", h.CreateHarvest)
 
 	t.Run("should create harvest successfully", func(t *testing.T) {
-		uc.EXPECT().CreateHarvest(gomock.Any(), gomock.Any()).Return(mocks.Harvest, nil).Times(1)
+		uc.EXPECT().CreateHarvest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mocks.Harvest, nil).Times(1)
 
 		reqBody := `{"land_commodity_id":"` + mocks.Harvest.LandCommodityID.String() + `","city_id":` + strconv.FormatInt(ids.CityID, 10) + `,"quantity":100,"unit":"kg","harvest_date":"2022-01-01"}`
 
@@ -145,7 +145,7 @@ func TestHarvestHandler_CreateHarvest(t *testing.T) {
 	})
 
 	t.Run("should return error when bind error", func(t *testing.T) {
-		uc.EXPECT().CreateHarvest(gomock.Any(), gomock.Any()).Times(0)
+		uc.EXPECT().CreateHarvest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		req, _ := http.NewRequest(http.MethodPost, "/harvests", bytes.NewReader([]byte(`invalid-json`)))
 
@@ -163,7 +163,7 @@ func TestHarvestHandler_CreateHarvest(t *testing.T) {
 	})
 
 	t.Run("should return error when internal error", func(t *testing.T) {
-		uc.EXPECT().CreateHarvest(gomock.Any(), gomock.Any()).Return(nil, utils.NewInternalError("Internal error"))
+		uc.EXPECT().CreateHarvest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, utils.NewInternalError("Internal error"))
 
 		reqBody := `{"land_commodity_id":"` + mocks.Harvest.LandCommodityID.String() + `","city_id":` + strconv.FormatInt(ids.CityID, 10) + `,"quantity":100,"unit":"kg","harvest_date":"2022-01-01"}`
 
@@ -477,7 +477,7 @@ func TestHarvestHandler_UpdateHarvest(t *testing.T) {
 	r.PATCH("/harvests/:id", h.UpdateHarvest)
 
 	t.Run("should update harvest successfully", func(t *testing.T) {
-		uc.EXPECT().UpdateHarvest(gomock.Any(), ids.HarvestID, gomock.Any()).Return(mocks.Harvest, nil).Times(1)
+		uc.EXPECT().UpdateHarvest(gomock.Any(), gomock.Any(), gomock.Any(), ids.HarvestID, gomock.Any()).Return(mocks.Harvest, nil).Times(1)
 
 		reqBody := `{"quantity":100,"unit":"kg","harvest_date":"2022-01-01"}`
 		req, _ := http.NewRequest(http.MethodPatch, "/harvests/"+ids.HarvestID.String(), bytes.NewReader([]byte(reqBody)))
@@ -496,7 +496,7 @@ func TestHarvestHandler_UpdateHarvest(t *testing.T) {
 	})
 
 	t.Run("should return error when internal error", func(t *testing.T) {
-		uc.EXPECT().UpdateHarvest(gomock.Any(), ids.HarvestID, gomock.Any()).Return(nil, utils.NewInternalError("internal error"))
+		uc.EXPECT().UpdateHarvest(gomock.Any(), gomock.Any(), gomock.Any(), ids.HarvestID, gomock.Any()).Return(nil, utils.NewInternalError("internal error"))
 
 		reqBody := `{"quantity":100,"unit":"kg","harvest_date":"2022-01-01"}`
 		req, _ := http.NewRequest(http.MethodPatch, "/harvests/"+ids.HarvestID.String(), bytes.NewReader([]byte(reqBody)))
@@ -514,7 +514,7 @@ func TestHarvestHandler_UpdateHarvest(t *testing.T) {
 	})
 
 	t.Run("should return error when bind error", func(t *testing.T) {
-		uc.EXPECT().UpdateHarvest(gomock.Any(), ids.HarvestID, gomock.Any()).Times(0)
+		uc.EXPECT().UpdateHarvest(gomock.Any(), gomock.Any(), gomock.Any(), ids.HarvestID, gomock.Any()).Times(0)
 
 		req, _ := http.NewRequest(http.MethodPatch, "/harvests/"+ids.HarvestID.String(), bytes.NewReader([]byte(`invalid-json`)))
 		req.Header.Set("Content-Type", "application/json")
@@ -544,7 +544,7 @@ func TestHarvestHandler_UpdateHarvest(t *testing.T) {
 	})
 
 	t.Run("should return error when not found", func(t *testing.T) {
-		uc.EXPECT().UpdateHarvest(gomock.Any(), ids.HarvestID, gomock.Any()).Return(nil, utils.NewNotFoundError("harvest not found"))
+		uc.EXPECT().UpdateHarvest(gomock.Any(), gomock.Any(), gomock.Any(), ids.HarvestID, gomock.Any()).Return(nil, utils.NewNotFoundError("harvest not found"))
 
 		reqBody := `{"quantity":100,"unit":"kg","harvest_date":"2022-01-01"}`
 		req, _ := http.NewRequest(http.MethodPatch, "/harvests/"+ids.HarvestID.String(), bytes.NewReader([]byte(reqBody)))
@@ -568,7 +568,7 @@ func TestHarvestHandler_DeleteHarvest(t *testing.T) {
 	r.DELETE("/harvests/:id", h.DeleteHarvest)
 
 	t.Run("should delete harvest successfully", func(t *testing.T) {
-		uc.EXPECT().DeleteHarvest(gomock.Any(), ids.HarvestID).Return(nil).Times(1)
+		uc.EXPECT().DeleteHarvest(gomock.Any(), gomock.Any(), gomock.Any(), ids.HarvestID).Return(nil).Times(1)
 
 		req, _ := http.NewRequest(http.MethodDelete, "/harvests/"+ids.HarvestID.String(), nil)
 		w := httptest.NewRecorder()
@@ -582,7 +582,7 @@ func TestHarvestHandler_DeleteHarvest(t *testing.T) {
 	})
 
 	t.Run("should return error when internal error", func(t *testing.T) {
-		uc.EXPECT().DeleteHarvest(gomock.Any(), ids.HarvestID).Return(utils.NewInternalError("internal error"))
+		uc.EXPECT().DeleteHarvest(gomock.Any(), gomock.Any(), gomock.Any(), ids.HarvestID).Return(utils.NewInternalError("internal error"))
 
 		req, _ := http.NewRequest(http.MethodDelete, "/harvests/"+ids.HarvestID.String(), nil)
 		w := httptest.NewRecorder()
@@ -610,7 +610,7 @@ func TestHarvestHandler_DeleteHarvest(t *testing.T) {
 	})
 
 	t.Run("should return error when not found", func(t *testing.T) {
-		uc.EXPECT().DeleteHarvest(gomock.Any(), ids.HarvestID).Return(utils.NewNotFoundError("harvest not found"))
+		uc.EXPECT().DeleteHarvest(gomock.Any(), gomock.Any(), gomock.Any(), ids.HarvestID).Return(utils.NewNotFoundError("harvest not found"))
 
 		req, _ := http.NewRequest(http.MethodDelete, "/harvests/"+ids.HarvestID.String(), nil)
 		w := httptest.NewRecorder()
@@ -631,7 +631,7 @@ func TestHarvestHandler_RestoreHarvest(t *testing.T) {
 	r.POST("/harvests/:id/restore", h.RestoreHarvest)
 
 	t.Run("should restore harvest successfully", func(t *testing.T) {
-		uc.EXPECT().RestoreHarvest(gomock.Any(), ids.HarvestID).Return(mocks.Harvest, nil).Times(1)
+		uc.EXPECT().RestoreHarvest(gomock.Any(), gomock.Any(), gomock.Any(), ids.HarvestID).Return(mocks.Harvest, nil).Times(1)
 
 		req, _ := http.NewRequest(http.MethodPost, "/harvests/"+ids.HarvestID.String()+"/restore", nil)
 		w := httptest.NewRecorder()
@@ -648,7 +648,7 @@ func TestHarvestHandler_RestoreHarvest(t *testing.T) {
 	})
 
 	t.Run("should return error when internal error", func(t *testing.T) {
-		uc.EXPECT().RestoreHarvest(gomock.Any(), ids.HarvestID).Return(nil, utils.NewInternalError("Internal error"))
+		uc.EXPECT().RestoreHarvest(gomock.Any(), gomock.Any(), gomock.Any(), ids.HarvestID).Return(nil, utils.NewInternalError("Internal error"))
 
 		req, _ := http.NewRequest(http.MethodPost, "/harvests/"+ids.HarvestID.String()+"/restore", nil)
 		w := httptest.NewRecorder()
@@ -676,7 +676,7 @@ func TestHarvestHandler_RestoreHarvest(t *testing.T) {
 	})
 
 	t.Run("should return error when not found", func(t *testing.T) {
-		uc.EXPECT().RestoreHarvest(gomock.Any(), ids.HarvestID).Return(nil, utils.NewNotFoundError("harvest not found"))
+		uc.EXPECT().RestoreHarvest(gomock.Any(), gomock.Any(), gomock.Any(), ids.HarvestID).Return(nil, utils.NewNotFoundError("harvest not found"))
 
 		req, _ := http.NewRequest(http.MethodPost, "/harvests/"+ids.HarvestID.String()+"/restore", nil)
 		w := httptest.NewRecorder()
@@ -16,6 +16,7 @@ import (
 	"github.com/ryvasa/go-super-farmer/internal/model/domain"
 	mock_usecase "github.com/ryvasa/go-super-farmer/internal/usecase/mock"
 	"github.com/ryvasa/go-super-farmer/utils"
+	mockAuthUtil "github.com/ryvasa/go-super-farmer/utils/mock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -50,9 +51,13 @@ func LandCommodityHandlerSetUp(t *testing.T) (*gin.Engine, handler_interface.Lan
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	uc := mock_usecase.NewMockLandCommodityUsecase(ctrl)
-	h := handler_implementation.NewLandCommodityHandler(uc)
+	authUtil := mockAuthUtil.NewMockAuthUtil(ctrl)
+	h := handler_implementation.NewLandCommodityHandler(uc, authUtil)
 	r := gin.Default()
 
+	authUtil.EXPECT().GetAuthUserID(gomock.Any()).Return(uuid.New(), nil).AnyTimes()
+	authUtil.EXPECT().GetAuthRole(gomock.Any()).Return("Farmer", nil).AnyTimes()
+
 	landCommodityID := uuid.New()
 	commodityID := uuid.New()
 	landID := uuid.New()
@@ -86,7 +91,7 @@ func TestLandCommodityHandler_CreateLandCommodity(t *testing.T) {
 	r.POST("/land_commodities", h.CreateLandCommodity)
 
 	t.Run("should create land commodity successfully", func(t *testing.T) {
-		uc.EXPECT().CreateLandCommodity(gomock.Any(), gomock.Any()).Return(mocks.LandCommodity, nil).Times(1)
+		uc.EXPECT().CreateLandCommodity(gomock.Any(), gomock.Any(), "Farmer", gomock.Any()).Return(mocks.LandCommodity, nil).Times(1)
 
 		reqBody := `{"land_id":"` + ids.LandID.String() + `","commodity_id":"` + ids.CommodityID.String() + `","land_area":100}`
 		req, _ := http.NewRequest(http.MethodPost, "/land_commodities", bytes.NewReader([]byte(reqBody)))
@@ -117,7 +122,7 @@ func TestLandCommodityHandler_CreateLandCommodity(t *testing.T) {
 	})
 
 	t.Run("should return error when usecase error", func(t *testing.T) {
-		uc.EXPECT().CreateLandCommodity(gomock.Any(), gomock.Any()).Return(nil, utils.NewInternalError("internal server error")).Times(1)
+		uc.EXPECT().CreateLandCommodity(gomock.Any(), gomock.Any(), "Farmer", gomock.Any()).Return(nil, utils.NewInternalError("internal server error")).Times(1)
 
 		reqBody := `{"land_id":"` + ids.LandID.String() + `","commodity_id":"` + ids.CommodityID.String() + `","land_area":100}`
 		req, _ := http.NewRequest(http.MethodPost, "/land_commodities", bytes.NewReader([]byte(reqBody)))
@@ -317,7 +322,7 @@ func TestLandCommodityHandler_UpdateLandCommodity(t *testing.T) {
 	r.PATCH("/land_commodities/:id", h.UpdateLandCommodity)
 
 	t.Run("should update land commodity successfully", func(t *testing.T) {
-		uc.EXPECT().UpdateLandCommodity(gomock.Any(), ids.LandCommodityID, gomock.Any()).Return(mocks.LandCommodity, nil).Times(1)
+		uc.EXPECT().UpdateLandCommodity(gomock.Any(), gomock.Any(), "Farmer", ids.LandCommodityID, gomock.Any()).Return(mocks.LandCommodity, nil).Times(1)
 
 		reqBody := `{"land_area":100}`
 		req, _ := http.NewRequest(http.MethodPatch, "/land_commodities/"+ids.LandCommodityID.String(), bytes.NewReader([]byte(reqBody)))
@@ -354,7 +359,7 @@ func TestLandCommodityHandler_UpdateLandCommodity(t *testing.T) {
 	})
 
 	t.Run("should return error when usecase error", func(t *testing.T) {
-		uc.EXPECT().UpdateLandCommodity(gomock.Any(), ids.LandCommodityID, gomock.Any()).Return(nil, utils.NewInternalError("internal server error")).Times(1)
+		uc.EXPECT().UpdateLandCommodity(gomock.Any(), gomock.Any(), "Farmer", ids.LandCommodityID, gomock.Any()).Return(nil, utils.NewInternalError("internal server error")).Times(1)
 
 		reqBody := `{"land_area":100}`
 		req, _ := http.NewRequest(http.MethodPatch, "/land_commodities/"+ids.LandCommodityID.String(), bytes.NewReader([]byte(reqBody)))
@@ -393,7 +398,7 @@ func TestLandCommodityHandler_DeleteLandCommodity(t *testing.T) {
 	r.DELETE("/land_commodities/:id", h.DeleteLandCommodity)
 
 	t.Run("should delete land commodity successfully", func(t *testing.T) {
-		uc.EXPECT().DeleteLandCommodity(gomock.Any(), ids.LandCommodityID).Return(nil).Times(1)
+		uc.EXPECT().DeleteLandCommodity(gomock.Any(), gomock.Any(), "Farmer", ids.LandCommodityID).Return(nil).Times(1)
 		req, _ := http.NewRequest(http.MethodDelete, "/land_commodities/"+ids.LandCommodityID.String(), nil)
 		w := httptest.NewRecorder()
 		r.ServeHTTP(w, req)
@@ -406,7 +411,7 @@ func TestLandCommodityHandler_DeleteLandCommodity(t *testing.T) {
 	})
 
 	t.Run("should return error when usecase error", func(t *testing.T) {
-		uc.EXPECT().DeleteLandCommodity(gomock.Any(), ids.LandCommodityID).Return(utils.NewInternalError("internal server error")).Times(1)
+		uc.EXPECT().DeleteLandCommodity(gomock.Any(), gomock.Any(), "Farmer", ids.LandCommodityID).Return(utils.NewInternalError("internal server error")).Times(1)
 		req, _ := http.NewRequest(http.MethodDelete, "/land_commodities/"+ids.LandCommodityID.String(), nil)
 		w := httptest.NewRecorder()
 		r.ServeHTTP(w, req)
@@ -438,7 +443,7 @@ func TestLandCommodityHandler_RestoreLandCommodity(t *testing.T) {
 	r.PATCH("/land_commodities/:id/restore", h.RestoreLandCommodity)
 
 	t.Run("should restore land commodity successfully", func(t *testing.T) {
-		uc.EXPECT().RestoreLandCommodity(gomock.Any(), ids.LandCommodityID).Return(mocks.LandCommodity, nil).Times(1)
+		uc.EXPECT().RestoreLandCommodity(gomock.Any(), gomock.Any(), "Farmer", ids.LandCommodityID).Return(mocks.LandCommodity, nil).Times(1)
 
 		req, _ := http.NewRequest(http.MethodPatch, "/land_commodities/"+ids.LandCommodityID.String()+"/restore", nil)
 		w := httptest.NewRecorder()
@@ -455,7 +460,7 @@ func TestLandCommodityHandler_RestoreLandCommodity(t *testing.T) {
 	})
 
 	t.Run("should return error when usecase error", func(t *testing.T) {
-		uc.EXPECT().RestoreLandCommodity(gomock.Any(), ids.LandCommodityID).Return(nil, utils.NewInternalError("internal server error")).Times(1)
+		uc.EXPECT().RestoreLandCommodity(gomock.Any(), gomock.Any(), "Farmer", ids.LandCommodityID).Return(nil, utils.NewInternalError("internal server error")).Times(1)
 		req, _ := http.NewRequest(http.MethodPatch, "/land_commodities/"+ids.LandCommodityID.String()+"/restore", nil)
 		w := httptest.NewRecorder()
 		r.ServeHTTP(w, req)
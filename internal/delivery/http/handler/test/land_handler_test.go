@@ -294,7 +294,8 @@ func TestLandHandler_UpdateLand(t *testing.T) {
 
 	t.Run("should update land successfully", func(t *testing.T) {
 		authUtil.EXPECT().GetAuthUserID(gomock.Any()).Return(ids.UserID, nil).Times(1)
-		uc.EXPECT().UpdateLand(gomock.Any(), ids.UserID, gomock.Any(), gomock.Any()).Return(mocks.Land, nil).Times(1)
+		authUtil.EXPECT().GetAuthRole(gomock.Any()).Return("Farmer", nil).Times(1)
+		uc.EXPECT().UpdateLand(gomock.Any(), ids.UserID, "Farmer", gomock.Any(), gomock.Any()).Return(mocks.Land, nil).Times(1)
 
 		reqBody := `{"land_area":10,"certificate":"test"}`
 		req, _ := http.NewRequest(http.MethodPatch, "/lands/"+ids.LandID.String(), bytes.NewReader([]byte(reqBody)))
@@ -313,7 +314,8 @@ func TestLandHandler_UpdateLand(t *testing.T) {
 
 	t.Run("should return error when bind error", func(t *testing.T) {
 		authUtil.EXPECT().GetAuthUserID(gomock.Any()).Return(ids.UserID, nil).Times(1)
-		uc.EXPECT().UpdateLand(gomock.Any(), ids.UserID, ids.LandID, gomock.Any()).Return(nil, utils.NewInternalError("internal server error")).Times(1)
+		authUtil.EXPECT().GetAuthRole(gomock.Any()).Return("Farmer", nil).Times(1)
+		uc.EXPECT().UpdateLand(gomock.Any(), ids.UserID, "Farmer", ids.LandID, gomock.Any()).Return(nil, utils.NewInternalError("internal server error")).Times(1)
 
 		reqBody := `{"land_area":"invalid","certificate":"test"}`
 		req, _ := http.NewRequest(http.MethodPatch, "/lands/"+ids.LandID.String(), bytes.NewReader([]byte(reqBody)))
@@ -345,7 +347,8 @@ func TestLandHandler_UpdateLand(t *testing.T) {
 
 	t.Run("should return error when usecase error", func(t *testing.T) {
 		authUtil.EXPECT().GetAuthUserID(gomock.Any()).Return(ids.UserID, nil).Times(1)
-		uc.EXPECT().UpdateLand(gomock.Any(), ids.UserID, ids.LandID, gomock.Any()).Return(nil, utils.NewNotFoundError("land not found")).Times(1)
+		authUtil.EXPECT().GetAuthRole(gomock.Any()).Return("Farmer", nil).Times(1)
+		uc.EXPECT().UpdateLand(gomock.Any(), ids.UserID, "Farmer", ids.LandID, gomock.Any()).Return(nil, utils.NewNotFoundError("land not found")).Times(1)
 
 		reqBody := `{"land_area":10,"certificate":"test"}`
 		req, _ := http.NewRequest(http.MethodPatch, "/lands/"+ids.LandID.String(), bytes.NewReader([]byte(reqBody)))
@@ -382,12 +385,14 @@ func TestLandHandler_UpdateLand(t *testing.T) {
 }
 
 func TestLandHandler_DeleteLand(t *testing.T) {
-	r, h, uc, _, ids, _ := LandHandlerSetup(t)
+	r, h, uc, authUtil, ids, _ := LandHandlerSetup(t)
 
 	r.DELETE("/lands/:id", h.DeleteLand)
 
 	t.Run("should delete land successfully", func(t *testing.T) {
-		uc.EXPECT().DeleteLand(gomock.Any(), ids.LandID).Return(nil).Times(1)
+		authUtil.EXPECT().GetAuthUserID(gomock.Any()).Return(ids.UserID, nil).Times(1)
+		authUtil.EXPECT().GetAuthRole(gomock.Any()).Return("Farmer", nil).Times(1)
+		uc.EXPECT().DeleteLand(gomock.Any(), ids.UserID, "Farmer", ids.LandID).Return(nil).Times(1)
 
 		req, _ := http.NewRequest(http.MethodDelete, "/lands/"+ids.LandID.String(), nil)
 		w := httptest.NewRecorder()
@@ -401,7 +406,9 @@ func TestLandHandler_DeleteLand(t *testing.T) {
 	})
 
 	t.Run("should return error when usecase error", func(t *testing.T) {
-		uc.EXPECT().DeleteLand(gomock.Any(), ids.LandID).Return(utils.NewInternalError("internal error")).Times(1)
+		authUtil.EXPECT().GetAuthUserID(gomock.Any()).Return(ids.UserID, nil).Times(1)
+		authUtil.EXPECT().GetAuthRole(gomock.Any()).Return("Farmer", nil).Times(1)
+		uc.EXPECT().DeleteLand(gomock.Any(), ids.UserID, "Farmer", ids.LandID).Return(utils.NewInternalError("internal error")).Times(1)
 
 		req, _ := http.NewRequest(http.MethodDelete, "/lands/"+ids.LandID.String(), nil)
 		w := httptest.NewRecorder()
@@ -428,7 +435,9 @@ func TestLandHandler_DeleteLand(t *testing.T) {
 	})
 
 	t.Run("should return error when land not found", func(t *testing.T) {
-		uc.EXPECT().DeleteLand(gomock.Any(), ids.LandID).Return(utils.NewNotFoundError("land not found")).Times(1)
+		authUtil.EXPECT().GetAuthUserID(gomock.Any()).Return(ids.UserID, nil).Times(1)
+		authUtil.EXPECT().GetAuthRole(gomock.Any()).Return("Farmer", nil).Times(1)
+		uc.EXPECT().DeleteLand(gomock.Any(), ids.UserID, "Farmer", ids.LandID).Return(utils.NewNotFoundError("land not found")).Times(1)
 
 		req, _ := http.NewRequest(http.MethodDelete, "/lands/"+ids.LandID.String(), nil)
 		w := httptest.NewRecorder()
@@ -444,13 +453,15 @@ func TestLandHandler_DeleteLand(t *testing.T) {
 }
 
 func TestLandHandler_RestoreLand(t *testing.T) {
-	r, h, uc, _, ids, mocks := LandHandlerSetup(t)
+	r, h, uc, authUtil, ids, mocks := LandHandlerSetup(t)
 
 	r.PATCH("/lands/:id/restore", h.RestoreLand)
 
 	t.Run("should restore land successfully", func(t *testing.T) {
 
-		uc.EXPECT().RestoreLand(gomock.Any(), ids.LandID).Return(mocks.Land, nil).Times(1)
+		authUtil.EXPECT().GetAuthUserID(gomock.Any()).Return(ids.UserID, nil).Times(1)
+		authUtil.EXPECT().GetAuthRole(gomock.Any()).Return("Farmer", nil).Times(1)
+		uc.EXPECT().RestoreLand(gomock.Any(), ids.UserID, "Farmer", ids.LandID).Return(mocks.Land, nil).Times(1)
 
 		req, _ := http.NewRequest(http.MethodPatch, "/lands/"+ids.LandID.String()+"/restore", nil)
 		w := httptest.NewRecorder()
@@ -466,7 +477,9 @@ func TestLandHandler_RestoreLand(t *testing.T) {
 	})
 
 	t.Run("should return error when usecase error", func(t *testing.T) {
-		uc.EXPECT().RestoreLand(gomock.Any(), ids.LandID).Return(nil, utils.NewInternalError("internal error")).Times(1)
+		authUtil.EXPECT().GetAuthUserID(gomock.Any()).Return(ids.UserID, nil).Times(1)
+		authUtil.EXPECT().GetAuthRole(gomock.Any()).Return("Farmer", nil).Times(1)
+		uc.EXPECT().RestoreLand(gomock.Any(), ids.UserID, "Farmer", ids.LandID).Return(nil, utils.NewInternalError("internal error")).Times(1)
 
 		req, _ := http.NewRequest(http.MethodPatch, "/lands/"+ids.LandID.String()+"/restore", nil)
 		w := httptest.NewRecorder()
@@ -494,7 +507,9 @@ func TestLandHandler_RestoreLand(t *testing.T) {
 	})
 
 	t.Run("should return error when land not found", func(t *testing.T) {
-		uc.EXPECT().RestoreLand(gomock.Any(), ids.LandID).Return(nil, utils.NewNotFoundError("land not found")).Times(1)
+		authUtil.EXPECT().GetAuthUserID(gomock.Any()).Return(ids.UserID, nil).Times(1)
+		authUtil.EXPECT().GetAuthRole(gomock.Any()).Return("Farmer", nil).Times(1)
+		uc.EXPECT().RestoreLand(gomock.Any(), ids.UserID, "Farmer", ids.LandID).Return(nil, utils.NewNotFoundError("land not found")).Times(1)
 
 		req, _ := http.NewRequest(http.MethodPatch, "/lands/"+ids.LandID.String()+"/restore", nil)
 		w := httptest.NewRecorder()
@@ -18,6 +18,7 @@ import (
 	"github.com/ryvasa/go-super-farmer/internal/model/dto"
 	mock_usecase "github.com/ryvasa/go-super-farmer/internal/usecase/mock"
 	"github.com/ryvasa/go-super-farmer/utils"
+	mockAuthUtil "github.com/ryvasa/go-super-farmer/utils/mock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -57,7 +58,10 @@ func SaleHandlerSetup(t *testing.T) (*gin.Engine, handler_interface.SaleHandler,
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	ucSale := mock_usecase.NewMockSaleUsecase(ctrl)
-	h := handler_implementation.NewSaleHandler(ucSale)
+	authUtil := mockAuthUtil.NewMockAuthUtil(ctrl)
+	h := handler_implementation.NewSaleHandler(ucSale, mock_usecase.NewMockConversionUsecase(ctrl), authUtil)
+	authUtil.EXPECT().GetAuthUserID(gomock.Any()).Return(uuid.New(), nil).AnyTimes()
+	authUtil.EXPECT().GetAuthRole(gomock.Any()).Return("Buyer", nil).AnyTimes()
 	r := gin.Default()
 
 	ids := SaleHandlerIDs{
@@ -92,7 +96,7 @@ func TestSaleHandler_CreateSale(t *testing.T) {
 	r.POST("/sales", h.CreateSale)
 
 	t.Run("should create sale successfully", func(t *testing.T) {
-		uc.Sale.EXPECT().CreateSale(gomock.Any(), gomock.Any()).Return(domain.Sale, nil).Times(1)
+		uc.Sale.EXPECT().CreateSale(gomock.Any(), gomock.Any(), "Buyer", gomock.Any()).Return(domain.Sale, nil).Times(1)
 
 		reqBody := `{"commodity_id":"` + ids.CommodityID.String() + `","city_id":1,"quantity":1}`
 		req, _ := http.NewRequest(http.MethodPost, "/sales", bytes.NewReader([]byte(reqBody)))
@@ -108,7 +112,7 @@ func TestSaleHandler_CreateSale(t *testing.T) {
 	})
 
 	t.Run("should return error when usecase error", func(t *testing.T) {
-		uc.Sale.EXPECT().CreateSale(gomock.Any(), gomock.Any()).Return(domain.Sale, utils.NewInternalError("internal error")).Times(1)
+		uc.Sale.EXPECT().CreateSale(gomock.Any(), gomock.Any(), "Buyer", gomock.Any()).Return(domain.Sale, utils.NewInternalError("internal error")).Times(1)
 
 		reqBody := `{"commodity_id":"` + ids.CommodityID.String() + `","city_id":1,"quantity":1}`
 		req, _ := http.NewRequest(http.MethodPost, "/sales", bytes.NewReader([]byte(reqBody)))
@@ -124,7 +128,7 @@ func TestSaleHandler_CreateSale(t *testing.T) {
 	})
 
 	t.Run("should return error when bind error", func(t *testing.T) {
-		uc.Sale.EXPECT().CreateSale(gomock.Any(), gomock.Any()).Times(0)
+		uc.Sale.EXPECT().CreateSale(gomock.Any(), gomock.Any(), "Buyer", gomock.Any()).Times(0)
 		req, _ := http.NewRequest(http.MethodPost, "/sales", bytes.NewReader([]byte(`invalid-json`)))
 		req.Header.Set("Content-Type", "application/json")
 		w := httptest.NewRecorder()
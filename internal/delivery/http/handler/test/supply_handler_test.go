@@ -62,7 +62,7 @@ func SupplyHandlerSetUp(t *testing.T) (*gin.Engine, handler_interface.SupplyHand
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	uc := mock_usecase.NewMockSupplyUsecase(ctrl)
-	h := handler_implementation.NewSupplyHandler(uc)
+	h := handler_implementation.NewSupplyHandler(uc, mock_usecase.NewMockConversionUsecase(ctrl))
 	r := gin.Default()
 
 	supplyID := uuid.New()
@@ -4,21 +4,32 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
 	"github.com/ryvasa/go-super-farmer/pkg/auth/token"
+	"github.com/ryvasa/go-super-farmer/pkg/database/cache"
 	"github.com/ryvasa/go-super-farmer/utils"
 )
 
 type AuthMiddleware struct {
-	token token.Token
+	token  token.Token
+	cache  cache.Cache
+	apiKey usecase_interface.APIKeyUsecase
 	// enforcer  *casbin.Enforcer
 }
 
-func NewAuthMiddleware(token token.Token) *AuthMiddleware {
-	return &AuthMiddleware{token}
+func NewAuthMiddleware(token token.Token, cache cache.Cache, apiKey usecase_interface.APIKeyUsecase) *AuthMiddleware {
+	return &AuthMiddleware{token, cache, apiKey}
 }
 
 func (m *AuthMiddleware) Handle() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		// Machine clients authenticate with an api key instead of a bearer token
+		if key := c.GetHeader("X-API-Key"); key != "" {
+			m.handleAPIKey(c, key)
+			return
+		}
+
 		// Authentication
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -39,7 +50,58 @@ func (m *AuthMiddleware) Handle() gin.HandlerFunc {
 			c.Abort()
 			return
 		}
+
+		revoked, err := token.IsRevoked(c, m.cache, claims)
+		if err != nil {
+			utils.ErrorResponse(c, utils.NewInternalError("failed to check token revocation"))
+			c.Abort()
+			return
+		}
+		if revoked {
+			utils.ErrorResponse(c, utils.NewUnauthorizedError("token has been revoked"))
+			c.Abort()
+			return
+		}
+
 		c.Set("user", claims)
 		c.Next()
 	}
 }
+
+// handleAPIKey maps every scope of the key to a casbin subject so AutzMiddleware can enforce them,
+// sub and role are the owner's so the usecases run the same owner checks as for a bearer token
+func (m *AuthMiddleware) handleAPIKey(c *gin.Context, key string) {
+	apiKey, err := m.apiKey.Authenticate(c, key)
+	if err != nil {
+		utils.ErrorResponse(c, err)
+		c.Abort()
+		return
+	}
+
+	// Same revocation as bearer tokens, a key created before the owner was revoked stops working
+	revoked, err := token.IsUserRevoked(c, m.cache, apiKey.OwnerID.String(), apiKey.CreatedAt)
+	if err != nil {
+		utils.ErrorResponse(c, utils.NewInternalError("failed to check token revocation"))
+		c.Abort()
+		return
+	}
+	if revoked {
+		utils.ErrorResponse(c, utils.NewUnauthorizedError("api key has been revoked"))
+		c.Abort()
+		return
+	}
+
+	scopes := strings.Split(apiKey.Scopes, ",")
+	subjects := make([]string, len(scopes))
+	for i, scope := range scopes {
+		subjects[i] = "scope:" + scope
+	}
+
+	c.Set("user", jwt.MapClaims{
+		"sub":        apiKey.OwnerID.String(),
+		"role":       apiKey.Owner.Role.Name,
+		"api_key_id": apiKey.ID.String(),
+		"scopes":     subjects,
+	})
+	c.Next()
+}
@@ -1,17 +1,17 @@
 package middleware
 
 import (
-	"github.com/casbin/casbin/v2"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/ryvasa/go-super-farmer/pkg/auth/casbin"
 	"github.com/ryvasa/go-super-farmer/utils"
 )
 
 type AutzMiddleware struct {
-	enforcer *casbin.Enforcer
+	enforcer casbin.Casbin
 }
 
-func NewAutzMiddleware(enforcer *casbin.Enforcer) *AutzMiddleware {
+func NewAutzMiddleware(enforcer casbin.Casbin) *AutzMiddleware {
 	return &AutzMiddleware{enforcer: enforcer}
 }
 
@@ -35,7 +35,17 @@ func (m *AutzMiddleware) Handle() gin.HandlerFunc {
 		path := c.Request.URL.Path
 		method := c.Request.Method
 
-		allowed, err := m.enforcer.Enforce(role, path, method)
+		// API keys are enforced by their scopes instead of a role, and never beyond the role of their owner
+		subjects := []string{role}
+		scopes, isAPIKey := claimsMap["scopes"].([]string)
+		if isAPIKey {
+			subjects = scopes
+		}
+
+		allowed, err := m.enforceAny(subjects, path, method)
+		if err == nil && allowed && isAPIKey {
+			allowed, err = m.enforcer.Enforce(role, path, method)
+		}
 		if err != nil {
 			utils.ErrorResponse(c, utils.NewUnauthorizedError("Authorization check failed"))
 			c.Abort()
@@ -51,3 +61,17 @@ func (m *AutzMiddleware) Handle() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// enforceAny reports whether one of the subjects may act on the path
+func (m *AutzMiddleware) enforceAny(subjects []string, path, method string) (bool, error) {
+	for _, subject := range subjects {
+		ok, err := m.enforcer.Enforce(subject, path, method)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,103 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/delivery/http/middleware"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	mock_usecase "github.com/ryvasa/go-super-farmer/internal/usecase/mock"
+	"github.com/ryvasa/go-super-farmer/pkg/auth/token"
+	mock_token "github.com/ryvasa/go-super-farmer/pkg/auth/token/mock"
+	mock_pkg "github.com/ryvasa/go-super-farmer/pkg/mock"
+	"github.com/ryvasa/go-super-farmer/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type AuthMiddlewareMocks struct {
+	Token  *mock_token.MockToken
+	Cache  *mock_pkg.MockCache
+	APIKey *mock_usecase.MockAPIKeyUsecase
+}
+
+func AuthMiddlewareSetUp(t *testing.T) (*gin.Engine, *AuthMiddlewareMocks, *jwt.MapClaims) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mocks := &AuthMiddlewareMocks{
+		Token:  mock_token.NewMockToken(ctrl),
+		Cache:  mock_pkg.NewMockCache(ctrl),
+		APIKey: mock_usecase.NewMockAPIKeyUsecase(ctrl),
+	}
+	m := middleware.NewAuthMiddleware(mocks.Token, mocks.Cache, mocks.APIKey)
+
+	// The handler keeps the claims it was called with
+	claims := &jwt.MapClaims{}
+	r := gin.Default()
+	r.POST("/api/harvests", m.Handle(), func(c *gin.Context) {
+		value, _ := c.Get("user")
+		*claims = value.(jwt.MapClaims)
+		c.Status(http.StatusCreated)
+	})
+
+	return r, mocks, claims
+}
+
+func TestAuthMiddleware_APIKey(t *testing.T) {
+	r, mocks, claims := AuthMiddlewareSetUp(t)
+
+	ownerID := uuid.New()
+	apiKey := &domain.APIKey{
+		ID:        uuid.New(),
+		OwnerID:   ownerID,
+		Owner:     &domain.User{ID: ownerID, Role: domain.Role{Name: "Farmer"}},
+		Scopes:    "harvests:read,harvests:write",
+		CreatedAt: time.Now().Add(-time.Hour),
+	}
+
+	t.Run("should act as the key owner", func(t *testing.T) {
+		mocks.APIKey.EXPECT().Authenticate(gomock.Any(), "sf_key").Return(apiKey, nil).Times(1)
+		mocks.Cache.EXPECT().Get(gomock.Any(), token.RevokedUserKey(ownerID.String())).Return(nil, nil).Times(1)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/harvests", nil)
+		req.Header.Set("X-API-Key", "sf_key")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, ownerID.String(), (*claims)["sub"])
+		assert.Equal(t, "Farmer", (*claims)["role"])
+		assert.Equal(t, apiKey.ID.String(), (*claims)["api_key_id"])
+		assert.Equal(t, []string{"scope:harvests:read", "scope:harvests:write"}, (*claims)["scopes"])
+	})
+
+	t.Run("should reject key created before the owner was revoked", func(t *testing.T) {
+		revokedAt := strconv.FormatInt(time.Now().UnixMilli(), 10)
+		mocks.APIKey.EXPECT().Authenticate(gomock.Any(), "sf_key").Return(apiKey, nil).Times(1)
+		mocks.Cache.EXPECT().Get(gomock.Any(), token.RevokedUserKey(ownerID.String())).Return([]byte(revokedAt), nil).Times(1)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/harvests", nil)
+		req.Header.Set("X-API-Key", "sf_key")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should reject key when authentication fails", func(t *testing.T) {
+		mocks.APIKey.EXPECT().Authenticate(gomock.Any(), "sf_key").Return(nil, utils.NewUnauthorizedError("api key owner not found")).Times(1)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/harvests", nil)
+		req.Header.Set("X-API-Key", "sf_key")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
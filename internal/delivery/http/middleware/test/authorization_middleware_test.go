@@ -0,0 +1,76 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/ryvasa/go-super-farmer/internal/delivery/http/middleware"
+	mock_casbin "github.com/ryvasa/go-super-farmer/pkg/auth/casbin/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func AutzMiddlewareSetUp(t *testing.T) (*mock_casbin.MockCasbin, func(claims jwt.MapClaims) int) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	enforcer := mock_casbin.NewMockCasbin(ctrl)
+	m := middleware.NewAutzMiddleware(enforcer)
+
+	// serve runs a price write with the claims AuthMiddleware would have set
+	serve := func(claims jwt.MapClaims) int {
+		r := gin.Default()
+		r.POST("/api/prices", func(c *gin.Context) {
+			c.Set("user", claims)
+			c.Next()
+		}, m.Handle(), func(c *gin.Context) {
+			c.Status(http.StatusCreated)
+		})
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/prices", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	return enforcer, serve
+}
+
+func TestAutzMiddleware_APIKey(t *testing.T) {
+	enforcer, serve := AutzMiddlewareSetUp(t)
+	apiKeyClaims := func(role string) jwt.MapClaims {
+		return jwt.MapClaims{"sub": "owner", "role": role, "scopes": []string{"scope:prices:read", "scope:prices:write"}}
+	}
+
+	t.Run("should allow a scope the owner's role allows too", func(t *testing.T) {
+		enforcer.EXPECT().Enforce("scope:prices:read", "/api/prices", http.MethodPost).Return(false, nil).Times(1)
+		enforcer.EXPECT().Enforce("scope:prices:write", "/api/prices", http.MethodPost).Return(true, nil).Times(1)
+		enforcer.EXPECT().Enforce("Admin", "/api/prices", http.MethodPost).Return(true, nil).Times(1)
+
+		assert.Equal(t, http.StatusCreated, serve(apiKeyClaims("Admin")))
+	})
+
+	t.Run("should reject a scope beyond the owner's role", func(t *testing.T) {
+		enforcer.EXPECT().Enforce("scope:prices:read", "/api/prices", http.MethodPost).Return(false, nil).Times(1)
+		enforcer.EXPECT().Enforce("scope:prices:write", "/api/prices", http.MethodPost).Return(true, nil).Times(1)
+		enforcer.EXPECT().Enforce("Buyer", "/api/prices", http.MethodPost).Return(false, nil).Times(1)
+
+		assert.Equal(t, http.StatusForbidden, serve(apiKeyClaims("Buyer")))
+	})
+
+	t.Run("should reject a request outside the scopes without checking the role", func(t *testing.T) {
+		claims := jwt.MapClaims{"sub": "owner", "role": "Admin", "scopes": []string{"scope:prices:read"}}
+		enforcer.EXPECT().Enforce("scope:prices:read", "/api/prices", http.MethodPost).Return(false, nil).Times(1)
+		enforcer.EXPECT().Enforce("Admin", "/api/prices", http.MethodPost).Times(0)
+
+		assert.Equal(t, http.StatusForbidden, serve(claims))
+	})
+
+	t.Run("should enforce the role of a bearer token", func(t *testing.T) {
+		enforcer.EXPECT().Enforce("Buyer", "/api/prices", http.MethodPost).Return(false, nil).Times(1)
+
+		assert.Equal(t, http.StatusForbidden, serve(jwt.MapClaims{"sub": "user", "role": "Buyer"}))
+	})
+}
@@ -0,0 +1,19 @@
+package route
+
+import (
+	"github.com/gin-gonic/gin"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+)
+
+type AnalystRoute struct {
+	handler handler_interface.AnalystHandler
+}
+
+func NewAnalystRoute(handler handler_interface.AnalystHandler) *AnalystRoute {
+	return &AnalystRoute{handler}
+}
+
+func (r *AnalystRoute) Register(public, protected *gin.RouterGroup) {
+	protected.GET("/analyst/market/:commodity_id", r.handler.GetMarketOverview)
+	protected.GET("/analyst/market/:commodity_id/comparison", r.handler.GetPriceComparison)
+}
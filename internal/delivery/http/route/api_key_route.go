@@ -0,0 +1,20 @@
+package route
+
+import (
+	"github.com/gin-gonic/gin"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+)
+
+type APIKeyRoute struct {
+	handler handler_interface.APIKeyHandler
+}
+
+func NewAPIKeyRoute(handler handler_interface.APIKeyHandler) *APIKeyRoute {
+	return &APIKeyRoute{handler}
+}
+
+func (r *APIKeyRoute) Register(public, protected *gin.RouterGroup) {
+	protected.POST("/api_keys", r.handler.CreateAPIKey)
+	protected.GET("/api_keys", r.handler.GetAPIKeys)
+	protected.DELETE("/api_keys/:id", r.handler.RevokeAPIKey)
+}
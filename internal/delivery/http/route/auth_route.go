@@ -17,4 +17,13 @@ func (r *AuthRoute) Register(public, protected *gin.RouterGroup) {
 	public.POST("/auth/login", r.handler.Login)
 	public.POST("/auth/send", r.handler.SendOTP)
 	public.POST("/auth/verify", r.handler.VerifyOTP)
+	public.POST("/auth/refresh", r.handler.RefreshToken)
+	public.POST("/auth/password/forgot", r.handler.ForgotPassword)
+	public.POST("/auth/password/reset", r.handler.ResetPassword)
+	protected.POST("/auth/logout", r.handler.Logout)
+	protected.POST("/auth/unlock", r.handler.UnlockAccount)
+	public.POST("/auth/2fa/verify", r.handler.VerifyMFA)
+	protected.POST("/auth/2fa/setup", r.handler.SetupTOTP)
+	protected.POST("/auth/2fa/enable", r.handler.EnableTOTP)
+	protected.POST("/auth/2fa/disable", r.handler.DisableTOTP)
 }
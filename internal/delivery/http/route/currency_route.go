@@ -0,0 +1,21 @@
+package route
+
+import (
+	"github.com/gin-gonic/gin"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+)
+
+type CurrencyRoute struct {
+	handler handler_interface.CurrencyHandler
+}
+
+func NewCurrencyRoute(handler handler_interface.CurrencyHandler) *CurrencyRoute {
+	return &CurrencyRoute{handler}
+}
+
+func (r *CurrencyRoute) Register(public, protected *gin.RouterGroup) {
+	public.GET("/currencies", r.handler.GetCurrencies)
+	protected.POST("/currencies", r.handler.CreateCurrency)
+	public.GET("/currencies/:code/rates", r.handler.GetExchangeRates)
+	protected.POST("/currencies/:code/rates", r.handler.CreateExchangeRate)
+}
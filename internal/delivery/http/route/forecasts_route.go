@@ -15,4 +15,10 @@ func NewForecastsRoute(handler handler_interface.ForecastsHandler) *ForecastsRou
 
 func (r *ForecastsRoute) Register(public, protected *gin.RouterGroup) {
 	protected.GET("/forecasts/city/:city_id/land_commodity/:land_commodity_id", r.handler.GetForecastsByCommodityIDAndCityID)
+	protected.POST("/forecasts/jobs", r.handler.CreateForecastJob)
+	protected.GET("/forecasts/jobs/:id", r.handler.GetForecastJob)
+	protected.GET("/forecasts/land_commodity/:land_commodity_id/history", r.handler.GetForecastHistory)
+	protected.GET("/forecasts/accuracy", r.handler.GetForecastAccuracy)
+	protected.GET("/forecasts/backtest", r.handler.BacktestForecasts)
+	protected.GET("/forecasts/area", r.handler.GetForecastsByArea)
 }
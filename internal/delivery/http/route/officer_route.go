@@ -0,0 +1,19 @@
+package route
+
+import (
+	"github.com/gin-gonic/gin"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+)
+
+type OfficerRoute struct {
+	handler handler_interface.OfficerHandler
+}
+
+func NewOfficerRoute(handler handler_interface.OfficerHandler) *OfficerRoute {
+	return &OfficerRoute{handler}
+}
+
+func (r *OfficerRoute) Register(public, protected *gin.RouterGroup) {
+	protected.PATCH("/officers/:id/province", r.handler.AssignProvince)
+	protected.GET("/officer/dashboard", r.handler.GetDashboard)
+}
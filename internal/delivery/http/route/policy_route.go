@@ -0,0 +1,21 @@
+package route
+
+import (
+	"github.com/gin-gonic/gin"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+)
+
+type PolicyRoute struct {
+	handler handler_interface.PolicyHandler
+}
+
+func NewPolicyRoute(handler handler_interface.PolicyHandler) *PolicyRoute {
+	return &PolicyRoute{handler}
+}
+
+func (r *PolicyRoute) Register(public, protected *gin.RouterGroup) {
+	protected.GET("/policies", r.handler.GetPolicies)
+	protected.POST("/policies", r.handler.CreatePolicy)
+	protected.PUT("/policies", r.handler.UpdatePolicy)
+	protected.DELETE("/policies", r.handler.DeletePolicy)
+}
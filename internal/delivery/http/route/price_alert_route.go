@@ -0,0 +1,22 @@
+package route
+
+import (
+	"github.com/gin-gonic/gin"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+)
+
+type PriceAlertRoute struct {
+	handler handler_interface.PriceAlertHandler
+}
+
+func NewPriceAlertRoute(handler handler_interface.PriceAlertHandler) *PriceAlertRoute {
+	return &PriceAlertRoute{handler}
+}
+
+func (r *PriceAlertRoute) Register(public, protected *gin.RouterGroup) {
+	protected.POST("/price_alerts", r.handler.CreatePriceAlert)
+	protected.GET("/price_alerts", r.handler.GetPriceAlerts)
+	protected.GET("/price_alerts/:id", r.handler.GetPriceAlertByID)
+	protected.PATCH("/price_alerts/:id", r.handler.UpdatePriceAlert)
+	protected.DELETE("/price_alerts/:id", r.handler.DeletePriceAlert)
+}
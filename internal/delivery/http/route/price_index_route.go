@@ -0,0 +1,20 @@
+package route
+
+import (
+	"github.com/gin-gonic/gin"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+)
+
+type PriceIndexRoute struct {
+	handler handler_interface.PriceIndexHandler
+}
+
+func NewPriceIndexRoute(handler handler_interface.PriceIndexHandler) *PriceIndexRoute {
+	return &PriceIndexRoute{handler}
+}
+
+func (r *PriceIndexRoute) Register(public, protected *gin.RouterGroup) {
+	public.GET("/prices/index/commodity/:commodity_id", r.handler.GetNationalPriceIndex)
+	public.GET("/prices/index/commodity/:commodity_id/provinces", r.handler.GetProvincePriceIndices)
+	public.GET("/prices/index/commodity/:commodity_id/province/:province_id", r.handler.GetProvincePriceIndex)
+}
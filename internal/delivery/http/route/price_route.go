@@ -16,6 +16,7 @@ func NewPriceRoute(handler handler_interface.PriceHandler) *PriceRoute {
 func (r *PriceRoute) Register(public, protected *gin.RouterGroup) {
 	protected.POST("/prices", r.handler.CreatePrice)
 	protected.GET("/prices", r.handler.GetAllPrices)
+	protected.POST("/prices/import", r.handler.ImportPrices)
 	public.GET("/prices/:id", r.handler.GetPriceByID)
 	protected.GET("/prices/commodity/:id", r.handler.GetPricesByCommodityID)
 	protected.GET("/prices/city/:id", r.handler.GetPricesByCityID)
@@ -24,6 +25,14 @@ func (r *PriceRoute) Register(public, protected *gin.RouterGroup) {
 	protected.PATCH("/prices/:id/restore", r.handler.RestorePrice)
 	public.GET("/prices/current/commodity/:commodity_id/city/:city_id", r.handler.GetPriceByCommodityIDAndCityID)
 	public.GET("/prices/history/commodity/:commodity_id/city/:city_id", r.handler.GetPricesHistoryByCommodityIDAndCityID)
+	public.GET("/prices/history/commodity/:commodity_id/city/:city_id/series", r.handler.GetPriceHistorySeries)
 	public.GET("/prices/history/commodity/:commodity_id/city/:city_id/report", r.handler.GetReportPricesHistoryByCommodityIDAndCityID)
 	public.GET("/prices/history/:bucket/:file_report/download", r.handler.DownloadFileReport)
+	protected.GET("/price_reviews", r.handler.GetPriceReviews)
+	protected.GET("/price_reviews/:id", r.handler.GetPriceReviewByID)
+	protected.POST("/price_reviews/:id/approve", r.handler.ApprovePriceReview)
+	protected.POST("/price_reviews/:id/reject", r.handler.RejectPriceReview)
+	protected.GET("/price_schedules", r.handler.GetPriceSchedules)
+	protected.GET("/price_schedules/:id", r.handler.GetPriceScheduleByID)
+	protected.POST("/price_schedules/:id/cancel", r.handler.CancelPriceSchedule)
 }
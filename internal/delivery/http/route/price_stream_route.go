@@ -0,0 +1,18 @@
+package route
+
+import (
+	"github.com/gin-gonic/gin"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+)
+
+type PriceStreamRoute struct {
+	handler handler_interface.PriceStreamHandler
+}
+
+func NewPriceStreamRoute(handler handler_interface.PriceStreamHandler) *PriceStreamRoute {
+	return &PriceStreamRoute{handler}
+}
+
+func (r *PriceStreamRoute) Register(public, protected *gin.RouterGroup) {
+	public.GET("/prices/stream", r.handler.StreamPrices)
+}
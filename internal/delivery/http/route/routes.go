@@ -1,9 +1,13 @@
 package route
 
 import (
+	"net/http"
+
 	"github.com/gin-gonic/gin"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
 	"github.com/ryvasa/go-super-farmer/pkg/auth/casbin"
 	"github.com/ryvasa/go-super-farmer/pkg/auth/token"
+	"github.com/ryvasa/go-super-farmer/pkg/database/cache"
 	"github.com/ryvasa/go-super-farmer/pkg/env"
 	handler "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler"
 	"github.com/ryvasa/go-super-farmer/internal/delivery/http/middleware"
@@ -13,7 +17,7 @@ type Router interface {
 	Register(public, protected *gin.RouterGroup)
 }
 
-func NewRouter(handlers *handler.Handlers) *gin.Engine {
+func NewRouter(handlers *handler.Handlers, cache cache.Cache, apiKeyUsecase usecase_interface.APIKeyUsecase, enforcer casbin.Casbin) *gin.Engine {
 	r := gin.Default()
 
 	public := r.Group("/api")
@@ -25,13 +29,11 @@ func NewRouter(handlers *handler.Handlers) *gin.Engine {
 	}
 
 	// Setup middleware
-	enforcer, err := casbin.Init(env.Casbin.ModelPath, env.Casbin.PolicyPath)
+	tokenService, err := token.NewToken(env)
 	if err != nil {
 		panic(err)
 	}
-
-	tokenService := token.NewToken(env)
-	authMiddleware := middleware.NewAuthMiddleware(tokenService)
+	authMiddleware := middleware.NewAuthMiddleware(tokenService, cache, apiKeyUsecase)
 	autzMiddleware := middleware.NewAutzMiddleware(enforcer)
 
 	protected.Use(authMiddleware.Handle())
@@ -52,8 +54,23 @@ func NewRouter(handlers *handler.Handlers) *gin.Engine {
 		NewRoleRoute(handlers.RoleHandler),
 		NewSaleRoute(handlers.SaleHandler),
 		NewForecastsRoute(handlers.ForecastsHandler),
+		NewAPIKeyRoute(handlers.APIKeyHandler),
+		NewPolicyRoute(handlers.PolicyHandler),
+		NewOfficerRoute(handlers.OfficerHandler),
+		NewAnalystRoute(handlers.AnalystHandler),
+		NewPriceAlertRoute(handlers.PriceAlertHandler),
+		NewUnitRoute(handlers.UnitHandler),
+		NewCurrencyRoute(handlers.CurrencyHandler),
+		NewPriceIndexRoute(handlers.PriceIndexHandler),
+		NewPriceStreamRoute(handlers.PriceStreamHandler),
 	}
 
+	// Public keys for other services to verify our tokens
+	r.GET("/.well-known/jwks.json", func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(http.StatusOK, tokenService.JWKS())
+	})
+
 	// Register all routes
 	for _, router := range routes {
 		router.Register(public, protected)
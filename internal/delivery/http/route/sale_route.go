@@ -19,6 +19,7 @@ func (r *SaleRoute) Register(public, protected *gin.RouterGroup) {
 	public.GET("/sales/:id", r.handler.GetSaleByID)
 	public.GET("/sales/commodity/:id", r.handler.GetSalesByCommodityID)
 	public.GET("/sales/city/:id", r.handler.GetSalesByCityID)
+	protected.GET("/buyer/sales", r.handler.GetBuyerSales)
 	protected.PUT("/sales/:id", r.handler.UpdateSale)
 	protected.DELETE("/sales/:id", r.handler.DeleteSale)
 	protected.POST("/sales/:id/restore", r.handler.RestoreSale)
@@ -0,0 +1,20 @@
+package route
+
+import (
+	"github.com/gin-gonic/gin"
+	handler_interface "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/interface"
+)
+
+type UnitRoute struct {
+	handler handler_interface.UnitHandler
+}
+
+func NewUnitRoute(handler handler_interface.UnitHandler) *UnitRoute {
+	return &UnitRoute{handler}
+}
+
+func (r *UnitRoute) Register(public, protected *gin.RouterGroup) {
+	public.GET("/units", r.handler.GetUnits)
+	protected.POST("/units", r.handler.CreateUnit)
+	protected.PATCH("/units/:code", r.handler.UpdateUnit)
+}
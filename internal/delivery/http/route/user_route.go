@@ -20,4 +20,11 @@ func (r *UserRoute) Register(public, protected *gin.RouterGroup) {
 	protected.PATCH("/users/:id", r.handler.UpdateUser)
 	protected.DELETE("/users/:id", r.handler.DeleteUser)
 	protected.PATCH("/users/:id/restore", r.handler.RestoreUser)
+
+	protected.GET("/me", r.handler.GetMe)
+	protected.PATCH("/me", r.handler.UpdateMe)
+	protected.DELETE("/me", r.handler.DeleteMe)
+	protected.PUT("/me/password", r.handler.ChangePassword)
+	protected.POST("/me/email", r.handler.RequestEmailChange)
+	protected.POST("/me/email/confirm", r.handler.ConfirmEmailChange)
 }
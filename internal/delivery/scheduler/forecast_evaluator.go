@@ -0,0 +1,57 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/pkg/env"
+	"github.com/ryvasa/go-super-farmer/pkg/logrus"
+)
+
+const defaultForecastEvaluationInterval = time.Hour
+
+// ForecastEvaluator compares the forecasts whose harvest date passed with the actual price, every instance of
+// the API runs one and evaluating a forecast twice writes the same price
+type ForecastEvaluator struct {
+	uc       usecase_interface.ForecastsUsecase
+	interval time.Duration
+}
+
+func NewForecastEvaluator(uc usecase_interface.ForecastsUsecase, env *env.Env) *ForecastEvaluator {
+	interval := defaultForecastEvaluationInterval
+	if env.Forecast.EvaluationInterval != "" {
+		parsed, err := time.ParseDuration(env.Forecast.EvaluationInterval)
+		if err != nil || parsed <= 0 {
+			logrus.Log.Warnf("invalid FORECAST_EVALUATION_INTERVAL %q, using %s", env.Forecast.EvaluationInterval, interval)
+		} else {
+			interval = parsed
+		}
+	}
+	return &ForecastEvaluator{uc, interval}
+}
+
+// Run evaluates the due forecasts right away and then on every tick until ctx is done
+func (e *ForecastEvaluator) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		e.evaluate(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *ForecastEvaluator) evaluate(ctx context.Context) {
+	evaluated, err := e.uc.EvaluateDueForecasts(ctx)
+	if evaluated > 0 {
+		logrus.Log.Infof("evaluated %d forecasts", evaluated)
+	}
+	if err != nil {
+		logrus.Log.Errorf("failed to evaluate forecasts: %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/pkg/env"
+	"github.com/ryvasa/go-super-farmer/pkg/logrus"
+)
+
+const defaultPriceScheduleInterval = time.Minute
+
+// PriceScheduler applies the scheduled price changes once they are due, every instance of the API runs one
+// and the schedules are locked while they are applied so none is applied twice
+type PriceScheduler struct {
+	uc       usecase_interface.PriceUsecase
+	interval time.Duration
+}
+
+func NewPriceScheduler(uc usecase_interface.PriceUsecase, env *env.Env) *PriceScheduler {
+	interval := defaultPriceScheduleInterval
+	if env.PriceSchedule.Interval != "" {
+		parsed, err := time.ParseDuration(env.PriceSchedule.Interval)
+		if err != nil || parsed <= 0 {
+			logrus.Log.Warnf("invalid PRICE_SCHEDULE_INTERVAL %q, using %s", env.PriceSchedule.Interval, interval)
+		} else {
+			interval = parsed
+		}
+	}
+	return &PriceScheduler{uc, interval}
+}
+
+// Run applies the due schedules right away and then on every tick until ctx is done
+func (s *PriceScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.apply(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *PriceScheduler) apply(ctx context.Context) {
+	applied, err := s.uc.ApplyDuePriceSchedules(ctx)
+	if applied > 0 {
+		logrus.Log.Infof("applied %d scheduled price changes", applied)
+	}
+	if err != nil {
+		logrus.Log.Errorf("failed to apply scheduled price changes: %v", err)
+	}
+}
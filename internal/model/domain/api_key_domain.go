@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type APIKey struct {
+	ID         uuid.UUID      `gorm:"primaryKey;type:varchar(36)"`
+	Name       string         `gorm:"not null;type:varchar(100)"`
+	Prefix     string         `gorm:"not null;type:varchar(16);uniqueIndex"`
+	SecretHash string         `gorm:"not null;type:varchar(64)" json:"-"`
+	OwnerID    uuid.UUID      `gorm:"not null;type:varchar(36)"`
+	Owner      *User          `gorm:"foreignKey:OwnerID" json:"-"`
+	Scopes     string         `gorm:"not null;type:varchar(255)"`
+	ExpiresAt  *time.Time     `gorm:"index"`
+	LastUsedAt *time.Time     `gorm:"default:null"`
+	CreatedAt  time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt  gorm.DeletedAt `gorm:"index"`
+}
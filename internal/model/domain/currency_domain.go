@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BaseCurrency is the currency prices and sales are recorded in
+const BaseCurrency = "idr"
+
+type Currency struct {
+	Code      string    `gorm:"primaryKey;type:varchar(3)"`
+	Name      string    `gorm:"not null;type:varchar(100)"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// ExchangeRate is the amount of the base currency one unit of the currency buys from EffectiveDate on
+type ExchangeRate struct {
+	ID            uuid.UUID `gorm:"primaryKey;type:varchar(36)"`
+	CurrencyCode  string    `gorm:"not null;type:varchar(3);uniqueIndex:idx_exchange_rate_date"`
+	Currency      *Currency `gorm:"foreignKey:CurrencyCode;references:Code" json:"-"`
+	Rate          float64   `gorm:"not null"`
+	EffectiveDate time.Time `gorm:"not null;type:date;uniqueIndex:idx_exchange_rate_date"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Statuses of a forecast, a pending forecast is a job still waiting for the prediction service
+const (
+	ForecastPending   = "pending"
+	ForecastCompleted = "completed"
+	ForecastFailed    = "failed"
+)
+
+// Engines a forecast is made by, the baseline is the statistical forecaster of the API itself
+const (
+	ForecastEngineService  = "prediction-service"
+	ForecastEngineBaseline = "baseline"
+)
+
+// Forecast is one prediction of the harvest price of a land commodity, stored with the inputs it was made from
+// so past forecasts can be compared with what the price turned out to be. ActualPrice is the price in effect on the
+// harvest date, set once that date passed and left nil when the commodity had no price in the city by then
+type Forecast struct {
+	ID               uuid.UUID  `gorm:"primaryKey;type:varchar(36)"`
+	LandCommodityID  uuid.UUID  `gorm:"not null;index"`
+	CommodityID      uuid.UUID  `gorm:"not null;index"`
+	Commodity        *Commodity `gorm:"foreignKey:CommodityID;references:ID" json:"commodity,omitempty"`
+	CityID           int64      `gorm:"not null;index"`
+	City             *City      `gorm:"foreignKey:CityID" json:"city,omitempty"`
+	Status           string     `gorm:"not null;type:varchar(10);index"`
+	LandArea         float64    `gorm:"not null"`
+	HarvestYield     float64    `gorm:"not null"`
+	Demand           float64    `gorm:"not null"`
+	Supply           float64    `gorm:"not null"`
+	Sale             float64    `gorm:"not null"`
+	CurrentPrice     float64    `gorm:"not null"`
+	DayOfYear        int        `gorm:"not null"`
+	DaysUntilHarvest int        `gorm:"not null"`
+	HarvestDate      time.Time  `gorm:"not null"`
+	Engine           string     `gorm:"type:varchar(20)"`
+	ModelVersion     string     `gorm:"type:varchar(50)"`
+	PredictedPrice   *float64   `gorm:"default:null"`
+	Error            string     `gorm:"type:varchar(255)"`
+	RequestedBy      *uuid.UUID `gorm:"default:null"`
+	CompletedAt      *time.Time `gorm:"default:null"`
+	ActualPrice      *float64   `gorm:"default:null"`
+	EvaluatedAt      *time.Time `gorm:"default:null;index"`
+	CreatedAt        time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt        time.Time  `gorm:"autoUpdateTime"`
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Conditions of a price alert, above and below fire when the price crosses TargetPrice,
+// change fires when the price moved more than ChangePercent within WindowDays
+const (
+	PriceAlertAbove  = "above"
+	PriceAlertBelow  = "below"
+	PriceAlertChange = "change"
+)
+
+type PriceAlert struct {
+	ID              uuid.UUID      `gorm:"primaryKey;type:varchar(36)"`
+	UserID          uuid.UUID      `gorm:"not null;type:varchar(36);index"`
+	User            *User          `gorm:"foreignKey:UserID" json:"-"`
+	CommodityID     uuid.UUID      `gorm:"not null;index:idx_price_alert_target"`
+	Commodity       *Commodity     `gorm:"foreignKey:CommodityID;references:ID" json:"-"`
+	CityID          int64          `gorm:"not null;index:idx_price_alert_target"`
+	City            *City          `gorm:"foreignKey:CityID" json:"-"`
+	Condition       string         `gorm:"not null;type:varchar(10)"`
+	TargetPrice     *float64       `gorm:"default:null"`
+	ChangePercent   *float64       `gorm:"default:null"`
+	WindowDays      int            `gorm:"not null;default:7"`
+	CooldownMinutes int            `gorm:"not null;default:1440"`
+	Active          bool           `gorm:"not null;default:true"`
+	LastTriggeredAt *time.Time     `gorm:"default:null"`
+	CreatedAt       time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
+}
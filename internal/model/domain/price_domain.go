@@ -15,6 +15,9 @@ type Price struct {
 	City        *City          `gorm:"foreignKey:CityID" json:"city,omitempty"`
 	Price       float64        `gorm:"not null"`
 	Unit        string         `gorm:"not null;type:varchar(255); default:idr"`
+	ChangedBy   *uuid.UUID     `json:"changed_by,omitempty"`
+	Reason      string         `gorm:"type:varchar(255)" json:"reason,omitempty"`
+	Source      string         `gorm:"type:varchar(10)" json:"source,omitempty"`
 	CreatedAt   time.Time      `gorm:"autoCreateTime"`
 	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
 	DeletedAt   gorm.DeletedAt `gorm:"index"`
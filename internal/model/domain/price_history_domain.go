@@ -7,6 +7,15 @@ import (
 	"gorm.io/gorm"
 )
 
+// Sources of a price change
+const (
+	PriceSourceManual    = "manual"
+	PriceSourceImport    = "import"
+	PriceSourceAPIKey    = "api-key"
+	PriceSourceScheduler = "scheduler"
+)
+
+// PriceHistory is a price that was replaced, ChangedBy, Reason and Source tell who set that price and why
 type PriceHistory struct {
 	ID          uuid.UUID      `gorm:"primary_key;"`
 	CommodityID uuid.UUID      `gorm:"not null"`
@@ -15,6 +24,9 @@ type PriceHistory struct {
 	City        *City          `gorm:"foreignKey:CityID" json:"city,omitempty"`
 	Price       float64        `gorm:"not null" validate:"gte=0"`
 	Unit        string         `gorm:"not null;type:varchar(255); default:idr"`
+	ChangedBy   *uuid.UUID     `json:"changed_by,omitempty"`
+	Reason      string         `gorm:"type:varchar(255)" json:"reason,omitempty"`
+	Source      string         `gorm:"type:varchar(10)" json:"source,omitempty"`
 	CreatedAt   time.Time      `gorm:"autoCreateTime"`
 	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
 	DeletedAt   gorm.DeletedAt `gorm:"index"`
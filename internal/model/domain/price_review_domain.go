@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Statuses of a price review, a pending change is not applied until an admin approves it,
+// a forced change was applied right away and only waits to be looked at
+const (
+	PriceReviewPending  = "pending"
+	PriceReviewForced   = "forced"
+	PriceReviewApproved = "approved"
+	PriceReviewRejected = "rejected"
+)
+
+// PriceReview records a price write that was far from the recent prices of its commodity.
+// Median and Score describe the check, Score is the modified z-score and is nil when the recent prices did not vary.
+// ChangedBy, Reason and Source belong to the write and are applied with it
+type PriceReview struct {
+	ID            uuid.UUID  `gorm:"primaryKey;type:varchar(36)"`
+	PriceID       *uuid.UUID `gorm:"default:null"`
+	CommodityID   uuid.UUID  `gorm:"not null"`
+	Commodity     *Commodity `gorm:"foreignKey:CommodityID;references:ID" json:"commodity,omitempty"`
+	CityID        int64      `gorm:"not null"`
+	City          *City      `gorm:"foreignKey:CityID" json:"city,omitempty"`
+	PreviousPrice *float64   `gorm:"default:null"`
+	Price         float64    `gorm:"not null"`
+	Median        float64    `gorm:"not null"`
+	Score         *float64   `gorm:"default:null"`
+	Samples       int        `gorm:"not null"`
+	ChangedBy     *uuid.UUID `json:"changed_by,omitempty"`
+	Reason        string     `gorm:"type:varchar(255)" json:"reason,omitempty"`
+	Source        string     `gorm:"type:varchar(10)" json:"source,omitempty"`
+	Status        string     `gorm:"not null;type:varchar(10);index"`
+	ReviewedBy    *uuid.UUID `gorm:"default:null"`
+	ReviewedAt    *time.Time `gorm:"default:null"`
+	Note          string     `gorm:"type:varchar(500)"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time  `gorm:"autoUpdateTime"`
+}
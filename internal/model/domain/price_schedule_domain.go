@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Statuses of a price schedule, only a pending schedule is applied or cancelled.
+// A held schedule became a pending price review and a failed one could not be applied
+const (
+	PriceSchedulePending   = "pending"
+	PriceScheduleApplied   = "applied"
+	PriceScheduleCancelled = "cancelled"
+	PriceScheduleHeld      = "held"
+	PriceScheduleFailed    = "failed"
+)
+
+// PriceSchedule is a price change that takes effect at EffectiveAt, the scheduler applies it once it is due.
+// CommodityID and CityID are copied from the price so the schedule can still be listed after the price is deleted
+type PriceSchedule struct {
+	ID          uuid.UUID  `gorm:"primaryKey;type:varchar(36)"`
+	PriceID     uuid.UUID  `gorm:"not null;index"`
+	CommodityID uuid.UUID  `gorm:"not null"`
+	Commodity   *Commodity `gorm:"foreignKey:CommodityID;references:ID" json:"commodity,omitempty"`
+	CityID      int64      `gorm:"not null"`
+	City        *City      `gorm:"foreignKey:CityID" json:"city,omitempty"`
+	Price       float64    `gorm:"not null"`
+	EffectiveAt time.Time  `gorm:"not null;index"`
+	Status      string     `gorm:"not null;type:varchar(10);index"`
+	Reason      string     `gorm:"type:varchar(255)"`
+	CreatedBy   *uuid.UUID `gorm:"default:null"`
+	AppliedAt   *time.Time `gorm:"default:null"`
+	CancelledAt *time.Time `gorm:"default:null"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime"`
+}
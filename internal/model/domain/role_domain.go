@@ -1,5 +1,14 @@
 package domain
 
+// Names of the predefined roles, they are also the casbin subjects of their policies
+const (
+	RoleAdmin         = "Admin"
+	RoleFarmer        = "Farmer"
+	RoleBuyer         = "Buyer"
+	RoleFieldOfficer  = "FieldOfficer"
+	RoleMarketAnalyst = "MarketAnalyst"
+)
+
 type Role struct {
 	ID   int64  `gorm:"primary_key; auto_increment"`
 	Name string `gorm:"size:100; not null; type:varchar(100);uniqueIndex" validate:"min=5"`
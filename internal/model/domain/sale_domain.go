@@ -7,12 +7,14 @@ import (
 	"gorm.io/gorm"
 )
 
+// Sale is one sale of a commodity, Price is the total amount of the sale in the base currency, not a price per unit
 type Sale struct {
 	ID          uuid.UUID      `gorm:"primary_key"`
 	CityID      int64          `gorm:"not null"`
 	City        *City          `gorm:"foreignKey:CityID" json:"city,omitempty"`
 	CommodityID uuid.UUID      `gorm:"not null"`
 	Commodity   *Commodity     `gorm:"foreignKey:CommodityID;references:ID"`
+	BuyerID     *uuid.UUID     `gorm:"index"`
 	Quantity    float64        `gorm:"not null"`
 	Unit        string         `gorm:"not null;default:kg"`
 	Price       float64        `gorm:"not null"`
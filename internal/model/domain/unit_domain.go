@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// Dimensions of the registered units, only units of the same dimension convert into each other
+const (
+	UnitDimensionMass = "mass"
+	UnitDimensionArea = "area"
+
+	// BaseUnit is the unit prices are quoted per, quantities default to it as well
+	BaseUnit     = "kg"
+	BaseAreaUnit = "m2"
+)
+
+// Unit converts to the base unit of its dimension by Factor, kg for mass and m2 for area
+type Unit struct {
+	Code      string    `gorm:"primaryKey;type:varchar(20)"`
+	Name      string    `gorm:"not null;type:varchar(100)"`
+	Dimension string    `gorm:"not null;type:varchar(20)"`
+	Factor    float64   `gorm:"not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
@@ -8,15 +8,22 @@ import (
 )
 
 type User struct {
-	ID        uuid.UUID      `gorm:"primaryKey;type:varchar(36)"`
-	Name      string         `gorm:"size:100;not null;type:varchar(100)"`
-	Email     string         `gorm:"unique;not null;type:varchar(255)"`
-	Password  string         `gorm:"not null;type:varchar(255)"`
-	RoleID    int64          `gorm:"not null;default:1"`
-	Role      Role           `gorm:"foreignKey:RoleID"`
-	Phone     *string        `gorm:"type:varchar(20)"`
-	Verified  bool           `gorm:"not null;default:false"`
-	CreatedAt time.Time      `gorm:"autoCreateTime"`
-	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
-	DeletedAt gorm.DeletedAt `gorm:"index"`
+	ID       uuid.UUID `gorm:"primaryKey;type:varchar(36)"`
+	Name     string    `gorm:"size:100;not null;type:varchar(100)"`
+	Email    string    `gorm:"unique;not null;type:varchar(255)"`
+	Password string    `gorm:"not null;type:varchar(255)" json:"-"`
+	RoleID   int64     `gorm:"not null;default:1"`
+	Role     Role      `gorm:"foreignKey:RoleID"`
+	// ProvinceID is the region a field officer supervises
+	ProvinceID *int64         `gorm:"index"`
+	Province   *Province      `gorm:"foreignKey:ProvinceID" json:"-"`
+	Phone      *string        `gorm:"type:varchar(20)"`
+	Verified   bool           `gorm:"not null;default:false"`
+	CreatedAt  time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt  gorm.DeletedAt `gorm:"index"`
+
+	TOTPSecret    *string `gorm:"column:totp_secret;type:varchar(64)" json:"-"`
+	TOTPEnabled   bool    `gorm:"column:totp_enabled;not null;default:false"`
+	RecoveryCodes *string `gorm:"column:recovery_codes;type:text" json:"-"`
 }
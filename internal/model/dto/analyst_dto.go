@@ -0,0 +1,54 @@
+package dto
+
+import "github.com/ryvasa/go-super-farmer/internal/model/domain"
+
+type MarketCityDTO struct {
+	CityID    int64        `json:"city_id"`
+	City      *domain.City `json:"city,omitempty"`
+	Price     *float64     `json:"price,omitempty"`
+	PriceUnit string       `json:"price_unit,omitempty"`
+	Supply    float64      `json:"supply"`
+	Demand    float64      `json:"demand"`
+	Balance   float64      `json:"balance"`
+}
+
+type MarketOverviewDTO struct {
+	Commodity    *domain.Commodity `json:"commodity"`
+	AveragePrice float64           `json:"average_price"`
+	TotalSupply  float64           `json:"total_supply"`
+	TotalDemand  float64           `json:"total_demand"`
+	Cities       []*MarketCityDTO  `json:"cities"`
+}
+
+// PriceNeighborDTO is another city of the same province, Spread is its price minus the price of the city it is listed on
+type PriceNeighborDTO struct {
+	CityID int64        `json:"city_id"`
+	City   *domain.City `json:"city,omitempty"`
+	Price  float64      `json:"price"`
+	Spread float64      `json:"spread"`
+}
+
+// CityPriceComparisonDTO ranks a city by its current price, Supply and Demand are in kg.
+// HighPriceSurplus flags a city whose supply exceeds its demand while its price is above the national median
+type CityPriceComparisonDTO struct {
+	Rank             int               `json:"rank"`
+	CityID           int64             `json:"city_id"`
+	City             *domain.City      `json:"city,omitempty"`
+	ProvinceID       int64             `json:"province_id"`
+	Price            float64           `json:"price"`
+	Spread           float64           `json:"spread"`
+	SpreadPercent    float64           `json:"spread_percent"`
+	ProvinceMedian   float64           `json:"province_median"`
+	Neighbors        int               `json:"neighbors"`
+	CheapestNeighbor *PriceNeighborDTO `json:"cheapest_neighbor,omitempty"`
+	Supply           float64           `json:"supply"`
+	Demand           float64           `json:"demand"`
+	Surplus          bool              `json:"surplus"`
+	HighPriceSurplus bool              `json:"high_price_surplus"`
+}
+
+type PriceComparisonDTO struct {
+	Commodity      *domain.Commodity         `json:"commodity"`
+	NationalMedian float64                   `json:"national_median"`
+	Cities         []*CityPriceComparisonDTO `json:"cities"`
+}
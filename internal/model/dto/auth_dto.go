@@ -3,11 +3,16 @@ package dto
 type AuthDTO struct {
 	Email    string `json:"email" validate:"required,email,min=3,max=255"`
 	Password string `json:"password" validate:"required,min=6,max=255"`
+	IP       string `json:"-"`
 }
 
 type AuthResponseDTO struct {
-	User  *UserResponseDTO `json:"user"`
-	Token string           `json:"token"`
+	User                  *UserResponseDTO `json:"user,omitempty"`
+	Token                 string           `json:"token,omitempty"`
+	RefreshToken          string           `json:"refresh_token,omitempty"`
+	MFARequired           bool             `json:"mfa_required,omitempty"`
+	MFAToken              string           `json:"mfa_token,omitempty"`
+	MFAEnrollmentRequired bool             `json:"mfa_enrollment_required,omitempty"`
 }
 
 type AuthSendDTO struct {
@@ -17,4 +22,50 @@ type AuthSendDTO struct {
 type AuthVerifyDTO struct {
 	Email string `json:"email" validate:"required,email,min=3,max=255"`
 	OTP   string `json:"otp" validate:"required,min=6,max=6"`
+	IP    string `json:"-"`
+}
+
+type AuthRefreshDTO struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type AuthLogoutDTO struct {
+	RefreshToken string `json:"refresh_token" validate:"omitempty"`
+	All          bool   `json:"all"`
+}
+
+type AuthUnlockDTO struct {
+	Email string `json:"email" validate:"required,email,min=3,max=255"`
+	IP    string `json:"ip" validate:"omitempty,ip"`
+}
+
+type AuthForgotPasswordDTO struct {
+	Email string `json:"email" validate:"required,email,min=3,max=255"`
+}
+
+type AuthResetPasswordDTO struct {
+	Email    string `json:"email" validate:"required,email,min=3,max=255"`
+	OTP      string `json:"otp" validate:"required,min=6,max=6"`
+	Password string `json:"password" validate:"required,min=6,max=255"`
+	IP       string `json:"-"`
+}
+
+type AuthMFAVerifyDTO struct {
+	MFAToken     string `json:"mfa_token" validate:"required"`
+	Code         string `json:"code" validate:"required_without=RecoveryCode,omitempty,len=6,numeric"`
+	RecoveryCode string `json:"recovery_code" validate:"required_without=Code,omitempty,max=32"`
+	IP           string `json:"-"`
+}
+
+type AuthTOTPCodeDTO struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type AuthTOTPSetupResponseDTO struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type AuthRecoveryCodesResponseDTO struct {
+	RecoveryCodes []string `json:"recovery_codes"`
 }
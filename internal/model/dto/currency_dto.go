@@ -0,0 +1,11 @@
+package dto
+
+type CurrencyCreateDTO struct {
+	Code string `json:"code" validate:"required,len=3,alpha"`
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+type ExchangeRateCreateDTO struct {
+	Rate          float64 `json:"rate" validate:"required,gt=0"`
+	EffectiveDate string  `json:"effective_date" validate:"required"`
+}
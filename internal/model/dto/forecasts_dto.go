@@ -3,13 +3,116 @@ package dto
 import (
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/ryvasa/go-super-farmer/internal/model/domain"
 )
 
 type ForecastsResponseDTO struct {
+	ForecastID   uuid.UUID         `json:"forecastId"`
 	CurrentPrice float64           `json:"price"`
 	HarvestDate  time.Time         `json:"harvestDate"`
 	HarvestPrice float64           `json:"harvestPrice"`
 	Commodity    *domain.Commodity `json:"commodity"`
 	City         *domain.City      `json:"city"`
+	Engine       string            `json:"engine"`
+	ModelVersion string            `json:"modelVersion,omitempty"`
+}
+
+type ForecastJobCreateDTO struct {
+	LandCommodityID uuid.UUID `json:"land_commodity_id" validate:"required"`
+	CityID          int64     `json:"city_id" validate:"required"`
+}
+
+// ForecastAccuracyParamsDTO filters evaluated forecasts by commodity, city and harvest date, zero values match all
+type ForecastAccuracyParamsDTO struct {
+	CommodityID uuid.UUID `json:"commodity_id"`
+	CityID      int64     `json:"city_id"`
+	StartDate   time.Time `json:"start_date"`
+	EndDate     time.Time `json:"end_date"`
+}
+
+// ForecastAccuracyDTO compares the forecasts of one model with the actual harvest prices of a commodity in a city.
+// MAPE is in percent, nil when every harvest price was zero
+type ForecastAccuracyDTO struct {
+	CommodityID  uuid.UUID `json:"commodity_id"`
+	CityID       int64     `json:"city_id"`
+	Engine       string    `json:"engine"`
+	ModelVersion string    `json:"model_version"`
+	Count        int64     `json:"count"`
+	MAE          float64   `json:"mae"`
+	MAPE         *float64  `json:"mape"`
+}
+
+// ForecastBacktestParamsDTO replays the price history of a commodity in a city through the baseline forecaster,
+// making a forecast HorizonWeeks ahead every week from StartDate to EndDate
+type ForecastBacktestParamsDTO struct {
+	CommodityID  uuid.UUID `json:"commodity_id" validate:"required"`
+	CityID       int64     `json:"city_id" validate:"required"`
+	StartDate    time.Time `json:"start_date"`
+	EndDate      time.Time `json:"end_date"`
+	HorizonWeeks int       `json:"horizon_weeks" validate:"omitempty,gte=1,lte=52"`
+}
+
+// ForecastBacktestPointDTO is one replayed forecast, made with the prices known in the week of ForecastedAt
+// for the week of TargetDate
+type ForecastBacktestPointDTO struct {
+	ForecastedAt   time.Time `json:"forecasted_at"`
+	TargetDate     time.Time `json:"target_date"`
+	PredictedPrice float64   `json:"predicted_price"`
+	ActualPrice    float64   `json:"actual_price"`
+	ModelVersion   string    `json:"model_version"`
+}
+
+type ForecastBacktestDTO struct {
+	CommodityID  uuid.UUID                   `json:"commodity_id"`
+	CityID       int64                       `json:"city_id"`
+	Engine       string                      `json:"engine"`
+	StartDate    time.Time                   `json:"start_date"`
+	EndDate      time.Time                   `json:"end_date"`
+	HorizonWeeks int                         `json:"horizon_weeks"`
+	Count        int                         `json:"count"`
+	MAE          *float64                    `json:"mae"`
+	MAPE         *float64                    `json:"mape"`
+	Points       []*ForecastBacktestPointDTO `json:"points"`
+}
+
+// Sources of the yield per ha of an area forecast
+const (
+	AreaYieldSourceArea     = "area"
+	AreaYieldSourceNational = "national"
+	AreaYieldSourceNone     = "none"
+)
+
+// AreaForecastParamsDTO selects the land commodities of a commodity in a city or in a province, not both
+type AreaForecastParamsDTO struct {
+	CommodityID uuid.UUID `json:"commodity_id" validate:"required"`
+	CityID      int64     `json:"city_id"`
+	ProvinceID  int64     `json:"province_id"`
+}
+
+// AreaHarvestWeekDTO is what the land commodities due in one week grow on, in ha, and are expected to yield, in kg
+type AreaHarvestWeekDTO struct {
+	Week            time.Time `json:"week"`
+	LandCommodities int       `json:"land_commodities"`
+	LandArea        float64   `json:"land_area"`
+	ExpectedYield   float64   `json:"expected_yield"`
+	ExpectedPrice   *float64  `json:"expected_price"`
+}
+
+// AreaForecastDTO adds up the land commodities of a commodity that are not harvested yet in a city or a province.
+// Land commodities recorded in an unknown area unit are only counted in SkippedLandCommodities.
+// The expected prices are averages weighted by land area, nil when no city of the area has a price
+type AreaForecastDTO struct {
+	Commodity              *domain.Commodity     `json:"commodity"`
+	City                   *domain.City          `json:"city,omitempty"`
+	Province               *domain.Province      `json:"province,omitempty"`
+	Engine                 string                `json:"engine"`
+	LandCommodities        int                   `json:"land_commodities"`
+	SkippedLandCommodities int                   `json:"skipped_land_commodities"`
+	LandArea               float64               `json:"land_area"`
+	YieldPerHectare        float64               `json:"yield_per_hectare"`
+	YieldSource            string                `json:"yield_source"`
+	ExpectedYield          float64               `json:"expected_yield"`
+	ExpectedPrice          *float64              `json:"expected_price"`
+	Harvests               []*AreaHarvestWeekDTO `json:"harvests"`
 }
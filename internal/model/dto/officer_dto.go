@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+)
+
+type OfficerProvinceDTO struct {
+	ProvinceID int64 `json:"province_id" validate:"required,gte=1"`
+}
+
+type OfficerFarmDTO struct {
+	LandID      uuid.UUID    `json:"land_id"`
+	LandArea    float64      `json:"land_area"`
+	Unit        string       `json:"unit"`
+	Certificate string       `json:"certificate"`
+	City        *domain.City `json:"city,omitempty"`
+	FarmerID    uuid.UUID    `json:"farmer_id"`
+	FarmerName  string       `json:"farmer_name"`
+	FarmerPhone *string      `json:"farmer_phone,omitempty"`
+}
+
+type OfficerDashboardDTO struct {
+	Province      *domain.Province  `json:"province"`
+	TotalFarms    int               `json:"total_farms"`
+	TotalFarmers  int               `json:"total_farmers"`
+	TotalLandArea float64           `json:"total_land_area"`
+	Farms         []*OfficerFarmDTO `json:"farms"`
+}
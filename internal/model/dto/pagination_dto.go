@@ -19,6 +19,7 @@ type ParamFilterDTO struct {
 	CityName      string     `json:"city_name" form:"city_name"`
 	CityID        *int64     `json:"city_id" form:"city_id"`
 	CommodityID   *uuid.UUID `json:"commodity_id" form:"commodity_id"`
+	BuyerID       *uuid.UUID `json:"-" form:"-"`
 	StartDate     time.Time  `json:"start_date" form:"start_date"`
 	EndDate       time.Time  `json:"end_date" form:"end_date"`
 }
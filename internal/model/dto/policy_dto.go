@@ -0,0 +1,12 @@
+package dto
+
+type PolicyDTO struct {
+	Subject string `json:"subject" validate:"required,max=100"`
+	Object  string `json:"object" validate:"required,startswith=/api/,max=100"`
+	Action  string `json:"action" validate:"required,oneof=GET POST PUT PATCH DELETE *"`
+}
+
+type PolicyUpdateDTO struct {
+	Old PolicyDTO `json:"old" validate:"required"`
+	New PolicyDTO `json:"new" validate:"required"`
+}
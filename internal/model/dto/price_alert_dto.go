@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PriceAlertCreateDTO struct {
+	CommodityID     uuid.UUID `json:"commodity_id" validate:"required"`
+	CityID          int64     `json:"city_id" validate:"required"`
+	Condition       string    `json:"condition" validate:"required,oneof=above below change"`
+	TargetPrice     *float64  `json:"target_price" validate:"required_unless=Condition change,omitempty,gt=0"`
+	ChangePercent   *float64  `json:"change_percent" validate:"required_if=Condition change,omitempty,gt=0,lte=1000"`
+	WindowDays      int       `json:"window_days" validate:"omitempty,min=1,max=90"`
+	CooldownMinutes int       `json:"cooldown_minutes" validate:"omitempty,min=5,max=43200"`
+}
+
+type PriceAlertUpdateDTO struct {
+	TargetPrice     *float64 `json:"target_price" validate:"omitempty,gt=0"`
+	ChangePercent   *float64 `json:"change_percent" validate:"omitempty,gt=0,lte=1000"`
+	WindowDays      *int     `json:"window_days" validate:"omitempty,min=1,max=90"`
+	CooldownMinutes *int     `json:"cooldown_minutes" validate:"omitempty,min=5,max=43200"`
+	Active          *bool    `json:"active"`
+}
+
+type PriceAlertResponseDTO struct {
+	ID              uuid.UUID  `json:"id"`
+	CommodityID     uuid.UUID  `json:"commodity_id"`
+	CityID          int64      `json:"city_id"`
+	Condition       string     `json:"condition"`
+	TargetPrice     *float64   `json:"target_price,omitempty"`
+	ChangePercent   *float64   `json:"change_percent,omitempty"`
+	WindowDays      int        `json:"window_days"`
+	CooldownMinutes int        `json:"cooldown_minutes"`
+	Active          bool       `json:"active"`
+	LastTriggeredAt *time.Time `json:"last_triggered_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
@@ -6,14 +6,31 @@ import (
 	"github.com/google/uuid"
 )
 
+// PriceCreateDTO and PriceUpdateDTO are held for review when the price is far from the recent ones,
+// Force applies it anyway and only records the review. ChangedBy and Source are set from the request's credentials
 type PriceCreateDTO struct {
-	CommodityID uuid.UUID `json:"commodity_id" validate:"required"`
-	CityID      int64     `json:"city_id" validate:"required"`
-	Price       float64   `json:"price" validate:"required,min=1"`
+	CommodityID uuid.UUID  `json:"commodity_id" validate:"required"`
+	CityID      int64      `json:"city_id" validate:"required"`
+	Price       float64    `json:"price" validate:"required,min=1"`
+	Force       bool       `json:"force"`
+	Reason      string     `json:"reason" validate:"omitempty,max=255"`
+	ChangedBy   *uuid.UUID `json:"-"`
+	Source      string     `json:"-"`
 }
 
+// PriceUpdateDTO with an EffectiveAt is not applied now but scheduled, a scheduled change is checked once it is due
+// and held for review then, Force only applies to a change made now
 type PriceUpdateDTO struct {
-	Price float64 `json:"price" validate:"required,min=1"`
+	Price       float64    `json:"price" validate:"required,min=1"`
+	Force       bool       `json:"force"`
+	EffectiveAt *time.Time `json:"effective_at"`
+	Reason      string     `json:"reason" validate:"omitempty,max=255"`
+	ChangedBy   *uuid.UUID `json:"-"`
+	Source      string     `json:"-"`
+}
+
+type PriceReviewDecisionDTO struct {
+	Note string `json:"note" validate:"omitempty,max=500"`
 }
 
 type PriceResponseDTO struct {
@@ -42,3 +59,77 @@ type PriceParamsDTO struct {
 	StartDate   time.Time `json:"start_date" validate:"required"`
 	EndDate     time.Time `json:"end_date" validate:"required"`
 }
+
+// PriceHistorySeriesParamsDTO selects the range of a price chart, the dates are inclusive
+type PriceHistorySeriesParamsDTO struct {
+	CommodityID uuid.UUID `json:"commodity_id" validate:"required"`
+	CityID      int64     `json:"city_id" validate:"required"`
+	StartDate   time.Time `json:"start_date"`
+	EndDate     time.Time `json:"end_date"`
+	Interval    string    `json:"interval" validate:"omitempty,oneof=day week month"`
+}
+
+type PriceBucketDTO struct {
+	Period time.Time `json:"period"`
+	Open   float64   `json:"open"`
+	High   float64   `json:"high"`
+	Low    float64   `json:"low"`
+	Close  float64   `json:"close"`
+	Avg    float64   `json:"avg"`
+	Count  int64     `json:"count"`
+}
+
+type PriceHistorySeriesDTO struct {
+	CommodityID uuid.UUID         `json:"commodity_id"`
+	CityID      int64             `json:"city_id"`
+	Interval    string            `json:"interval"`
+	StartDate   time.Time         `json:"start_date"`
+	EndDate     time.Time         `json:"end_date"`
+	Buckets     []*PriceBucketDTO `json:"buckets"`
+}
+
+// PriceImportDTO is an uploaded csv or xlsx file with the columns commodity_code, city and price
+type PriceImportDTO struct {
+	FileName  string
+	Content   []byte
+	DryRun    bool
+	ChangedBy *uuid.UUID
+}
+
+// PriceImportRowDTO reports one data row, Row is the line in the file so the header is row 1
+type PriceImportRowDTO struct {
+	Row           int        `json:"row"`
+	CommodityCode string     `json:"commodity_code"`
+	City          string     `json:"city"`
+	Price         string     `json:"price"`
+	Status        string     `json:"status"`
+	PriceID       *uuid.UUID `json:"price_id,omitempty"`
+	PreviousPrice *float64   `json:"previous_price,omitempty"`
+	ReviewID      *uuid.UUID `json:"review_id,omitempty"`
+	Errors        []string   `json:"errors,omitempty"`
+}
+
+// PriceImportReportDTO is the outcome of an import, nothing is written unless every row is valid.
+// ChangedBy, Reason and Source are the audit values every written price gets
+type PriceImportReportDTO struct {
+	DryRun    bool                 `json:"dry_run"`
+	Committed bool                 `json:"committed"`
+	TotalRows int                  `json:"total_rows"`
+	Invalid   int                  `json:"invalid"`
+	Created   int                  `json:"created"`
+	Updated   int                  `json:"updated"`
+	Unchanged int                  `json:"unchanged"`
+	Held      int                  `json:"held"`
+	ChangedBy *uuid.UUID           `json:"changed_by,omitempty"`
+	Reason    string               `json:"reason"`
+	Source    string               `json:"source"`
+	Rows      []*PriceImportRowDTO `json:"rows"`
+}
+
+const (
+	PriceImportCreated   = "created"
+	PriceImportUpdated   = "updated"
+	PriceImportUnchanged = "unchanged"
+	PriceImportHeld      = "held"
+	PriceImportInvalid   = "invalid"
+)
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PriceEventCreated = "created"
+	PriceEventUpdated = "updated"
+	PriceEventDeleted = "deleted"
+	// PriceEventReset tells a resuming client that events were missed and it has to reload the current prices
+	PriceEventReset = "reset"
+)
+
+// PriceEventDTO is published on price-event-exchange after a price change is committed,
+// ID is what a client sends back as Last-Event-ID to resume the stream
+type PriceEventDTO struct {
+	ID            uuid.UUID `json:"id"`
+	Type          string    `json:"type"`
+	PriceID       uuid.UUID `json:"price_id"`
+	CommodityID   uuid.UUID `json:"commodity_id"`
+	CityID        int64     `json:"city_id"`
+	Price         float64   `json:"price"`
+	PreviousPrice *float64  `json:"previous_price,omitempty"`
+	Unit          string    `json:"unit,omitempty"`
+	Source        string    `json:"source,omitempty"`
+	OccurredAt    time.Time `json:"occurred_at"`
+}
+
+// PriceStreamParamsDTO filters the stream, a zero CommodityID or CityID matches every commodity or city
+type PriceStreamParamsDTO struct {
+	CommodityID uuid.UUID `json:"commodity_id"`
+	CityID      int64     `json:"city_id"`
+	LastEventID string    `json:"last_event_id"`
+}
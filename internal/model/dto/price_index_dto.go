@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PriceIndexParamsDTO selects the range and the base period of an index, the dates are inclusive.
+// ProvinceID 0 is the nationwide index
+type PriceIndexParamsDTO struct {
+	CommodityID   uuid.UUID `json:"commodity_id" validate:"required"`
+	ProvinceID    int64     `json:"province_id"`
+	StartDate     time.Time `json:"start_date"`
+	EndDate       time.Time `json:"end_date"`
+	BaseStartDate time.Time `json:"base_start_date"`
+	BaseEndDate   time.Time `json:"base_end_date"`
+	Interval      string    `json:"interval" validate:"omitempty,oneof=day week month"`
+}
+
+// CityPriceCloseDTO is the last price a city had in a period, Period is a YYYY-MM-DD date
+type CityPriceCloseDTO struct {
+	CityID     int64   `json:"city_id"`
+	ProvinceID int64   `json:"province_id"`
+	Period     string  `json:"period"`
+	Close      float64 `json:"close"`
+}
+
+// PriceIndexPointDTO is the index of one period, Price is the supply weighted average price of the cities it covers
+type PriceIndexPointDTO struct {
+	Period time.Time `json:"period"`
+	Index  *float64  `json:"index"`
+	Price  *float64  `json:"price"`
+	Cities int       `json:"cities"`
+}
+
+type PriceIndexDTO struct {
+	CommodityID   uuid.UUID             `json:"commodity_id"`
+	ProvinceID    *int64                `json:"province_id,omitempty"`
+	Province      string                `json:"province,omitempty"`
+	Interval      string                `json:"interval"`
+	StartDate     time.Time             `json:"start_date"`
+	EndDate       time.Time             `json:"end_date"`
+	BaseStartDate time.Time             `json:"base_start_date"`
+	BaseEndDate   time.Time             `json:"base_end_date"`
+	BasePrice     float64               `json:"base_price"`
+	Points        []*PriceIndexPointDTO `json:"points"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+type UnitCreateDTO struct {
+	Code      string  `json:"code" validate:"required,max=20"`
+	Name      string  `json:"name" validate:"required,max=100"`
+	Dimension string  `json:"dimension" validate:"required,oneof=mass area"`
+	Factor    float64 `json:"factor" validate:"required,gt=0"`
+}
+
+// UnitUpdateDTO cannot change the dimension, values already recorded in the unit would change meaning
+type UnitUpdateDTO struct {
+	Name   string  `json:"name" validate:"omitempty,max=100"`
+	Factor float64 `json:"factor" validate:"omitempty,gt=0"`
+}
+
+// ConversionParamsDTO is the ?unit= and ?currency= of a read endpoint, empty fields keep the stored values
+type ConversionParamsDTO struct {
+	Unit     string `form:"unit"`
+	Currency string `form:"currency"`
+}
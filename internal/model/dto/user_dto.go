@@ -18,17 +18,40 @@ type UserUpdateDTO struct {
 	Email    string `json:"email" validate:"omitempty,email"`
 	Password string `json:"password" validate:"omitempty,min=6,max=255"`
 	Phone    string `json:"phone" validate:"omitempty,min=3,max=20"`
-	RoleID   int64  `json:"role_id" validate:"omitempty,min=1,max=2"`
+	RoleID   int64  `json:"role_id" validate:"omitempty,min=1,max=5"`
+}
+
+// UserProfileUpdateDTO is what users may change on their own profile, email and password have their own flows
+type UserProfileUpdateDTO struct {
+	Name  string `json:"name" validate:"omitempty,min=3,max=255"`
+	Phone string `json:"phone" validate:"omitempty,min=3,max=20"`
+}
+
+type UserChangePasswordDTO struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,min=6,max=255,nefield=CurrentPassword"`
+}
+
+type UserEmailChangeDTO struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type UserEmailConfirmDTO struct {
+	OTP string `json:"otp" validate:"required,len=6"`
+}
+
+type UserDeleteAccountDTO struct {
+	Password string `json:"password" validate:"required"`
 }
 
 type UserResponseDTO struct {
-	ID        uuid.UUID  `json:"id"`
-	Name      string     `json:"name"`
-	Email     string     `json:"email"`
-	Phone     *string    `json:"phone,omitempty"`
-	Password  string     `json:"password,omitempty"`
-	RoleID    int64      `json:"role_id,omitempty"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Email      string     `json:"email"`
+	Phone      *string    `json:"phone,omitempty"`
+	RoleID     int64      `json:"role_id,omitempty"`
+	ProvinceID *int64     `json:"province_id,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
 }
@@ -0,0 +1,58 @@
+package repository_implementation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	"gorm.io/gorm"
+)
+
+type APIKeyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) repository_interface.APIKeyRepository {
+	return &APIKeyRepositoryImpl{db}
+}
+
+func (r *APIKeyRepositoryImpl) Create(ctx context.Context, apiKey *domain.APIKey) error {
+	return r.db.WithContext(ctx).Create(apiKey).Error
+}
+
+func (r *APIKeyRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.APIKey, error) {
+	var apiKey domain.APIKey
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&apiKey).Error
+	if err != nil {
+		return nil, err
+	}
+	return &apiKey, nil
+}
+
+func (r *APIKeyRepositoryImpl) FindByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error) {
+	var apiKey domain.APIKey
+	err := r.db.WithContext(ctx).Where("prefix = ?", prefix).First(&apiKey).Error
+	if err != nil {
+		return nil, err
+	}
+	return &apiKey, nil
+}
+
+func (r *APIKeyRepositoryImpl) FindByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*domain.APIKey, error) {
+	var apiKeys []*domain.APIKey
+	err := r.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("created_at desc").Find(&apiKeys).Error
+	if err != nil {
+		return nil, err
+	}
+	return apiKeys, nil
+}
+
+func (r *APIKeyRepositoryImpl) UpdateLastUsed(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&domain.APIKey{}).Where("id = ?", id).UpdateColumn("last_used_at", lastUsedAt).Error
+}
+
+func (r *APIKeyRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&domain.APIKey{}).Error
+}
@@ -2,6 +2,7 @@ package repository_implementation
 
 import (
 	"context"
+	"strings"
 
 	"github.com/ryvasa/go-super-farmer/internal/model/domain"
 	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
@@ -29,6 +30,19 @@ func (r *CityRepositoryImpl) FindByID(ctx context.Context, id int64) (*domain.Ci
 	return &city, nil
 }
 
+// FindByNames matches names case insensitively, a name can match cities of several provinces
+func (r *CityRepositoryImpl) FindByNames(ctx context.Context, names []string) ([]*domain.City, error) {
+	lowered := make([]string, len(names))
+	for i, name := range names {
+		lowered[i] = strings.ToLower(name)
+	}
+	var cities []*domain.City
+	if err := r.db.WithContext(ctx).Where("LOWER(name) IN ?", lowered).Find(&cities).Error; err != nil {
+		return nil, err
+	}
+	return cities, nil
+}
+
 func (r *CityRepositoryImpl) FindAll(ctx context.Context) ([]*domain.City, error) {
 	var cities []*domain.City
 	if err := r.db.WithContext(ctx).Find(&cities).Error; err != nil {
@@ -32,6 +32,14 @@ func (r *CommodityRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*
 	return &commodity, nil
 }
 
+func (r *CommodityRepositoryImpl) FindByCodes(ctx context.Context, codes []string) ([]*domain.Commodity, error) {
+	var commodities []*domain.Commodity
+	if err := r.db.WithContext(ctx).Where("code IN ?", codes).Find(&commodities).Error; err != nil {
+		return nil, err
+	}
+	return commodities, nil
+}
+
 func (r *CommodityRepositoryImpl) FindAll(ctx context.Context, params *dto.PaginationDTO) ([]*domain.Commodity, error) {
 	var commodities []*domain.Commodity
 
@@ -0,0 +1,58 @@
+package repository_implementation
+
+import (
+	"context"
+
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CurrencyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewCurrencyRepository(db *gorm.DB) repository_interface.CurrencyRepository {
+	return &CurrencyRepositoryImpl{db}
+}
+
+func (r *CurrencyRepositoryImpl) Create(ctx context.Context, currency *domain.Currency) error {
+	return r.db.WithContext(ctx).Create(currency).Error
+}
+
+func (r *CurrencyRepositoryImpl) FindAll(ctx context.Context) ([]*domain.Currency, error) {
+	var currencies []*domain.Currency
+	if err := r.db.WithContext(ctx).Order("code").Find(&currencies).Error; err != nil {
+		return nil, err
+	}
+	return currencies, nil
+}
+
+func (r *CurrencyRepositoryImpl) FindByCode(ctx context.Context, code string) (*domain.Currency, error) {
+	var currency domain.Currency
+	err := r.db.WithContext(ctx).Where("code = ?", code).First(&currency).Error
+	if err != nil {
+		return nil, err
+	}
+	return &currency, nil
+}
+
+// UpsertRate replaces the rate of the currency on the same effective date, rate gets the id of the stored row
+func (r *CurrencyRepositoryImpl) UpsertRate(ctx context.Context, rate *domain.ExchangeRate) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "currency_code"}, {Name: "effective_date"}},
+			DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+		}, clause.Returning{Columns: []clause.Column{{Name: "id"}, {Name: "created_at"}}}).
+		Create(rate).Error
+}
+
+func (r *CurrencyRepositoryImpl) FindRatesByCurrencyCode(ctx context.Context, code string) ([]*domain.ExchangeRate, error) {
+	var rates []*domain.ExchangeRate
+	err := r.db.WithContext(ctx).Where("currency_code = ?", code).Order("effective_date desc").Find(&rates).Error
+	if err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
@@ -0,0 +1,125 @@
+package repository_implementation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	"github.com/ryvasa/go-super-farmer/internal/repository"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ForecastRepositoryImpl struct {
+	repository.BaseRepository
+}
+
+func NewForecastRepository(db repository.BaseRepository) repository_interface.ForecastRepository {
+	return &ForecastRepositoryImpl{db}
+}
+
+// Create writes the forecast only, the commodity and city it carries are not saved
+func (r *ForecastRepositoryImpl) Create(ctx context.Context, forecast *domain.Forecast) error {
+	return r.DB(ctx).Omit(clause.Associations).Create(forecast).Error
+}
+
+func (r *ForecastRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.Forecast, error) {
+	var forecast domain.Forecast
+	err := r.DB(ctx).
+		Preload("Commodity", func(db *gorm.DB) *gorm.DB {
+			return db.Omit("CreatedAt", "UpdatedAt", "DeletedAt", "Description")
+		}).
+		Preload("City").
+		Where("id = ?", id).
+		First(&forecast).Error
+	if err != nil {
+		return nil, err
+	}
+	return &forecast, nil
+}
+
+// FindByLandCommodityID returns the forecasts of a land commodity, the latest first
+func (r *ForecastRepositoryImpl) FindByLandCommodityID(ctx context.Context, landCommodityID uuid.UUID) ([]*domain.Forecast, error) {
+	forecasts := []*domain.Forecast{}
+	err := r.DB(ctx).
+		Where("land_commodity_id = ?", landCommodityID).
+		Order("created_at desc").
+		Find(&forecasts).Error
+	if err != nil {
+		return nil, err
+	}
+	return forecasts, nil
+}
+
+// Update writes the outcome of a pending forecast, its inputs never change.
+// It returns false when the forecast was finished in the meantime
+func (r *ForecastRepositoryImpl) Update(ctx context.Context, forecast *domain.Forecast) (bool, error) {
+	result := r.DB(ctx).
+		Model(&domain.Forecast{}).
+		Where("id = ? AND status = ?", forecast.ID, domain.ForecastPending).
+		Select("status", "engine", "model_version", "predicted_price", "error", "completed_at").
+		Updates(forecast)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// FindUnevaluated returns completed forecasts whose harvest date is before harvestedBefore and that were not
+// compared with the actual price yet, the earliest harvest first
+func (r *ForecastRepositoryImpl) FindUnevaluated(ctx context.Context, harvestedBefore time.Time, limit int) ([]*domain.Forecast, error) {
+	forecasts := []*domain.Forecast{}
+	err := r.DB(ctx).
+		Where("status = ? AND evaluated_at IS NULL AND harvest_date < ?", domain.ForecastCompleted, harvestedBefore).
+		Order("harvest_date asc").
+		Limit(limit).
+		Find(&forecasts).Error
+	if err != nil {
+		return nil, err
+	}
+	return forecasts, nil
+}
+
+// Evaluate writes the actual price of a forecast, evaluating it again writes the same price
+func (r *ForecastRepositoryImpl) Evaluate(ctx context.Context, forecast *domain.Forecast) error {
+	return r.DB(ctx).
+		Model(&domain.Forecast{}).
+		Where("id = ?", forecast.ID).
+		Select("actual_price", "evaluated_at").
+		Updates(forecast).Error
+}
+
+// FindAccuracy returns the mean absolute error and the mean absolute percentage error of the evaluated forecasts
+// per commodity, city and model. Harvests with a zero price are left out of the percentage
+func (r *ForecastRepositoryImpl) FindAccuracy(ctx context.Context, params *dto.ForecastAccuracyParamsDTO) ([]*dto.ForecastAccuracyDTO, error) {
+	accuracy := []*dto.ForecastAccuracyDTO{}
+	query := r.DB(ctx).
+		Model(&domain.Forecast{}).
+		Select(`commodity_id, city_id, engine, model_version, COUNT(*) AS count,
+			AVG(ABS(predicted_price - actual_price)) AS mae,
+			AVG(ABS(predicted_price - actual_price) / actual_price) FILTER (WHERE actual_price > 0) * 100 AS mape`).
+		Where("actual_price IS NOT NULL")
+	if params.CommodityID != uuid.Nil {
+		query = query.Where("commodity_id = ?", params.CommodityID)
+	}
+	if params.CityID != 0 {
+		query = query.Where("city_id = ?", params.CityID)
+	}
+	if !params.StartDate.IsZero() {
+		query = query.Where("harvest_date >= ?", params.StartDate)
+	}
+	if !params.EndDate.IsZero() {
+		query = query.Where("harvest_date < ?", params.EndDate.AddDate(0, 0, 1))
+	}
+	err := query.
+		Group("commodity_id, city_id, engine, model_version").
+		Order("commodity_id, city_id, engine, model_version").
+		Scan(&accuracy).Error
+	if err != nil {
+		return nil, err
+	}
+	return accuracy, nil
+}
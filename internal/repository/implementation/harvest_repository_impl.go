@@ -54,6 +54,23 @@ func (r *HarvestRepositoryImpl) FindByCommodityID(ctx context.Context, id uuid.U
 	return harvests, nil
 }
 
+// FindByCommodityIDAndArea returns the harvests of a commodity, with their land commodity, on the lands of a city
+// or, when cityID is 0, of a province. Both 0 returns the harvests of every area
+func (r *HarvestRepositoryImpl) FindByCommodityIDAndArea(ctx context.Context, commodityID uuid.UUID, cityID, provinceID int64) ([]*domain.Harvest, error) {
+	var harvests []*domain.Harvest
+
+	if err := r.db.WithContext(ctx).
+		Preload("LandCommodity").
+		Joins("JOIN land_commodities ON harvests.land_commodity_id = land_commodities.id").
+		Joins("JOIN lands ON lands.id = land_commodities.land_id").
+		Where("land_commodities.commodity_id = ?", commodityID).
+		Scopes(applyAreaFilter(cityID, provinceID)).
+		Find(&harvests).Error; err != nil {
+		return nil, err
+	}
+	return harvests, nil
+}
+
 func (r *HarvestRepositoryImpl) FindByLandID(ctx context.Context, id uuid.UUID) ([]*domain.Harvest, error) {
 	var harvests []*domain.Harvest
 
@@ -23,6 +23,21 @@ func applyFilters2(filter *dto.LandAreaParamsDTO) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
+// applyAreaFilter narrows a query joined with lands to a city, or to a province when cityID is 0.
+// Both 0 leaves it nationwide
+func applyAreaFilter(cityID, provinceID int64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if cityID != 0 {
+			return db.Where("lands.city_id = ?", cityID)
+		}
+		if provinceID != 0 {
+			return db.Joins("JOIN cities ON cities.id = lands.city_id").
+				Where("cities.province_id = ?", provinceID)
+		}
+		return db
+	}
+}
+
 type LandCommodityRepositoryImpl struct {
 	db *gorm.DB
 }
@@ -79,6 +94,22 @@ func (r *LandCommodityRepositoryImpl) FindByCommodityID(ctx context.Context, id
 	return landCommodities, nil
 }
 
+// FindActiveByArea returns the land commodities of a commodity that are not harvested yet, with their land,
+// on the lands of a city or, when cityID is 0, of a province
+func (r *LandCommodityRepositoryImpl) FindActiveByArea(ctx context.Context, commodityID uuid.UUID, cityID, provinceID int64) ([]*domain.LandCommodity, error) {
+	var landCommodities []*domain.LandCommodity
+	err := r.db.WithContext(ctx).
+		Preload("Land").
+		Joins("JOIN lands ON lands.id = land_commodities.land_id AND lands.deleted_at IS NULL").
+		Where("land_commodities.commodity_id = ? AND land_commodities.harvested = ?", commodityID, false).
+		Scopes(applyAreaFilter(cityID, provinceID)).
+		Find(&landCommodities).Error
+	if err != nil {
+		return nil, err
+	}
+	return landCommodities, nil
+}
+
 func (r *LandCommodityRepositoryImpl) Update(ctx context.Context, id uuid.UUID, landCommodity *domain.LandCommodity) error {
 	err := r.db.WithContext(ctx).Model(&domain.LandCommodity{}).Where("id = ?", id).Updates(landCommodity).Error
 	if err != nil {
@@ -65,6 +65,24 @@ func (r *LandRepositoryImpl) FindAll(ctx context.Context) ([]*domain.Land, error
 	return lands, nil
 }
 
+// FindByProvinceID returns the lands of every city in the province together with their city and owner
+func (r *LandRepositoryImpl) FindByProvinceID(ctx context.Context, provinceID int64) ([]*domain.Land, error) {
+	var lands []*domain.Land
+	err := r.db.WithContext(ctx).
+		Joins("JOIN cities ON cities.id = lands.city_id").
+		Where("cities.province_id = ?", provinceID).
+		Preload("City").
+		Preload("User", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "name", "email", "phone")
+		}).
+		Order("lands.created_at desc").
+		Find(&lands).Error
+	if err != nil {
+		return nil, err
+	}
+	return lands, nil
+}
+
 func (r *LandRepositoryImpl) Update(ctx context.Context, id uuid.UUID, land *domain.Land) error {
 	err := r.db.WithContext(ctx).Model(&domain.Land{}).Where("id = ?", id).Updates(land).Error
 	if err != nil {
@@ -0,0 +1,73 @@
+package repository_implementation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	"gorm.io/gorm"
+)
+
+type PriceAlertRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPriceAlertRepository(db *gorm.DB) repository_interface.PriceAlertRepository {
+	return &PriceAlertRepositoryImpl{db}
+}
+
+func (r *PriceAlertRepositoryImpl) Create(ctx context.Context, alert *domain.PriceAlert) error {
+	return r.db.WithContext(ctx).Create(alert).Error
+}
+
+func (r *PriceAlertRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.PriceAlert, error) {
+	var alert domain.PriceAlert
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&alert).Error
+	if err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+func (r *PriceAlertRepositoryImpl) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.PriceAlert, error) {
+	var alerts []*domain.PriceAlert
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at desc").Find(&alerts).Error
+	if err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+// FindActiveByCommodityIDAndCityID loads the subscribers of a price together with the address to notify
+func (r *PriceAlertRepositoryImpl) FindActiveByCommodityIDAndCityID(ctx context.Context, commodityID uuid.UUID, cityID int64) ([]*domain.PriceAlert, error) {
+	var alerts []*domain.PriceAlert
+	err := r.db.WithContext(ctx).
+		Preload("User", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "name", "email")
+		}).
+		Where("commodity_id = ? AND city_id = ? AND active = ?", commodityID, cityID, true).
+		Find(&alerts).Error
+	if err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+// Update writes the editable columns even when they are zero, so an alert can be paused
+func (r *PriceAlertRepositoryImpl) Update(ctx context.Context, alert *domain.PriceAlert) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.PriceAlert{}).
+		Where("id = ?", alert.ID).
+		Select("target_price", "change_percent", "window_days", "cooldown_minutes", "active").
+		Updates(alert).Error
+}
+
+func (r *PriceAlertRepositoryImpl) UpdateLastTriggered(ctx context.Context, id uuid.UUID, triggeredAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&domain.PriceAlert{}).Where("id = ?", id).UpdateColumn("last_triggered_at", triggeredAt).Error
+}
+
+func (r *PriceAlertRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&domain.PriceAlert{}).Error
+}
@@ -2,9 +2,11 @@ package repository_implementation
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
 	"github.com/ryvasa/go-super-farmer/internal/repository"
 	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
 	"gorm.io/gorm"
@@ -46,3 +48,110 @@ func (r *PriceHistoryRepositoryImpl) FindByCommodityIDAndCityID(ctx context.Cont
 
 	return priceHistories, nil
 }
+
+// FindLatestBefore returns the price that was in effect at before, a history row is valid from its updated_at
+func (r *PriceHistoryRepositoryImpl) FindLatestBefore(ctx context.Context, commodityID uuid.UUID, cityID int64, before time.Time) (*domain.PriceHistory, error) {
+	var priceHistory domain.PriceHistory
+	err := r.DB(ctx).
+		Where("commodity_id = ? AND city_id = ? AND updated_at <= ?", commodityID, cityID, before).
+		Order("updated_at desc").
+		First(&priceHistory).Error
+	if err != nil {
+		return nil, err
+	}
+	return &priceHistory, nil
+}
+
+// FindRecentPrices returns the last limit prices a commodity had in a city, newest first
+func (r *PriceHistoryRepositoryImpl) FindRecentPrices(ctx context.Context, commodityID uuid.UUID, cityID int64, limit int) ([]float64, error) {
+	prices := []float64{}
+	err := r.DB(ctx).
+		Model(&domain.PriceHistory{}).
+		Where("commodity_id = ? AND city_id = ?", commodityID, cityID).
+		Order("updated_at desc").
+		Limit(limit).
+		Pluck("price", &prices).Error
+	if err != nil {
+		return nil, err
+	}
+	return prices, nil
+}
+
+// priceBucketQuery groups every price a commodity had in a city into date_trunc periods.
+// A history row keeps the updated_at of the price it replaced, which is when that value was set,
+// and the current price is included so the last bucket closes at today's value.
+const priceBucketQuery = `
+WITH points AS (
+	SELECT price, updated_at AS observed_at FROM price_histories
+	WHERE commodity_id = ? AND city_id = ? AND deleted_at IS NULL
+	UNION ALL
+	SELECT price, updated_at AS observed_at FROM prices
+	WHERE commodity_id = ? AND city_id = ? AND deleted_at IS NULL
+)
+SELECT date_trunc(?, observed_at) AS period,
+	(array_agg(price ORDER BY observed_at ASC))[1] AS open,
+	MAX(price) AS high,
+	MIN(price) AS low,
+	(array_agg(price ORDER BY observed_at DESC))[1] AS close,
+	AVG(price) AS avg,
+	COUNT(*) AS count
+FROM points
+WHERE observed_at >= ? AND observed_at < ?
+GROUP BY period
+ORDER BY period`
+
+// FindBuckets returns open, high, low, close and average prices per interval between start and end (exclusive)
+func (r *PriceHistoryRepositoryImpl) FindBuckets(ctx context.Context, commodityID uuid.UUID, cityID int64, interval string, start, end time.Time) ([]*dto.PriceBucketDTO, error) {
+	buckets := []*dto.PriceBucketDTO{}
+	err := r.DB(ctx).
+		Raw(priceBucketQuery, commodityID, cityID, commodityID, cityID, interval, start, end).
+		Scan(&buckets).Error
+	if err != nil {
+		return nil, err
+	}
+	return buckets, nil
+}
+
+// priceCityCloseQuery returns the last price of every city in each date_trunc period, optionally within a province.
+// The last price of each city before start is included so prices can be carried into the first periods.
+const priceCityCloseQuery = `
+WITH points AS (
+	SELECT city_id, price, updated_at AS observed_at FROM price_histories
+	WHERE commodity_id = ? AND deleted_at IS NULL
+	UNION ALL
+	SELECT city_id, price, updated_at AS observed_at FROM prices
+	WHERE commodity_id = ? AND deleted_at IS NULL
+),
+scoped AS (
+	SELECT points.city_id, cities.province_id, points.price, points.observed_at FROM points
+	JOIN cities ON cities.id = points.city_id
+	WHERE ? = 0 OR cities.province_id = ?
+),
+opening AS (
+	SELECT DISTINCT ON (city_id) city_id, province_id, price, observed_at FROM scoped
+	WHERE observed_at < ?
+	ORDER BY city_id, observed_at DESC
+)
+SELECT city_id, province_id,
+	to_char(date_trunc(?, observed_at), 'YYYY-MM-DD') AS period,
+	(array_agg(price ORDER BY observed_at DESC))[1] AS close
+FROM (
+	SELECT * FROM scoped WHERE observed_at >= ? AND observed_at < ?
+	UNION ALL
+	SELECT * FROM opening
+) AS ranged
+GROUP BY city_id, province_id, period
+ORDER BY period, city_id`
+
+// FindCityCloses returns the closing price per city and interval between start and end (exclusive),
+// provinceID 0 covers every province
+func (r *PriceHistoryRepositoryImpl) FindCityCloses(ctx context.Context, commodityID uuid.UUID, provinceID int64, interval string, start, end time.Time) ([]*dto.CityPriceCloseDTO, error) {
+	closes := []*dto.CityPriceCloseDTO{}
+	err := r.DB(ctx).
+		Raw(priceCityCloseQuery, commodityID, commodityID, provinceID, provinceID, start, interval, start, end).
+		Scan(&closes).Error
+	if err != nil {
+		return nil, err
+	}
+	return closes, nil
+}
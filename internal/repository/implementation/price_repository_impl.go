@@ -10,6 +10,7 @@ import (
 	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
 	"github.com/ryvasa/go-super-farmer/utils"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PriceRepositoryImpl struct {
@@ -94,8 +95,9 @@ func (r *PriceRepositoryImpl) FindByCityID(ctx context.Context, cityID int64) ([
 	return prices, nil
 }
 
+// Update writes a new price with who changed it and why, an empty reason replaces the reason of the previous change
 func (r *PriceRepositoryImpl) Update(ctx context.Context, id uuid.UUID, price *domain.Price) error {
-	return r.DB(ctx).Model(&domain.Price{}).Where("id = ?", id).Updates(price).Error
+	return r.DB(ctx).Model(&domain.Price{}).Where("id = ?", id).Select("price", "changed_by", "reason", "source").Updates(price).Error
 
 }
 
@@ -142,6 +144,19 @@ func (r *PriceRepositoryImpl) FindByCommodityIDAndCityID(ctx context.Context, co
 	return &price, nil
 }
 
+// FindByCommodityIDsAndCityIDs locks every price of the given commodities in the given cities for the running transaction
+func (r *PriceRepositoryImpl) FindByCommodityIDsAndCityIDs(ctx context.Context, commodityIDs []uuid.UUID, cityIDs []int64) ([]*domain.Price, error) {
+	var prices []*domain.Price
+	err := r.DB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("commodity_id IN ? AND city_id IN ?", commodityIDs, cityIDs).
+		Find(&prices).Error
+	if err != nil {
+		return nil, err
+	}
+	return prices, nil
+}
+
 func (r *PriceRepositoryImpl) Count(ctx context.Context, filter *dto.ParamFilterDTO) (int64, error) {
 	var count int64
 	err := r.DB(ctx).
@@ -0,0 +1,69 @@
+package repository_implementation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/repository"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	"gorm.io/gorm"
+)
+
+type PriceReviewRepositoryImpl struct {
+	repository.BaseRepository
+}
+
+func NewPriceReviewRepository(db repository.BaseRepository) repository_interface.PriceReviewRepository {
+	return &PriceReviewRepositoryImpl{db}
+}
+
+func (r *PriceReviewRepositoryImpl) Create(ctx context.Context, review *domain.PriceReview) error {
+	return r.DB(ctx).Create(review).Error
+}
+
+func (r *PriceReviewRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.PriceReview, error) {
+	var review domain.PriceReview
+	err := r.DB(ctx).
+		Preload("Commodity", func(db *gorm.DB) *gorm.DB {
+			return db.Omit("CreatedAt", "UpdatedAt", "DeletedAt", "Description")
+		}).
+		Preload("City").
+		Where("id = ?", id).
+		First(&review).Error
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// FindAll returns the newest reviews first, an empty status returns every review
+func (r *PriceReviewRepositoryImpl) FindAll(ctx context.Context, status string) ([]*domain.PriceReview, error) {
+	reviews := []*domain.PriceReview{}
+	query := r.DB(ctx).
+		Preload("Commodity", func(db *gorm.DB) *gorm.DB {
+			return db.Omit("CreatedAt", "UpdatedAt", "DeletedAt", "Description")
+		}).
+		Preload("City")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Order("created_at desc").Find(&reviews).Error; err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// Update writes the outcome of an open review, the checked values never change.
+// It returns false when the review was decided in the meantime
+func (r *PriceReviewRepositoryImpl) Update(ctx context.Context, review *domain.PriceReview) (bool, error) {
+	result := r.DB(ctx).
+		Model(&domain.PriceReview{}).
+		Where("id = ? AND status IN ?", review.ID, []string{domain.PriceReviewPending, domain.PriceReviewForced}).
+		Select("price_id", "status", "reviewed_by", "reviewed_at", "note").
+		Updates(review)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,87 @@
+package repository_implementation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/repository"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PriceScheduleRepositoryImpl struct {
+	repository.BaseRepository
+}
+
+func NewPriceScheduleRepository(db repository.BaseRepository) repository_interface.PriceScheduleRepository {
+	return &PriceScheduleRepositoryImpl{db}
+}
+
+func (r *PriceScheduleRepositoryImpl) Create(ctx context.Context, schedule *domain.PriceSchedule) error {
+	return r.DB(ctx).Create(schedule).Error
+}
+
+func (r *PriceScheduleRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.PriceSchedule, error) {
+	var schedule domain.PriceSchedule
+	err := r.DB(ctx).
+		Preload("Commodity", func(db *gorm.DB) *gorm.DB {
+			return db.Omit("CreatedAt", "UpdatedAt", "DeletedAt", "Description")
+		}).
+		Preload("City").
+		Where("id = ?", id).
+		First(&schedule).Error
+	if err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// FindAll returns the schedules in the order they take effect, an empty status returns every schedule
+func (r *PriceScheduleRepositoryImpl) FindAll(ctx context.Context, status string) ([]*domain.PriceSchedule, error) {
+	schedules := []*domain.PriceSchedule{}
+	query := r.DB(ctx).
+		Preload("Commodity", func(db *gorm.DB) *gorm.DB {
+			return db.Omit("CreatedAt", "UpdatedAt", "DeletedAt", "Description")
+		}).
+		Preload("City")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Order("effective_at asc, created_at asc").Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// FindDue returns the pending schedules due at now in the order they take effect. The rows are locked
+// and rows locked by another instance are skipped, so within a transaction every schedule is applied once
+func (r *PriceScheduleRepositoryImpl) FindDue(ctx context.Context, now time.Time, limit int) ([]*domain.PriceSchedule, error) {
+	schedules := []*domain.PriceSchedule{}
+	err := r.DB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND effective_at <= ?", domain.PriceSchedulePending, now).
+		Order("effective_at asc, created_at asc").
+		Limit(limit).
+		Find(&schedules).Error
+	if err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// Update writes the outcome of a pending schedule, the change itself never changes.
+// It returns false when the schedule was applied or cancelled in the meantime
+func (r *PriceScheduleRepositoryImpl) Update(ctx context.Context, schedule *domain.PriceSchedule) (bool, error) {
+	result := r.DB(ctx).
+		Model(&domain.PriceSchedule{}).
+		Where("id = ? AND status = ?", schedule.ID, domain.PriceSchedulePending).
+		Select("status", "applied_at", "cancelled_at").
+		Updates(schedule)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -2,6 +2,7 @@ package repository_implementation
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/ryvasa/go-super-farmer/internal/model/domain"
@@ -135,3 +136,13 @@ func (r *SaleRepositoryImpl) FindByCommodityIDAndCityID(ctx context.Context, id
 	err := r.DB(ctx).Where("commodity_id = ? AND city_id = ?", id, cityID).Find(&sales).Limit(50).Error
 	return sales, err
 }
+
+// FindByCommodityIDAndCityIDBetween returns the sales of a commodity in a city dated from start up to end (exclusive)
+func (r *SaleRepositoryImpl) FindByCommodityIDAndCityIDBetween(ctx context.Context, id uuid.UUID, cityID int64, start, end time.Time) ([]*domain.Sale, error) {
+	var sales []*domain.Sale
+	err := r.DB(ctx).
+		Where("commodity_id = ? AND city_id = ? AND sale_date >= ? AND sale_date < ?", id, cityID, start, end).
+		Order("sale_date asc").
+		Find(&sales).Error
+	return sales, err
+}
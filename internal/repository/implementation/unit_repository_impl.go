@@ -0,0 +1,42 @@
+package repository_implementation
+
+import (
+	"context"
+
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	"gorm.io/gorm"
+)
+
+type UnitRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewUnitRepository(db *gorm.DB) repository_interface.UnitRepository {
+	return &UnitRepositoryImpl{db}
+}
+
+func (r *UnitRepositoryImpl) Create(ctx context.Context, unit *domain.Unit) error {
+	return r.db.WithContext(ctx).Create(unit).Error
+}
+
+func (r *UnitRepositoryImpl) FindAll(ctx context.Context) ([]*domain.Unit, error) {
+	var units []*domain.Unit
+	if err := r.db.WithContext(ctx).Order("dimension, factor").Find(&units).Error; err != nil {
+		return nil, err
+	}
+	return units, nil
+}
+
+func (r *UnitRepositoryImpl) FindByCode(ctx context.Context, code string) (*domain.Unit, error) {
+	var unit domain.Unit
+	err := r.db.WithContext(ctx).Where("code = ?", code).First(&unit).Error
+	if err != nil {
+		return nil, err
+	}
+	return &unit, nil
+}
+
+func (r *UnitRepositoryImpl) Update(ctx context.Context, code string, unit *domain.Unit) error {
+	return r.db.WithContext(ctx).Model(&domain.Unit{}).Where("code = ?", code).Updates(unit).Error
+}
@@ -2,12 +2,14 @@ package repository_implementation
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
-	"github.com/ryvasa/go-super-farmer/pkg/logrus"
 	"github.com/ryvasa/go-super-farmer/internal/model/domain"
 	"github.com/ryvasa/go-super-farmer/internal/model/dto"
 	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	"github.com/ryvasa/go-super-farmer/pkg/logrus"
 	"github.com/ryvasa/go-super-farmer/utils"
 	"gorm.io/gorm"
 )
@@ -84,6 +86,42 @@ func (r *UserRepositoryImpl) FindByEmail(ctx context.Context, email string) (*do
 	return &user, err
 }
 
+// FindAuthByID loads every column including credentials, only use it for auth flows
+func (r *UserRepositoryImpl) FindAuthByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	var user domain.User
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		Preload("Role").
+		First(&user).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// FindWithRoleByID loads the role and supervised province of a user without its credentials
+func (r *UserRepositoryImpl) FindWithRoleByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	var user domain.User
+	err := r.db.WithContext(ctx).
+		Select("users.id", "users.name", "users.email", "users.phone", "users.role_id", "users.province_id", "users.created_at", "users.updated_at").
+		Where("id = ?", id).
+		Preload("Role").
+		First(&user).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// UpdateTwoFactor writes the totp columns even when they are cleared
+func (r *UserRepositoryImpl) UpdateTwoFactor(ctx context.Context, id uuid.UUID, user *domain.User) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.User{}).
+		Where("id = ?", id).
+		Select("totp_secret", "totp_enabled", "recovery_codes").
+		Updates(user).Error
+}
+
 func (r *UserRepositoryImpl) Count(ctx context.Context, filter *dto.ParamFilterDTO) (int64, error) {
 	var count int64
 	err := r.db.WithContext(ctx).
@@ -97,3 +135,31 @@ func (r *UserRepositoryImpl) Count(ctx context.Context, filter *dto.ParamFilterD
 	}
 	return count, nil
 }
+
+// Anonymize scrubs the personal data of a user and soft deletes it, removes their api keys and pauses their price alerts,
+// lands and harvests keep pointing at the row so statistics stay intact
+func (r *UserRepositoryImpl) Anonymize(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&domain.User{}).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{
+				"name":           "Deleted User",
+				"email":          fmt.Sprintf("deleted-%s@deleted.invalid", id),
+				"phone":          nil,
+				"password":       "",
+				"verified":       false,
+				"province_id":    nil,
+				"totp_secret":    nil,
+				"totp_enabled":   false,
+				"recovery_codes": nil,
+				"deleted_at":     time.Now(),
+			}).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("owner_id = ?", id).Delete(&domain.APIKey{}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&domain.PriceAlert{}).Where("user_id = ?", id).Update("active", false).Error
+	})
+}
@@ -0,0 +1,18 @@
+package repository_interface
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, apiKey *domain.APIKey) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.APIKey, error)
+	FindByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error)
+	FindByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*domain.APIKey, error)
+	UpdateLastUsed(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -9,6 +9,7 @@ import (
 type CityRepository interface {
 	Create(ctx context.Context, city *domain.City) error
 	FindByID(ctx context.Context, id int64) (*domain.City, error)
+	FindByNames(ctx context.Context, names []string) ([]*domain.City, error)
 	FindAll(ctx context.Context) ([]*domain.City, error)
 	Update(ctx context.Context, id int64, city *domain.City) error
 	Delete(ctx context.Context, id int64) error
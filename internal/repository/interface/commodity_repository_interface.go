@@ -11,6 +11,7 @@ import (
 type CommodityRepository interface {
 	Create(ctx context.Context, land *domain.Commodity) error
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Commodity, error)
+	FindByCodes(ctx context.Context, codes []string) ([]*domain.Commodity, error)
 	FindAll(ctx context.Context, params *dto.PaginationDTO) ([]*domain.Commodity, error)
 	Update(ctx context.Context, id uuid.UUID, land *domain.Commodity) error
 	Delete(ctx context.Context, id uuid.UUID) error
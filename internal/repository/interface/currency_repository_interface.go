@@ -0,0 +1,15 @@
+package repository_interface
+
+import (
+	"context"
+
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+)
+
+type CurrencyRepository interface {
+	Create(ctx context.Context, currency *domain.Currency) error
+	FindAll(ctx context.Context) ([]*domain.Currency, error)
+	FindByCode(ctx context.Context, code string) (*domain.Currency, error)
+	UpsertRate(ctx context.Context, rate *domain.ExchangeRate) error
+	FindRatesByCurrencyCode(ctx context.Context, code string) ([]*domain.ExchangeRate, error)
+}
@@ -0,0 +1,20 @@
+package repository_interface
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+type ForecastRepository interface {
+	Create(ctx context.Context, forecast *domain.Forecast) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Forecast, error)
+	FindByLandCommodityID(ctx context.Context, landCommodityID uuid.UUID) ([]*domain.Forecast, error)
+	Update(ctx context.Context, forecast *domain.Forecast) (bool, error)
+	FindUnevaluated(ctx context.Context, harvestedBefore time.Time, limit int) ([]*domain.Forecast, error)
+	Evaluate(ctx context.Context, forecast *domain.Forecast) error
+	FindAccuracy(ctx context.Context, params *dto.ForecastAccuracyParamsDTO) ([]*dto.ForecastAccuracyDTO, error)
+}
@@ -12,6 +12,7 @@ type HarvestRepository interface {
 	FindAll(ctx context.Context) ([]*domain.Harvest, error)
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Harvest, error)
 	FindByCommodityID(ctx context.Context, id uuid.UUID) ([]*domain.Harvest, error)
+	FindByCommodityIDAndArea(ctx context.Context, commodityID uuid.UUID, cityID, provinceID int64) ([]*domain.Harvest, error)
 	FindByLandID(ctx context.Context, id uuid.UUID) ([]*domain.Harvest, error)
 	FindByLandCommodityID(ctx context.Context, id uuid.UUID) ([]*domain.Harvest, error)
 	FindByCityID(ctx context.Context, id int64) ([]*domain.Harvest, error)
@@ -14,6 +14,7 @@ type LandCommodityRepository interface {
 	FindByLandID(ctx context.Context, id uuid.UUID) ([]*domain.LandCommodity, error)
 	FindAll(ctx context.Context) ([]*domain.LandCommodity, error)
 	FindByCommodityID(ctx context.Context, id uuid.UUID) ([]*domain.LandCommodity, error)
+	FindActiveByArea(ctx context.Context, commodityID uuid.UUID, cityID, provinceID int64) ([]*domain.LandCommodity, error)
 	Update(ctx context.Context, id uuid.UUID, landCommodity *domain.LandCommodity) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	Restore(ctx context.Context, id uuid.UUID) error
@@ -13,6 +13,7 @@ type LandRepository interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Land, error)
 	FindByUserID(ctx context.Context, id uuid.UUID) ([]*domain.Land, error)
 	FindAll(ctx context.Context) ([]*domain.Land, error)
+	FindByProvinceID(ctx context.Context, provinceID int64) ([]*domain.Land, error)
 	Update(ctx context.Context, id uuid.UUID, land *domain.Land) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	Restore(ctx context.Context, id uuid.UUID) error
@@ -0,0 +1,19 @@
+package repository_interface
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+)
+
+type PriceAlertRepository interface {
+	Create(ctx context.Context, alert *domain.PriceAlert) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.PriceAlert, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.PriceAlert, error)
+	FindActiveByCommodityIDAndCityID(ctx context.Context, commodityID uuid.UUID, cityID int64) ([]*domain.PriceAlert, error)
+	Update(ctx context.Context, alert *domain.PriceAlert) error
+	UpdateLastTriggered(ctx context.Context, id uuid.UUID, triggeredAt time.Time) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
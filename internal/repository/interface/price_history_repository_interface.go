@@ -2,13 +2,19 @@ package repository_interface
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
 )
 
 type PriceHistoryRepository interface {
 	Create(ctx context.Context, priceHistory *domain.PriceHistory) error
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.PriceHistory, error)
 	FindByCommodityIDAndCityID(ctx context.Context, commodityID uuid.UUID, cityID int64) ([]*domain.PriceHistory, error)
+	FindLatestBefore(ctx context.Context, commodityID uuid.UUID, cityID int64, before time.Time) (*domain.PriceHistory, error)
+	FindRecentPrices(ctx context.Context, commodityID uuid.UUID, cityID int64, limit int) ([]float64, error)
+	FindBuckets(ctx context.Context, commodityID uuid.UUID, cityID int64, interval string, start, end time.Time) ([]*dto.PriceBucketDTO, error)
+	FindCityCloses(ctx context.Context, commodityID uuid.UUID, provinceID int64, interval string, start, end time.Time) ([]*dto.CityPriceCloseDTO, error)
 }
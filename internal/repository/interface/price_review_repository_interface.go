@@ -0,0 +1,15 @@
+package repository_interface
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+)
+
+type PriceReviewRepository interface {
+	Create(ctx context.Context, review *domain.PriceReview) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.PriceReview, error)
+	FindAll(ctx context.Context, status string) ([]*domain.PriceReview, error)
+	Update(ctx context.Context, review *domain.PriceReview) (bool, error)
+}
@@ -0,0 +1,17 @@
+package repository_interface
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+)
+
+type PriceScheduleRepository interface {
+	Create(ctx context.Context, schedule *domain.PriceSchedule) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.PriceSchedule, error)
+	FindAll(ctx context.Context, status string) ([]*domain.PriceSchedule, error)
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*domain.PriceSchedule, error)
+	Update(ctx context.Context, schedule *domain.PriceSchedule) (bool, error)
+}
@@ -2,6 +2,7 @@ package repository_interface
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/ryvasa/go-super-farmer/internal/model/domain"
@@ -22,4 +23,5 @@ type SaleRepository interface {
 	Count(ctx context.Context, filter *dto.ParamFilterDTO) (int64, error)
 	DeletedCount(ctx context.Context, filter *dto.ParamFilterDTO) (int64, error)
 	FindByCommodityIDAndCityID(ctx context.Context, id uuid.UUID, cityID int64) ([]*domain.Sale, error)
+	FindByCommodityIDAndCityIDBetween(ctx context.Context, id uuid.UUID, cityID int64, start, end time.Time) ([]*domain.Sale, error)
 }
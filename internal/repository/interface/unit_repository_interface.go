@@ -0,0 +1,14 @@
+package repository_interface
+
+import (
+	"context"
+
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+)
+
+type UnitRepository interface {
+	Create(ctx context.Context, unit *domain.Unit) error
+	FindAll(ctx context.Context) ([]*domain.Unit, error)
+	FindByCode(ctx context.Context, code string) (*domain.Unit, error)
+	Update(ctx context.Context, code string, unit *domain.Unit) error
+}
@@ -17,5 +17,9 @@ type UserRepository interface {
 	Update(ctx context.Context, id uuid.UUID, user *domain.User) error
 	FindDeletedByID(ctx context.Context, id uuid.UUID) (*domain.User, error)
 	FindByEmail(ctx context.Context, email string) (*domain.User, error)
+	FindAuthByID(ctx context.Context, id uuid.UUID) (*domain.User, error)
+	FindWithRoleByID(ctx context.Context, id uuid.UUID) (*domain.User, error)
+	UpdateTwoFactor(ctx context.Context, id uuid.UUID, user *domain.User) error
 	Count(ctx context.Context, filter *dto.ParamFilterDTO) (int64, error)
+	Anonymize(ctx context.Context, id uuid.UUID) error
 }
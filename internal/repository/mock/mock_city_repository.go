@@ -93,6 +93,21 @@ func (mr *MockCityRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCityRepository)(nil).FindByID), ctx, id)
 }
 
+// FindByNames mocks base method.
+func (m *MockCityRepository) FindByNames(ctx context.Context, names []string) ([]*domain.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByNames", ctx, names)
+	ret0, _ := ret[0].([]*domain.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByNames indicates an expected call of FindByNames.
+func (mr *MockCityRepositoryMockRecorder) FindByNames(ctx, names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByNames", reflect.TypeOf((*MockCityRepository)(nil).FindByNames), ctx, names)
+}
+
 // Update mocks base method.
 func (m *MockCityRepository) Update(ctx context.Context, id int64, city *domain.City) error {
 	m.ctrl.T.Helper()
@@ -95,6 +95,21 @@ func (mr *MockCommodityRepositoryMockRecorder) FindAll(ctx, params interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockCommodityRepository)(nil).FindAll), ctx, params)
 }
 
+// FindByCodes mocks base method.
+func (m *MockCommodityRepository) FindByCodes(ctx context.Context, codes []string) ([]*domain.Commodity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCodes", ctx, codes)
+	ret0, _ := ret[0].([]*domain.Commodity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCodes indicates an expected call of FindByCodes.
+func (mr *MockCommodityRepositoryMockRecorder) FindByCodes(ctx, codes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCodes", reflect.TypeOf((*MockCommodityRepository)(nil).FindByCodes), ctx, codes)
+}
+
 // FindByID mocks base method.
 func (m *MockCommodityRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Commodity, error) {
 	m.ctrl.T.Helper()
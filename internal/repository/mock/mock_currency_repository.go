@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/interface/currency_repository_interface.go
+
+// Package mock_repo is a generated GoMock package.
+package mock_repo
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/ryvasa/go-super-farmer/internal/model/domain"
+)
+
+// MockCurrencyRepository is a mock of CurrencyRepository interface.
+type MockCurrencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCurrencyRepositoryMockRecorder
+}
+
+// MockCurrencyRepositoryMockRecorder is the mock recorder for MockCurrencyRepository.
+type MockCurrencyRepositoryMockRecorder struct {
+	mock *MockCurrencyRepository
+}
+
+// NewMockCurrencyRepository creates a new mock instance.
+func NewMockCurrencyRepository(ctrl *gomock.Controller) *MockCurrencyRepository {
+	mock := &MockCurrencyRepository{ctrl: ctrl}
+	mock.recorder = &MockCurrencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCurrencyRepository) EXPECT() *MockCurrencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCurrencyRepository) Create(ctx context.Context, currency *domain.Currency) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCurrencyRepositoryMockRecorder) Create(ctx, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCurrencyRepository)(nil).Create), ctx, currency)
+}
+
+// FindAll mocks base method.
+func (m *MockCurrencyRepository) FindAll(ctx context.Context) ([]*domain.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*domain.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockCurrencyRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockCurrencyRepository)(nil).FindAll), ctx)
+}
+
+// FindByCode mocks base method.
+func (m *MockCurrencyRepository) FindByCode(ctx context.Context, code string) (*domain.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCode", ctx, code)
+	ret0, _ := ret[0].(*domain.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCode indicates an expected call of FindByCode.
+func (mr *MockCurrencyRepositoryMockRecorder) FindByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCode", reflect.TypeOf((*MockCurrencyRepository)(nil).FindByCode), ctx, code)
+}
+
+// FindRatesByCurrencyCode mocks base method.
+func (m *MockCurrencyRepository) FindRatesByCurrencyCode(ctx context.Context, code string) ([]*domain.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRatesByCurrencyCode", ctx, code)
+	ret0, _ := ret[0].([]*domain.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRatesByCurrencyCode indicates an expected call of FindRatesByCurrencyCode.
+func (mr *MockCurrencyRepositoryMockRecorder) FindRatesByCurrencyCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRatesByCurrencyCode", reflect.TypeOf((*MockCurrencyRepository)(nil).FindRatesByCurrencyCode), ctx, code)
+}
+
+// UpsertRate mocks base method.
+func (m *MockCurrencyRepository) UpsertRate(ctx context.Context, rate *domain.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRate", ctx, rate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertRate indicates an expected call of UpsertRate.
+func (mr *MockCurrencyRepositoryMockRecorder) UpsertRate(ctx, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRate", reflect.TypeOf((*MockCurrencyRepository)(nil).UpsertRate), ctx, rate)
+}
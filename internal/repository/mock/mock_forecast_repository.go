@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/interface/forecast_repository_interface.go
+
+// Package mock_repo is a generated GoMock package.
+package mock_repo
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	domain "github.com/ryvasa/go-super-farmer/internal/model/domain"
+	dto "github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+// MockForecastRepository is a mock of ForecastRepository interface.
+type MockForecastRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockForecastRepositoryMockRecorder
+}
+
+// MockForecastRepositoryMockRecorder is the mock recorder for MockForecastRepository.
+type MockForecastRepositoryMockRecorder struct {
+	mock *MockForecastRepository
+}
+
+// NewMockForecastRepository creates a new mock instance.
+func NewMockForecastRepository(ctrl *gomock.Controller) *MockForecastRepository {
+	mock := &MockForecastRepository{ctrl: ctrl}
+	mock.recorder = &MockForecastRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForecastRepository) EXPECT() *MockForecastRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockForecastRepository) Create(ctx context.Context, forecast *domain.Forecast) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, forecast)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockForecastRepositoryMockRecorder) Create(ctx, forecast interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockForecastRepository)(nil).Create), ctx, forecast)
+}
+
+// Evaluate mocks base method.
+func (m *MockForecastRepository) Evaluate(ctx context.Context, forecast *domain.Forecast) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evaluate", ctx, forecast)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Evaluate indicates an expected call of Evaluate.
+func (mr *MockForecastRepositoryMockRecorder) Evaluate(ctx, forecast interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockForecastRepository)(nil).Evaluate), ctx, forecast)
+}
+
+// FindAccuracy mocks base method.
+func (m *MockForecastRepository) FindAccuracy(ctx context.Context, params *dto.ForecastAccuracyParamsDTO) ([]*dto.ForecastAccuracyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAccuracy", ctx, params)
+	ret0, _ := ret[0].([]*dto.ForecastAccuracyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAccuracy indicates an expected call of FindAccuracy.
+func (mr *MockForecastRepositoryMockRecorder) FindAccuracy(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAccuracy", reflect.TypeOf((*MockForecastRepository)(nil).FindAccuracy), ctx, params)
+}
+
+// FindByID mocks base method.
+func (m *MockForecastRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Forecast, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Forecast)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockForecastRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockForecastRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByLandCommodityID mocks base method.
+func (m *MockForecastRepository) FindByLandCommodityID(ctx context.Context, landCommodityID uuid.UUID) ([]*domain.Forecast, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByLandCommodityID", ctx, landCommodityID)
+	ret0, _ := ret[0].([]*domain.Forecast)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByLandCommodityID indicates an expected call of FindByLandCommodityID.
+func (mr *MockForecastRepositoryMockRecorder) FindByLandCommodityID(ctx, landCommodityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByLandCommodityID", reflect.TypeOf((*MockForecastRepository)(nil).FindByLandCommodityID), ctx, landCommodityID)
+}
+
+// FindUnevaluated mocks base method.
+func (m *MockForecastRepository) FindUnevaluated(ctx context.Context, harvestedBefore time.Time, limit int) ([]*domain.Forecast, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUnevaluated", ctx, harvestedBefore, limit)
+	ret0, _ := ret[0].([]*domain.Forecast)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUnevaluated indicates an expected call of FindUnevaluated.
+func (mr *MockForecastRepositoryMockRecorder) FindUnevaluated(ctx, harvestedBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUnevaluated", reflect.TypeOf((*MockForecastRepository)(nil).FindUnevaluated), ctx, harvestedBefore, limit)
+}
+
+// Update mocks base method.
+func (m *MockForecastRepository) Update(ctx context.Context, forecast *domain.Forecast) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, forecast)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockForecastRepositoryMockRecorder) Update(ctx, forecast interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockForecastRepository)(nil).Update), ctx, forecast)
+}
@@ -124,6 +124,21 @@ func (mr *MockHarvestRepositoryMockRecorder) FindByCommodityID(ctx, id interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCommodityID", reflect.TypeOf((*MockHarvestRepository)(nil).FindByCommodityID), ctx, id)
 }
 
+// FindByCommodityIDAndArea mocks base method.
+func (m *MockHarvestRepository) FindByCommodityIDAndArea(ctx context.Context, commodityID uuid.UUID, cityID, provinceID int64) ([]*domain.Harvest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCommodityIDAndArea", ctx, commodityID, cityID, provinceID)
+	ret0, _ := ret[0].([]*domain.Harvest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCommodityIDAndArea indicates an expected call of FindByCommodityIDAndArea.
+func (mr *MockHarvestRepositoryMockRecorder) FindByCommodityIDAndArea(ctx, commodityID, cityID, provinceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCommodityIDAndArea", reflect.TypeOf((*MockHarvestRepository)(nil).FindByCommodityIDAndArea), ctx, commodityID, cityID, provinceID)
+}
+
 // FindByID mocks base method.
 func (m *MockHarvestRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Harvest, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +65,21 @@ func (mr *MockLandCommodityRepositoryMockRecorder) Delete(ctx, id interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLandCommodityRepository)(nil).Delete), ctx, id)
 }
 
+// FindActiveByArea mocks base method.
+func (m *MockLandCommodityRepository) FindActiveByArea(ctx context.Context, commodityID uuid.UUID, cityID, provinceID int64) ([]*domain.LandCommodity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveByArea", ctx, commodityID, cityID, provinceID)
+	ret0, _ := ret[0].([]*domain.LandCommodity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveByArea indicates an expected call of FindActiveByArea.
+func (mr *MockLandCommodityRepositoryMockRecorder) FindActiveByArea(ctx, commodityID, cityID, provinceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveByArea", reflect.TypeOf((*MockLandCommodityRepository)(nil).FindActiveByArea), ctx, commodityID, cityID, provinceID)
+}
+
 // FindAll mocks base method.
 func (m *MockLandCommodityRepository) FindAll(ctx context.Context) ([]*domain.LandCommodity, error) {
 	m.ctrl.T.Helper()
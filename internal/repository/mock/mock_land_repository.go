@@ -95,6 +95,21 @@ func (mr *MockLandRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockLandRepository)(nil).FindByID), ctx, id)
 }
 
+// FindByProvinceID mocks base method.
+func (m *MockLandRepository) FindByProvinceID(ctx context.Context, provinceID int64) ([]*domain.Land, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProvinceID", ctx, provinceID)
+	ret0, _ := ret[0].([]*domain.Land)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProvinceID indicates an expected call of FindByProvinceID.
+func (mr *MockLandRepositoryMockRecorder) FindByProvinceID(ctx, provinceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProvinceID", reflect.TypeOf((*MockLandRepository)(nil).FindByProvinceID), ctx, provinceID)
+}
+
 // FindByUserID mocks base method.
 func (m *MockLandRepository) FindByUserID(ctx context.Context, id uuid.UUID) ([]*domain.Land, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/interface/price_alert_repository_interface.go
+
+// Package mock_repo is a generated GoMock package.
+package mock_repo
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	domain "github.com/ryvasa/go-super-farmer/internal/model/domain"
+)
+
+// MockPriceAlertRepository is a mock of PriceAlertRepository interface.
+type MockPriceAlertRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceAlertRepositoryMockRecorder
+}
+
+// MockPriceAlertRepositoryMockRecorder is the mock recorder for MockPriceAlertRepository.
+type MockPriceAlertRepositoryMockRecorder struct {
+	mock *MockPriceAlertRepository
+}
+
+// NewMockPriceAlertRepository creates a new mock instance.
+func NewMockPriceAlertRepository(ctrl *gomock.Controller) *MockPriceAlertRepository {
+	mock := &MockPriceAlertRepository{ctrl: ctrl}
+	mock.recorder = &MockPriceAlertRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceAlertRepository) EXPECT() *MockPriceAlertRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPriceAlertRepository) Create(ctx context.Context, alert *domain.PriceAlert) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, alert)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPriceAlertRepositoryMockRecorder) Create(ctx, alert interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPriceAlertRepository)(nil).Create), ctx, alert)
+}
+
+// Delete mocks base method.
+func (m *MockPriceAlertRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPriceAlertRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPriceAlertRepository)(nil).Delete), ctx, id)
+}
+
+// FindActiveByCommodityIDAndCityID mocks base method.
+func (m *MockPriceAlertRepository) FindActiveByCommodityIDAndCityID(ctx context.Context, commodityID uuid.UUID, cityID int64) ([]*domain.PriceAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveByCommodityIDAndCityID", ctx, commodityID, cityID)
+	ret0, _ := ret[0].([]*domain.PriceAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveByCommodityIDAndCityID indicates an expected call of FindActiveByCommodityIDAndCityID.
+func (mr *MockPriceAlertRepositoryMockRecorder) FindActiveByCommodityIDAndCityID(ctx, commodityID, cityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveByCommodityIDAndCityID", reflect.TypeOf((*MockPriceAlertRepository)(nil).FindActiveByCommodityIDAndCityID), ctx, commodityID, cityID)
+}
+
+// FindByID mocks base method.
+func (m *MockPriceAlertRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.PriceAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.PriceAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPriceAlertRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPriceAlertRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByUserID mocks base method.
+func (m *MockPriceAlertRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.PriceAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*domain.PriceAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockPriceAlertRepositoryMockRecorder) FindByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockPriceAlertRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockPriceAlertRepository) Update(ctx context.Context, alert *domain.PriceAlert) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, alert)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPriceAlertRepositoryMockRecorder) Update(ctx, alert interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPriceAlertRepository)(nil).Update), ctx, alert)
+}
+
+// UpdateLastTriggered mocks base method.
+func (m *MockPriceAlertRepository) UpdateLastTriggered(ctx context.Context, id uuid.UUID, triggeredAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastTriggered", ctx, id, triggeredAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastTriggered indicates an expected call of UpdateLastTriggered.
+func (mr *MockPriceAlertRepositoryMockRecorder) UpdateLastTriggered(ctx, id, triggeredAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastTriggered", reflect.TypeOf((*MockPriceAlertRepository)(nil).UpdateLastTriggered), ctx, id, triggeredAt)
+}
@@ -7,10 +7,12 @@ package mock_repo
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 	domain "github.com/ryvasa/go-super-farmer/internal/model/domain"
+	dto "github.com/ryvasa/go-super-farmer/internal/model/dto"
 )
 
 // MockPriceHistoryRepository is a mock of PriceHistoryRepository interface.
@@ -50,6 +52,21 @@ func (mr *MockPriceHistoryRepositoryMockRecorder) Create(ctx, priceHistory inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPriceHistoryRepository)(nil).Create), ctx, priceHistory)
 }
 
+// FindBuckets mocks base method.
+func (m *MockPriceHistoryRepository) FindBuckets(ctx context.Context, commodityID uuid.UUID, cityID int64, interval string, start, end time.Time) ([]*dto.PriceBucketDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBuckets", ctx, commodityID, cityID, interval, start, end)
+	ret0, _ := ret[0].([]*dto.PriceBucketDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBuckets indicates an expected call of FindBuckets.
+func (mr *MockPriceHistoryRepositoryMockRecorder) FindBuckets(ctx, commodityID, cityID, interval, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBuckets", reflect.TypeOf((*MockPriceHistoryRepository)(nil).FindBuckets), ctx, commodityID, cityID, interval, start, end)
+}
+
 // FindByCommodityIDAndCityID mocks base method.
 func (m *MockPriceHistoryRepository) FindByCommodityIDAndCityID(ctx context.Context, commodityID uuid.UUID, cityID int64) ([]*domain.PriceHistory, error) {
 	m.ctrl.T.Helper()
@@ -79,3 +96,48 @@ func (mr *MockPriceHistoryRepositoryMockRecorder) FindByID(ctx, id interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPriceHistoryRepository)(nil).FindByID), ctx, id)
 }
+
+// FindCityCloses mocks base method.
+func (m *MockPriceHistoryRepository) FindCityCloses(ctx context.Context, commodityID uuid.UUID, provinceID int64, interval string, start, end time.Time) ([]*dto.CityPriceCloseDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCityCloses", ctx, commodityID, provinceID, interval, start, end)
+	ret0, _ := ret[0].([]*dto.CityPriceCloseDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCityCloses indicates an expected call of FindCityCloses.
+func (mr *MockPriceHistoryRepositoryMockRecorder) FindCityCloses(ctx, commodityID, provinceID, interval, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCityCloses", reflect.TypeOf((*MockPriceHistoryRepository)(nil).FindCityCloses), ctx, commodityID, provinceID, interval, start, end)
+}
+
+// FindLatestBefore mocks base method.
+func (m *MockPriceHistoryRepository) FindLatestBefore(ctx context.Context, commodityID uuid.UUID, cityID int64, before time.Time) (*domain.PriceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLatestBefore", ctx, commodityID, cityID, before)
+	ret0, _ := ret[0].(*domain.PriceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLatestBefore indicates an expected call of FindLatestBefore.
+func (mr *MockPriceHistoryRepositoryMockRecorder) FindLatestBefore(ctx, commodityID, cityID, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLatestBefore", reflect.TypeOf((*MockPriceHistoryRepository)(nil).FindLatestBefore), ctx, commodityID, cityID, before)
+}
+
+// FindRecentPrices mocks base method.
+func (m *MockPriceHistoryRepository) FindRecentPrices(ctx context.Context, commodityID uuid.UUID, cityID int64, limit int) ([]float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRecentPrices", ctx, commodityID, cityID, limit)
+	ret0, _ := ret[0].([]float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRecentPrices indicates an expected call of FindRecentPrices.
+func (mr *MockPriceHistoryRepositoryMockRecorder) FindRecentPrices(ctx, commodityID, cityID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRecentPrices", reflect.TypeOf((*MockPriceHistoryRepository)(nil).FindRecentPrices), ctx, commodityID, cityID, limit)
+}
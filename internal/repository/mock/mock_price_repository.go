@@ -140,6 +140,21 @@ func (mr *MockPriceRepositoryMockRecorder) FindByCommodityIDAndCityID(ctx, commo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCommodityIDAndCityID", reflect.TypeOf((*MockPriceRepository)(nil).FindByCommodityIDAndCityID), ctx, commodityID, cityID)
 }
 
+// FindByCommodityIDsAndCityIDs mocks base method.
+func (m *MockPriceRepository) FindByCommodityIDsAndCityIDs(ctx context.Context, commodityIDs []uuid.UUID, cityIDs []int64) ([]*domain.Price, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCommodityIDsAndCityIDs", ctx, commodityIDs, cityIDs)
+	ret0, _ := ret[0].([]*domain.Price)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCommodityIDsAndCityIDs indicates an expected call of FindByCommodityIDsAndCityIDs.
+func (mr *MockPriceRepositoryMockRecorder) FindByCommodityIDsAndCityIDs(ctx, commodityIDs, cityIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCommodityIDsAndCityIDs", reflect.TypeOf((*MockPriceRepository)(nil).FindByCommodityIDsAndCityIDs), ctx, commodityIDs, cityIDs)
+}
+
 // FindByID mocks base method.
 func (m *MockPriceRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Price, error) {
 	m.ctrl.T.Helper()
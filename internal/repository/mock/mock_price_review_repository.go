@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/interface/price_review_repository_interface.go
+
+// Package mock_repo is a generated GoMock package.
+package mock_repo
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	domain "github.com/ryvasa/go-super-farmer/internal/model/domain"
+)
+
+// MockPriceReviewRepository is a mock of PriceReviewRepository interface.
+type MockPriceReviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceReviewRepositoryMockRecorder
+}
+
+// MockPriceReviewRepositoryMockRecorder is the mock recorder for MockPriceReviewRepository.
+type MockPriceReviewRepositoryMockRecorder struct {
+	mock *MockPriceReviewRepository
+}
+
+// NewMockPriceReviewRepository creates a new mock instance.
+func NewMockPriceReviewRepository(ctrl *gomock.Controller) *MockPriceReviewRepository {
+	mock := &MockPriceReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockPriceReviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceReviewRepository) EXPECT() *MockPriceReviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPriceReviewRepository) Create(ctx context.Context, review *domain.PriceReview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPriceReviewRepositoryMockRecorder) Create(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPriceReviewRepository)(nil).Create), ctx, review)
+}
+
+// FindAll mocks base method.
+func (m *MockPriceReviewRepository) FindAll(ctx context.Context, status string) ([]*domain.PriceReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, status)
+	ret0, _ := ret[0].([]*domain.PriceReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPriceReviewRepositoryMockRecorder) FindAll(ctx, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPriceReviewRepository)(nil).FindAll), ctx, status)
+}
+
+// FindByID mocks base method.
+func (m *MockPriceReviewRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.PriceReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.PriceReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPriceReviewRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPriceReviewRepository)(nil).FindByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockPriceReviewRepository) Update(ctx context.Context, review *domain.PriceReview) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, review)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPriceReviewRepositoryMockRecorder) Update(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPriceReviewRepository)(nil).Update), ctx, review)
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/interface/price_schedule_repository_interface.go
+
+// Package mock_repo is a generated GoMock package.
+package mock_repo
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	domain "github.com/ryvasa/go-super-farmer/internal/model/domain"
+)
+
+// MockPriceScheduleRepository is a mock of PriceScheduleRepository interface.
+type MockPriceScheduleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceScheduleRepositoryMockRecorder
+}
+
+// MockPriceScheduleRepositoryMockRecorder is the mock recorder for MockPriceScheduleRepository.
+type MockPriceScheduleRepositoryMockRecorder struct {
+	mock *MockPriceScheduleRepository
+}
+
+// NewMockPriceScheduleRepository creates a new mock instance.
+func NewMockPriceScheduleRepository(ctrl *gomock.Controller) *MockPriceScheduleRepository {
+	mock := &MockPriceScheduleRepository{ctrl: ctrl}
+	mock.recorder = &MockPriceScheduleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceScheduleRepository) EXPECT() *MockPriceScheduleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPriceScheduleRepository) Create(ctx context.Context, schedule *domain.PriceSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPriceScheduleRepositoryMockRecorder) Create(ctx, schedule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPriceScheduleRepository)(nil).Create), ctx, schedule)
+}
+
+// FindAll mocks base method.
+func (m *MockPriceScheduleRepository) FindAll(ctx context.Context, status string) ([]*domain.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, status)
+	ret0, _ := ret[0].([]*domain.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPriceScheduleRepositoryMockRecorder) FindAll(ctx, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPriceScheduleRepository)(nil).FindAll), ctx, status)
+}
+
+// FindByID mocks base method.
+func (m *MockPriceScheduleRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPriceScheduleRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPriceScheduleRepository)(nil).FindByID), ctx, id)
+}
+
+// FindDue mocks base method.
+func (m *MockPriceScheduleRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*domain.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDue", ctx, now, limit)
+	ret0, _ := ret[0].([]*domain.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDue indicates an expected call of FindDue.
+func (mr *MockPriceScheduleRepositoryMockRecorder) FindDue(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDue", reflect.TypeOf((*MockPriceScheduleRepository)(nil).FindDue), ctx, now, limit)
+}
+
+// Update mocks base method.
+func (m *MockPriceScheduleRepository) Update(ctx context.Context, schedule *domain.PriceSchedule) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, schedule)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPriceScheduleRepositoryMockRecorder) Update(ctx, schedule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPriceScheduleRepository)(nil).Update), ctx, schedule)
+}
@@ -7,6 +7,7 @@ package mock_repo
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -170,6 +171,21 @@ func (mr *MockSaleRepositoryMockRecorder) FindByCommodityIDAndCityID(ctx, id, ci
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCommodityIDAndCityID", reflect.TypeOf((*MockSaleRepository)(nil).FindByCommodityIDAndCityID), ctx, id, cityID)
 }
 
+// FindByCommodityIDAndCityIDBetween mocks base method.
+func (m *MockSaleRepository) FindByCommodityIDAndCityIDBetween(ctx context.Context, id uuid.UUID, cityID int64, start, end time.Time) ([]*domain.Sale, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCommodityIDAndCityIDBetween", ctx, id, cityID, start, end)
+	ret0, _ := ret[0].([]*domain.Sale)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCommodityIDAndCityIDBetween indicates an expected call of FindByCommodityIDAndCityIDBetween.
+func (mr *MockSaleRepositoryMockRecorder) FindByCommodityIDAndCityIDBetween(ctx, id, cityID, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCommodityIDAndCityIDBetween", reflect.TypeOf((*MockSaleRepository)(nil).FindByCommodityIDAndCityIDBetween), ctx, id, cityID, start, end)
+}
+
 // FindByID mocks base method.
 func (m *MockSaleRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Sale, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/interface/unit_repository_interface.go
+
+// Package mock_repo is a generated GoMock package.
+package mock_repo
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/ryvasa/go-super-farmer/internal/model/domain"
+)
+
+// MockUnitRepository is a mock of UnitRepository interface.
+type MockUnitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnitRepositoryMockRecorder
+}
+
+// MockUnitRepositoryMockRecorder is the mock recorder for MockUnitRepository.
+type MockUnitRepositoryMockRecorder struct {
+	mock *MockUnitRepository
+}
+
+// NewMockUnitRepository creates a new mock instance.
+func NewMockUnitRepository(ctrl *gomock.Controller) *MockUnitRepository {
+	mock := &MockUnitRepository{ctrl: ctrl}
+	mock.recorder = &MockUnitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnitRepository) EXPECT() *MockUnitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUnitRepository) Create(ctx context.Context, unit *domain.Unit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, unit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUnitRepositoryMockRecorder) Create(ctx, unit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUnitRepository)(nil).Create), ctx, unit)
+}
+
+// FindAll mocks base method.
+func (m *MockUnitRepository) FindAll(ctx context.Context) ([]*domain.Unit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*domain.Unit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockUnitRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockUnitRepository)(nil).FindAll), ctx)
+}
+
+// FindByCode mocks base method.
+func (m *MockUnitRepository) FindByCode(ctx context.Context, code string) (*domain.Unit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCode", ctx, code)
+	ret0, _ := ret[0].(*domain.Unit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCode indicates an expected call of FindByCode.
+func (mr *MockUnitRepositoryMockRecorder) FindByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCode", reflect.TypeOf((*MockUnitRepository)(nil).FindByCode), ctx, code)
+}
+
+// Update mocks base method.
+func (m *MockUnitRepository) Update(ctx context.Context, code string, unit *domain.Unit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, code, unit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUnitRepositoryMockRecorder) Update(ctx, code, unit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUnitRepository)(nil).Update), ctx, code, unit)
+}
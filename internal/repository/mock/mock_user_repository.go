@@ -37,6 +37,20 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Anonymize mocks base method.
+func (m *MockUserRepository) Anonymize(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Anonymize", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Anonymize indicates an expected call of Anonymize.
+func (mr *MockUserRepositoryMockRecorder) Anonymize(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Anonymize", reflect.TypeOf((*MockUserRepository)(nil).Anonymize), ctx, id)
+}
+
 // Count mocks base method.
 func (m *MockUserRepository) Count(ctx context.Context, filter *dto.ParamFilterDTO) (int64, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +109,21 @@ func (mr *MockUserRepositoryMockRecorder) FindAll(ctx, pagination interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockUserRepository)(nil).FindAll), ctx, pagination)
 }
 
+// FindAuthByID mocks base method.
+func (m *MockUserRepository) FindAuthByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAuthByID", ctx, id)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAuthByID indicates an expected call of FindAuthByID.
+func (mr *MockUserRepositoryMockRecorder) FindAuthByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAuthByID", reflect.TypeOf((*MockUserRepository)(nil).FindAuthByID), ctx, id)
+}
+
 // FindByEmail mocks base method.
 func (m *MockUserRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +169,21 @@ func (mr *MockUserRepositoryMockRecorder) FindDeletedByID(ctx, id interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockUserRepository)(nil).FindDeletedByID), ctx, id)
 }
 
+// FindWithRoleByID mocks base method.
+func (m *MockUserRepository) FindWithRoleByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWithRoleByID", ctx, id)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWithRoleByID indicates an expected call of FindWithRoleByID.
+func (mr *MockUserRepositoryMockRecorder) FindWithRoleByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithRoleByID", reflect.TypeOf((*MockUserRepository)(nil).FindWithRoleByID), ctx, id)
+}
+
 // Restore mocks base method.
 func (m *MockUserRepository) Restore(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -167,3 +211,17 @@ func (mr *MockUserRepositoryMockRecorder) Update(ctx, id, user interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepository)(nil).Update), ctx, id, user)
 }
+
+// UpdateTwoFactor mocks base method.
+func (m *MockUserRepository) UpdateTwoFactor(ctx context.Context, id uuid.UUID, user *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTwoFactor", ctx, id, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTwoFactor indicates an expected call of UpdateTwoFactor.
+func (mr *MockUserRepositoryMockRecorder) UpdateTwoFactor(ctx, id, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTwoFactor", reflect.TypeOf((*MockUserRepository)(nil).UpdateTwoFactor), ctx, id, user)
+}
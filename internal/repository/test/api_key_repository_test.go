@@ -0,0 +1,108 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	repository_implementation "github.com/ryvasa/go-super-farmer/internal/repository/implementation"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	"github.com/ryvasa/go-super-farmer/pkg/database"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type APIKeyRepositoryIDs struct {
+	APIKeyID uuid.UUID
+	OwnerID  uuid.UUID
+}
+
+type APIKeyRepositoryMockRows struct {
+	APIKey *sqlmock.Rows
+}
+
+func APIKeyRepositorySetup(t *testing.T) (*database.MockDB, repository_interface.APIKeyRepository, APIKeyRepositoryIDs, APIKeyRepositoryMockRows) {
+	mockDB := database.NewMockDB(t)
+
+	repo := repository_implementation.NewAPIKeyRepository(mockDB.DB)
+
+	ids := APIKeyRepositoryIDs{
+		APIKeyID: uuid.New(),
+		OwnerID:  uuid.New(),
+	}
+
+	rows := APIKeyRepositoryMockRows{
+		APIKey: sqlmock.NewRows([]string{"id", "name", "prefix", "secret_hash", "owner_id", "scopes", "created_at", "updated_at"}).
+			AddRow(ids.APIKeyID, "scraper", "abcd1234", "hash", ids.OwnerID, "prices:write", time.Now(), time.Now()),
+	}
+
+	return mockDB, repo, ids, rows
+}
+
+func TestAPIKeyRepository_FindByPrefix(t *testing.T) {
+	mockDB, repo, ids, rows := APIKeyRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	expectedSQL := `SELECT * FROM "api_keys" WHERE prefix = $1 AND "api_keys"."deleted_at" IS NULL ORDER BY "api_keys"."id" LIMIT $2`
+
+	t.Run("should return api key when find by prefix successfully", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).WithArgs("abcd1234", 1).WillReturnRows(rows.APIKey)
+
+		result, err := repo.FindByPrefix(context.TODO(), "abcd1234")
+		assert.Nil(t, err)
+		assert.Equal(t, ids.APIKeyID, result.ID)
+		assert.Equal(t, ids.OwnerID, result.OwnerID)
+		assert.Equal(t, "prices:write", result.Scopes)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when api key not found", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).WithArgs("abcd1234", 1).WillReturnError(gorm.ErrRecordNotFound)
+
+		result, err := repo.FindByPrefix(context.TODO(), "abcd1234")
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestAPIKeyRepository_FindByOwnerID(t *testing.T) {
+	mockDB, repo, ids, rows := APIKeyRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	expectedSQL := `SELECT * FROM "api_keys" WHERE owner_id = $1 AND "api_keys"."deleted_at" IS NULL ORDER BY created_at desc`
+
+	t.Run("should return api keys of owner", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).WithArgs(ids.OwnerID).WillReturnRows(rows.APIKey)
+
+		result, err := repo.FindByOwnerID(context.TODO(), ids.OwnerID)
+		assert.Nil(t, err)
+		assert.Len(t, result, 1)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestAPIKeyRepository_UpdateLastUsed(t *testing.T) {
+	mockDB, repo, ids, _ := APIKeyRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	expectedSQL := `UPDATE "api_keys" SET "last_used_at"=$1 WHERE id = $2 AND "api_keys"."deleted_at" IS NULL`
+
+	t.Run("should update last used successfully", func(t *testing.T) {
+		now := time.Now()
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).WithArgs(now, ids.APIKeyID).WillReturnResult(sqlmock.NewResult(1, 1))
+		mockDB.Mock.ExpectCommit()
+
+		err := repo.UpdateLastUsed(context.TODO(), ids.APIKeyID, now)
+		assert.Nil(t, err)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
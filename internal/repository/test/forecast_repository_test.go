@@ -0,0 +1,164 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	repository_implementation "github.com/ryvasa/go-super-farmer/internal/repository/implementation"
+	"github.com/ryvasa/go-super-farmer/pkg/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForecastRepository_FindByLandCommodityID(t *testing.T) {
+	mockDB := database.NewMockDB(t)
+	defer mockDB.SqlDB.Close()
+	repo := repository_implementation.NewForecastRepository(mockDB.BaseRepo)
+
+	landCommodityID := uuid.New()
+
+	t.Run("should return forecasts successfully", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "land_commodity_id", "status"}).
+			AddRow(uuid.New(), landCommodityID, domain.ForecastCompleted).
+			AddRow(uuid.New(), landCommodityID, domain.ForecastFailed)
+		mockDB.Mock.ExpectQuery(`SELECT \* FROM "forecasts" WHERE land_commodity_id = \$1 ORDER BY created_at desc`).
+			WithArgs(landCommodityID).
+			WillReturnRows(rows)
+
+		result, err := repo.FindByLandCommodityID(context.TODO(), landCommodityID)
+		assert.Nil(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, domain.ForecastCompleted, result[0].Status)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when query failed", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(`SELECT \* FROM "forecasts"`).
+			WillReturnError(errors.New("database error"))
+
+		result, err := repo.FindByLandCommodityID(context.TODO(), landCommodityID)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestForecastRepository_Update(t *testing.T) {
+	mockDB := database.NewMockDB(t)
+	defer mockDB.SqlDB.Close()
+	repo := repository_implementation.NewForecastRepository(mockDB.BaseRepo)
+
+	now := time.Now()
+	predicted := float64(13500)
+	forecast := &domain.Forecast{ID: uuid.New(), Status: domain.ForecastCompleted, Engine: domain.ForecastEngineService, ModelVersion: "rf-2", PredictedPrice: &predicted, CompletedAt: &now}
+
+	t.Run("should update a pending forecast successfully", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(`UPDATE "forecasts" SET "status"=\$1,"engine"=\$2,"model_version"=\$3,"predicted_price"=\$4,"error"=\$5,"completed_at"=\$6,"updated_at"=\$7 WHERE id = \$8 AND status = \$9`).
+			WithArgs(domain.ForecastCompleted, domain.ForecastEngineService, "rf-2", &predicted, "", &now, sqlmock.AnyArg(), forecast.ID, domain.ForecastPending).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mockDB.Mock.ExpectCommit()
+
+		updated, err := repo.Update(context.TODO(), forecast)
+		assert.Nil(t, err)
+		assert.True(t, updated)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return false when forecast is no longer pending", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(`UPDATE "forecasts"`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mockDB.Mock.ExpectCommit()
+
+		updated, err := repo.Update(context.TODO(), forecast)
+		assert.Nil(t, err)
+		assert.False(t, updated)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestForecastRepository_FindUnevaluated(t *testing.T) {
+	mockDB := database.NewMockDB(t)
+	defer mockDB.SqlDB.Close()
+	repo := repository_implementation.NewForecastRepository(mockDB.BaseRepo)
+
+	now := time.Now()
+
+	t.Run("should return the harvested forecasts successfully", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "status", "harvest_date"}).
+			AddRow(uuid.New(), domain.ForecastCompleted, now.AddDate(0, 0, -1))
+		mockDB.Mock.ExpectQuery(`SELECT \* FROM "forecasts" WHERE status = \$1 AND evaluated_at IS NULL AND harvest_date < \$2 ORDER BY harvest_date asc LIMIT \$3`).
+			WithArgs(domain.ForecastCompleted, now, 100).
+			WillReturnRows(rows)
+
+		result, err := repo.FindUnevaluated(context.TODO(), now, 100)
+		assert.Nil(t, err)
+		assert.Len(t, result, 1)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestForecastRepository_Evaluate(t *testing.T) {
+	mockDB := database.NewMockDB(t)
+	defer mockDB.SqlDB.Close()
+	repo := repository_implementation.NewForecastRepository(mockDB.BaseRepo)
+
+	now := time.Now()
+	actual := float64(12000)
+	forecast := &domain.Forecast{ID: uuid.New(), ActualPrice: &actual, EvaluatedAt: &now}
+
+	t.Run("should write the actual price successfully", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(`UPDATE "forecasts" SET "actual_price"=\$1,"evaluated_at"=\$2,"updated_at"=\$3 WHERE id = \$4`).
+			WithArgs(&actual, &now, sqlmock.AnyArg(), forecast.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mockDB.Mock.ExpectCommit()
+
+		err := repo.Evaluate(context.TODO(), forecast)
+		assert.Nil(t, err)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestForecastRepository_FindAccuracy(t *testing.T) {
+	mockDB := database.NewMockDB(t)
+	defer mockDB.SqlDB.Close()
+	repo := repository_implementation.NewForecastRepository(mockDB.BaseRepo)
+
+	commodityID := uuid.New()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should return the errors per model successfully", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"commodity_id", "city_id", "engine", "model_version", "count", "mae", "mape"}).
+			AddRow(commodityID, 1, domain.ForecastEngineBaseline, "naive", 3, 500, 4.2).
+			AddRow(commodityID, 1, domain.ForecastEngineService, "rf-2", 2, 300, nil)
+		mockDB.Mock.ExpectQuery(`SELECT commodity_id, city_id, engine, model_version, COUNT\(\*\) AS count,.* FROM "forecasts" WHERE actual_price IS NOT NULL AND commodity_id = \$1 AND harvest_date >= \$2 AND harvest_date < \$3 GROUP BY commodity_id, city_id, engine, model_version ORDER BY commodity_id, city_id, engine, model_version`).
+			WithArgs(commodityID, start, end.AddDate(0, 0, 1)).
+			WillReturnRows(rows)
+
+		result, err := repo.FindAccuracy(context.TODO(), &dto.ForecastAccuracyParamsDTO{CommodityID: commodityID, StartDate: start, EndDate: end})
+		assert.Nil(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, float64(500), result[0].MAE)
+		assert.Equal(t, 4.2, *result[0].MAPE)
+		assert.Nil(t, result[1].MAPE)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when query failed", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(`SELECT commodity_id`).
+			WillReturnError(errors.New("database error"))
+
+		result, err := repo.FindAccuracy(context.TODO(), &dto.ForecastAccuracyParamsDTO{})
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
@@ -539,3 +539,33 @@ func TestHarvestRepository_FindDeletedByID(t *testing.T) {
 		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
 	})
 }
+
+func TestHarvestRepository_FindByCommodityIDAndArea(t *testing.T) {
+	mockDB, repo, ids, rows, _ := HarvestRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	expectedSQL1 := `SELECT "harvests"."id","harvests"."land_commodity_id","harvests"."quantity","harvests"."unit","harvests"."harvest_date","harvests"."created_at","harvests"."updated_at","harvests"."deleted_at" FROM "harvests" JOIN land_commodities ON harvests.land_commodity_id = land_commodities.id JOIN lands ON lands.id = land_commodities.land_id WHERE land_commodities.commodity_id = $1 AND lands.city_id = $2 AND "harvests"."deleted_at" IS NULL`
+
+	expectedSQL2 := `SELECT * FROM "land_commodities" WHERE "land_commodities"."id" = $1 AND "land_commodities"."deleted_at" IS NULL`
+
+	t.Run("should return the harvests of a city successfully", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL1)).WithArgs(ids.CommodityID, ids.CityID).WillReturnRows(rows.Harvest)
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL2)).WithArgs(ids.LandCommodityID).WillReturnRows(rows.LandCommodity)
+
+		result, err := repo.FindByCommodityIDAndArea(context.TODO(), ids.CommodityID, ids.CityID, 0)
+		assert.Nil(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, float64(100), result[0].LandCommodity.LandArea)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when find by commodity id and area failed", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(`SELECT .* FROM "harvests"`).WithArgs(ids.CommodityID).WillReturnError(errors.New("database error"))
+
+		result, err := repo.FindByCommodityIDAndArea(context.TODO(), ids.CommodityID, 0, 0)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
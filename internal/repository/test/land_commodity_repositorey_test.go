@@ -488,3 +488,35 @@ func TestLandCommodityRepository_SumLandAreaByCommodityID(t *testing.T) {
 		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
 	})
 }
+
+func TestLandCommodityRepository_FindActiveByArea(t *testing.T) {
+	mockDB, repo, ids, rows, _ := LandCommodityRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	expectedSQL := `SELECT "land_commodities"."id","land_commodities"."land_area","land_commodities"."unit","land_commodities"."commodity_id","land_commodities"."land_id","land_commodities"."harvested","land_commodities"."created_at","land_commodities"."updated_at","land_commodities"."deleted_at" FROM "land_commodities" JOIN lands ON lands.id = land_commodities.land_id AND lands.deleted_at IS NULL JOIN cities ON cities.id = lands.city_id WHERE (land_commodities.commodity_id = $1 AND land_commodities.harvested = $2) AND cities.province_id = $3 AND "land_commodities"."deleted_at" IS NULL`
+
+	expectedLandSQL := `SELECT * FROM "lands" WHERE "lands"."id" = $1 AND "lands"."deleted_at" IS NULL`
+
+	t.Run("should return the land commodities of a province successfully", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).WithArgs(ids.CommodityID, false, int64(3)).WillReturnRows(rows.LandCommodities)
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedLandSQL)).WithArgs(ids.LandID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "city_id"}).AddRow(ids.LandID, int64(1)))
+
+		result, err := repo.FindActiveByArea(context.TODO(), ids.CommodityID, 0, 3)
+		assert.Nil(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, int64(1), result[0].Land.CityID)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when find active by area failed", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(`SELECT .* FROM "land_commodities" JOIN lands .* WHERE .* AND lands.city_id = \$3`).
+			WithArgs(ids.CommodityID, false, int64(1)).WillReturnError(errors.New("database error"))
+
+		result, err := repo.FindActiveByArea(context.TODO(), ids.CommodityID, 1, 0)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
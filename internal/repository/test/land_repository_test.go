@@ -177,6 +177,41 @@ func TestLandRepository_FindByUserID(t *testing.T) {
 
 }
 
+func TestLandRepository_FindByProvinceID(t *testing.T) {
+	mockDB, repo, ids, rows, _ := LandRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	expectedSQL := `SELECT "lands"."id","lands"."user_id","lands"."city_id","lands"."land_area","lands"."unit","lands"."certificate","lands"."created_at","lands"."updated_at","lands"."deleted_at" FROM "lands" JOIN cities ON cities.id = lands.city_id WHERE cities.province_id = $1 AND "lands"."deleted_at" IS NULL ORDER BY lands.created_at desc`
+	citySQL := `SELECT * FROM "cities" WHERE "cities"."id" = $1`
+	userSQL := `SELECT "id","name","email","phone" FROM "users" WHERE "users"."id" = $1 AND "users"."deleted_at" IS NULL`
+
+	t.Run("should return lands with city and owner when find by province id successfully", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).WithArgs(int64(1)).WillReturnRows(rows.Lands)
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(citySQL)).WithArgs(ids.CityID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "province_id"}).AddRow(ids.CityID, "Kota Banda Aceh", int64(1)))
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(userSQL)).WithArgs(ids.UserID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email", "phone"}).AddRow(ids.UserID, "farmer", "farmer@example.com", "08123456789"))
+
+		result, err := repo.FindByProvinceID(context.TODO(), 1)
+		assert.Nil(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, ids.LandID, result[0].ID)
+		assert.Equal(t, "Kota Banda Aceh", result[0].City.Name)
+		assert.Equal(t, "farmer", result[0].User.Name)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when find by province id failed", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).WithArgs(int64(1)).WillReturnError(errors.New("database error"))
+
+		result, err := repo.FindByProvinceID(context.TODO(), 1)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
 func TestLandRepository_FindAll(t *testing.T) {
 	mockDB, repo, ids, rows, _ := LandRepositorySetup(t)
 
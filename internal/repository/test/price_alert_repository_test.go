@@ -0,0 +1,118 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	repository_implementation "github.com/ryvasa/go-super-farmer/internal/repository/implementation"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	"github.com/ryvasa/go-super-farmer/pkg/database"
+	"github.com/stretchr/testify/assert"
+)
+
+type PriceAlertRepositoryIDs struct {
+	PriceAlertID uuid.UUID
+	UserID       uuid.UUID
+	CommodityID  uuid.UUID
+	CityID       int64
+}
+
+func PriceAlertRepositorySetup(t *testing.T) (*database.MockDB, repository_interface.PriceAlertRepository, PriceAlertRepositoryIDs) {
+	mockDB := database.NewMockDB(t)
+
+	repo := repository_implementation.NewPriceAlertRepository(mockDB.DB)
+
+	ids := PriceAlertRepositoryIDs{
+		PriceAlertID: uuid.New(),
+		UserID:       uuid.New(),
+		CommodityID:  uuid.New(),
+		CityID:       1,
+	}
+
+	return mockDB, repo, ids
+}
+
+func TestPriceAlertRepository_FindActiveByCommodityIDAndCityID(t *testing.T) {
+	mockDB, repo, ids := PriceAlertRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	expectedSQL := `SELECT * FROM "price_alerts" WHERE (commodity_id = $1 AND city_id = $2 AND active = $3) AND "price_alerts"."deleted_at" IS NULL`
+	userSQL := `SELECT "id","name","email" FROM "users" WHERE "users"."id" = $1 AND "users"."deleted_at" IS NULL`
+
+	t.Run("should return active alerts with their user", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).
+			WithArgs(ids.CommodityID, ids.CityID, true).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "commodity_id", "city_id", "condition", "target_price", "active"}).
+				AddRow(ids.PriceAlertID, ids.UserID, ids.CommodityID, ids.CityID, "above", float64(12000), true))
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(userSQL)).
+			WithArgs(ids.UserID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email"}).AddRow(ids.UserID, "farmer", "farmer@example.com"))
+
+		result, err := repo.FindActiveByCommodityIDAndCityID(context.TODO(), ids.CommodityID, ids.CityID)
+		assert.Nil(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "farmer@example.com", result[0].User.Email)
+		assert.Equal(t, float64(12000), *result[0].TargetPrice)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when query failed", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).
+			WithArgs(ids.CommodityID, ids.CityID, true).
+			WillReturnError(errors.New("database error"))
+
+		result, err := repo.FindActiveByCommodityIDAndCityID(context.TODO(), ids.CommodityID, ids.CityID)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestPriceAlertRepository_Update(t *testing.T) {
+	mockDB, repo, ids := PriceAlertRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	expectedSQL := `UPDATE "price_alerts" SET "target_price"=$1,"change_percent"=$2,"window_days"=$3,"cooldown_minutes"=$4,"active"=$5,"updated_at"=$6 WHERE id = $7 AND "price_alerts"."deleted_at" IS NULL`
+
+	t.Run("should write active even when it is false", func(t *testing.T) {
+		target := float64(12000)
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
+			WithArgs(target, nil, 7, 60, false, sqlmock.AnyArg(), ids.PriceAlertID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mockDB.Mock.ExpectCommit()
+
+		err := repo.Update(context.TODO(), &domain.PriceAlert{ID: ids.PriceAlertID, TargetPrice: &target, WindowDays: 7, CooldownMinutes: 60, Active: false})
+		assert.Nil(t, err)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestPriceAlertRepository_UpdateLastTriggered(t *testing.T) {
+	mockDB, repo, ids := PriceAlertRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	expectedSQL := `UPDATE "price_alerts" SET "last_triggered_at"=$1 WHERE id = $2 AND "price_alerts"."deleted_at" IS NULL`
+
+	t.Run("should update last triggered successfully", func(t *testing.T) {
+		now := time.Now()
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
+			WithArgs(now, ids.PriceAlertID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mockDB.Mock.ExpectCommit()
+
+		err := repo.UpdateLastTriggered(context.TODO(), ids.PriceAlertID, now)
+		assert.Nil(t, err)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
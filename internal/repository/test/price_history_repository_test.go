@@ -79,12 +79,12 @@ func TestPriceHistoryRepository_Create(t *testing.T) {
 
 	defer mockDB.SqlDB.Close()
 
-	expectedSQL := `INSERT INTO "price_histories" ("id","commodity_id","city_id","price","unit","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`
+	expectedSQL := `INSERT INTO "price_histories" ("id","commodity_id","city_id","price","unit","changed_by","reason","source","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)`
 
 	t.Run("should not return error when create successfully", func(t *testing.T) {
 		mockDB.Mock.ExpectBegin()
 		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
-			WithArgs(ids.PriceHistoryID, ids.CommodityID, ids.CityID, float64(100), "idr", sqlmock.AnyArg(), sqlmock.AnyArg(), nil).
+			WithArgs(ids.PriceHistoryID, ids.CommodityID, ids.CityID, float64(100), "idr", nil, "", "", sqlmock.AnyArg(), sqlmock.AnyArg(), nil).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		mockDB.Mock.ExpectCommit()
 
@@ -96,7 +96,7 @@ func TestPriceHistoryRepository_Create(t *testing.T) {
 	t.Run("should return error when create failed", func(t *testing.T) {
 		mockDB.Mock.ExpectBegin()
 		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
-			WithArgs(ids.PriceHistoryID, ids.CommodityID, ids.CityID, float64(100), "idr", sqlmock.AnyArg(), sqlmock.AnyArg(), nil).
+			WithArgs(ids.PriceHistoryID, ids.CommodityID, ids.CityID, float64(100), "idr", nil, "", "", sqlmock.AnyArg(), sqlmock.AnyArg(), nil).
 			WillReturnError(errors.New("database error"))
 		mockDB.Mock.ExpectRollback()
 
@@ -183,3 +183,104 @@ func TestPriceHistoryRepository_FindByCommodityIDAndCityID(t *testing.T) {
 		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
 	})
 }
+
+func TestPriceHistoryRepository_FindBuckets(t *testing.T) {
+	mockDB, repo, ids, _, _ := PriceHistoryRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should return buckets successfully", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"period", "open", "high", "low", "close", "avg", "count"}).
+			AddRow(start, float64(100), float64(120), float64(90), float64(110), float64(105), 4)
+		mockDB.Mock.ExpectQuery(`WITH points AS \(.*date_trunc\(\$5, observed_at\) AS period.*GROUP BY period`).
+			WithArgs(ids.CommodityID, ids.CityID, ids.CommodityID, ids.CityID, "week", start, end).
+			WillReturnRows(rows)
+
+		result, err := repo.FindBuckets(context.TODO(), ids.CommodityID, ids.CityID, "week", start, end)
+		assert.Nil(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, float64(100), result[0].Open)
+		assert.Equal(t, float64(120), result[0].High)
+		assert.Equal(t, float64(90), result[0].Low)
+		assert.Equal(t, float64(110), result[0].Close)
+		assert.Equal(t, int64(4), result[0].Count)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when query failed", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(`WITH points AS`).
+			WillReturnError(errors.New("database error"))
+
+		result, err := repo.FindBuckets(context.TODO(), ids.CommodityID, ids.CityID, "day", start, end)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestPriceHistoryRepository_FindCityCloses(t *testing.T) {
+	mockDB, repo, ids, _, _ := PriceHistoryRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should return city closes successfully", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"city_id", "province_id", "period", "close"}).
+			AddRow(ids.CityID, int64(3), "2023-12-01", float64(9000)).
+			AddRow(ids.CityID, int64(3), "2024-02-01", float64(11000))
+		mockDB.Mock.ExpectQuery(`WITH points AS \(.*WHERE \$3 = 0 OR cities.province_id = \$4.*GROUP BY city_id, province_id, period`).
+			WithArgs(ids.CommodityID, ids.CommodityID, int64(3), int64(3), start, "month", start, end).
+			WillReturnRows(rows)
+
+		result, err := repo.FindCityCloses(context.TODO(), ids.CommodityID, 3, "month", start, end)
+		assert.Nil(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "2023-12-01", result[0].Period)
+		assert.Equal(t, int64(3), result[1].ProvinceID)
+		assert.Equal(t, float64(11000), result[1].Close)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when query failed", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(`WITH points AS`).
+			WillReturnError(errors.New("database error"))
+
+		result, err := repo.FindCityCloses(context.TODO(), ids.CommodityID, 0, "month", start, end)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestPriceHistoryRepository_FindRecentPrices(t *testing.T) {
+	mockDB, repo, ids, _, _ := PriceHistoryRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	t.Run("should return recent prices successfully", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"price"}).AddRow(float64(11000)).AddRow(float64(10000))
+		mockDB.Mock.ExpectQuery(`SELECT "price" FROM "price_histories" WHERE \(commodity_id = \$1 AND city_id = \$2\) AND "price_histories"."deleted_at" IS NULL ORDER BY updated_at desc LIMIT \$3`).
+			WithArgs(ids.CommodityID, ids.CityID, 30).
+			WillReturnRows(rows)
+
+		result, err := repo.FindRecentPrices(context.TODO(), ids.CommodityID, ids.CityID, 30)
+		assert.Nil(t, err)
+		assert.Equal(t, []float64{11000, 10000}, result)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when query failed", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(`SELECT "price" FROM "price_histories"`).
+			WillReturnError(errors.New("database error"))
+
+		result, err := repo.FindRecentPrices(context.TODO(), ids.CommodityID, ids.CityID, 30)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
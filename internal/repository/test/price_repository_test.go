@@ -95,12 +95,12 @@ func TestPriceRepository_Create(t *testing.T) {
 
 	defer mockDB.SqlDB.Close()
 
-	expectedSQL := `INSERT INTO "prices" ("id","commodity_id","city_id","price","unit","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`
+	expectedSQL := `INSERT INTO "prices" ("id","commodity_id","city_id","price","unit","changed_by","reason","source","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)`
 
 	t.Run("should not return error when create successfully", func(t *testing.T) {
 		mockDB.Mock.ExpectBegin()
 		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
-			WithArgs(ids.PriceID, ids.CommodityID, ids.CityID, float64(100), "idr", sqlmock.AnyArg(), sqlmock.AnyArg(), nil).
+			WithArgs(ids.PriceID, ids.CommodityID, ids.CityID, float64(100), "idr", nil, "", "", sqlmock.AnyArg(), sqlmock.AnyArg(), nil).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		mockDB.Mock.ExpectCommit()
 
@@ -112,7 +112,7 @@ func TestPriceRepository_Create(t *testing.T) {
 	t.Run("should return error when create failed", func(t *testing.T) {
 		mockDB.Mock.ExpectBegin()
 		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
-			WithArgs(ids.PriceID, ids.CommodityID, ids.CityID, float64(100), "idr", sqlmock.AnyArg(), sqlmock.AnyArg(), nil).
+			WithArgs(ids.PriceID, ids.CommodityID, ids.CityID, float64(100), "idr", nil, "", "", sqlmock.AnyArg(), sqlmock.AnyArg(), nil).
 			WillReturnError(errors.New("database error"))
 		mockDB.Mock.ExpectRollback()
 
@@ -284,12 +284,12 @@ func TestPriceRepository_Update(t *testing.T) {
 
 	defer mockDB.SqlDB.Close()
 
-	expectedSQL := `UPDATE "prices" SET "id"=$1,"commodity_id"=$2,"city_id"=$3,"price"=$4,"updated_at"=$5 WHERE id = $6 AND "prices"."deleted_at" IS NULL`
+	expectedSQL := `UPDATE "prices" SET "price"=$1,"changed_by"=$2,"reason"=$3,"source"=$4,"updated_at"=$5 WHERE id = $6 AND "prices"."deleted_at" IS NULL`
 
 	t.Run("should not return error when update successfully", func(t *testing.T) {
 		mockDB.Mock.ExpectBegin()
 		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
-			WithArgs(ids.PriceID, ids.CommodityID, ids.CityID, float64(100), sqlmock.AnyArg(), ids.PriceID).
+			WithArgs(float64(100), nil, "", "", sqlmock.AnyArg(), ids.PriceID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		mockDB.Mock.ExpectCommit()
 
@@ -301,7 +301,7 @@ func TestPriceRepository_Update(t *testing.T) {
 	t.Run("should return error when update failed", func(t *testing.T) {
 		mockDB.Mock.ExpectBegin()
 		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
-			WithArgs(ids.PriceID, ids.CommodityID, ids.CityID, float64(100), sqlmock.AnyArg(), ids.PriceID).
+			WithArgs(float64(100), nil, "", "", sqlmock.AnyArg(), ids.PriceID).
 			WillReturnError(errors.New("database error"))
 		mockDB.Mock.ExpectRollback()
 
@@ -314,7 +314,7 @@ func TestPriceRepository_Update(t *testing.T) {
 	t.Run("should return error when update not found", func(t *testing.T) {
 		mockDB.Mock.ExpectBegin()
 		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
-			WithArgs(ids.PriceID, ids.CommodityID, ids.CityID, float64(100), sqlmock.AnyArg(), ids.PriceID).
+			WithArgs(float64(100), nil, "", "", sqlmock.AnyArg(), ids.PriceID).
 			WillReturnError(gorm.ErrRecordNotFound)
 		mockDB.Mock.ExpectRollback()
 
@@ -506,6 +506,33 @@ func TestPriceRepository_FindByCommodityIDAndCityID(t *testing.T) {
 	})
 }
 
+func TestPriceRepository_FindByCommodityIDsAndCityIDs(t *testing.T) {
+	mockDB, repo, ids, rows, _, _ := PriceRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	expectedSQL := `SELECT * FROM "prices" WHERE (commodity_id IN ($1) AND city_id IN ($2,$3)) AND "prices"."deleted_at" IS NULL FOR UPDATE`
+
+	t.Run("should return and lock prices successfully", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).WithArgs(ids.CommodityID, ids.CityID, int64(2)).WillReturnRows(rows.Price)
+
+		result, err := repo.FindByCommodityIDsAndCityIDs(context.TODO(), []uuid.UUID{ids.CommodityID}, []int64{ids.CityID, 2})
+		assert.Nil(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, ids.PriceID, result[0].ID)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when find failed", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).WithArgs(ids.CommodityID, ids.CityID, int64(2)).WillReturnError(errors.New("database error"))
+
+		result, err := repo.FindByCommodityIDsAndCityIDs(context.TODO(), []uuid.UUID{ids.CommodityID}, []int64{ids.CityID, 2})
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
 func TestPriceRepository_Count(t *testing.T) {
 	mockDB, repo, _, _, _, dtos := PriceRepositorySetup(t)
 
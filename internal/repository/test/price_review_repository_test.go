@@ -0,0 +1,49 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	repository_implementation "github.com/ryvasa/go-super-farmer/internal/repository/implementation"
+	"github.com/ryvasa/go-super-farmer/pkg/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriceReviewRepository_Update(t *testing.T) {
+	mockDB := database.NewMockDB(t)
+	defer mockDB.SqlDB.Close()
+	repo := repository_implementation.NewPriceReviewRepository(mockDB.BaseRepo)
+
+	now := time.Now()
+	reviewerID := uuid.New()
+	review := &domain.PriceReview{ID: uuid.New(), Status: domain.PriceReviewRejected, ReviewedBy: &reviewerID, ReviewedAt: &now, Note: "typo"}
+
+	t.Run("should update an open review successfully", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(`UPDATE "price_reviews" SET "price_id"=\$1,"status"=\$2,"reviewed_by"=\$3,"reviewed_at"=\$4,"note"=\$5,"updated_at"=\$6 WHERE id = \$7 AND status IN \(\$8,\$9\)`).
+			WithArgs(nil, domain.PriceReviewRejected, &reviewerID, &now, "typo", sqlmock.AnyArg(), review.ID, domain.PriceReviewPending, domain.PriceReviewForced).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mockDB.Mock.ExpectCommit()
+
+		updated, err := repo.Update(context.TODO(), review)
+		assert.Nil(t, err)
+		assert.True(t, updated)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return false when review was already decided", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(`UPDATE "price_reviews"`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mockDB.Mock.ExpectCommit()
+
+		updated, err := repo.Update(context.TODO(), review)
+		assert.Nil(t, err)
+		assert.False(t, updated)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
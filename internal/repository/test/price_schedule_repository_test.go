@@ -0,0 +1,83 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	repository_implementation "github.com/ryvasa/go-super-farmer/internal/repository/implementation"
+	"github.com/ryvasa/go-super-farmer/pkg/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriceScheduleRepository_FindDue(t *testing.T) {
+	mockDB := database.NewMockDB(t)
+	defer mockDB.SqlDB.Close()
+	repo := repository_implementation.NewPriceScheduleRepository(mockDB.BaseRepo)
+
+	now := time.Now()
+	scheduleID := uuid.New()
+	priceID := uuid.New()
+
+	t.Run("should return due schedules successfully", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "price_id", "price", "effective_at", "status"}).
+			AddRow(scheduleID, priceID, float64(900), now.Add(-time.Minute), domain.PriceSchedulePending)
+		mockDB.Mock.ExpectQuery(`SELECT \* FROM "price_schedules" WHERE status = \$1 AND effective_at <= \$2 ORDER BY effective_at asc, created_at asc LIMIT \$3 FOR UPDATE SKIP LOCKED`).
+			WithArgs(domain.PriceSchedulePending, now, 1).
+			WillReturnRows(rows)
+
+		result, err := repo.FindDue(context.TODO(), now, 1)
+		assert.Nil(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, priceID, result[0].PriceID)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when query failed", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(`SELECT \* FROM "price_schedules"`).
+			WillReturnError(errors.New("database error"))
+
+		result, err := repo.FindDue(context.TODO(), now, 1)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestPriceScheduleRepository_Update(t *testing.T) {
+	mockDB := database.NewMockDB(t)
+	defer mockDB.SqlDB.Close()
+	repo := repository_implementation.NewPriceScheduleRepository(mockDB.BaseRepo)
+
+	now := time.Now()
+	schedule := &domain.PriceSchedule{ID: uuid.New(), Status: domain.PriceScheduleApplied, AppliedAt: &now}
+
+	t.Run("should update a pending schedule successfully", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(`UPDATE "price_schedules" SET "status"=\$1,"applied_at"=\$2,"cancelled_at"=\$3,"updated_at"=\$4 WHERE id = \$5 AND status = \$6`).
+			WithArgs(domain.PriceScheduleApplied, &now, nil, sqlmock.AnyArg(), schedule.ID, domain.PriceSchedulePending).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mockDB.Mock.ExpectCommit()
+
+		updated, err := repo.Update(context.TODO(), schedule)
+		assert.Nil(t, err)
+		assert.True(t, updated)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return false when schedule is no longer pending", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(`UPDATE "price_schedules"`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mockDB.Mock.ExpectCommit()
+
+		updated, err := repo.Update(context.TODO(), schedule)
+		assert.Nil(t, err)
+		assert.False(t, updated)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
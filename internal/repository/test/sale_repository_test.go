@@ -94,12 +94,12 @@ func TestSaleRepo_Create(t *testing.T) {
 	mockDB, repo, ids, _, domains, _ := SaleRepoSetup(t)
 	defer mockDB.SqlDB.Close()
 
-	expectedSQL := `INSERT INTO "sales" ("id","city_id","commodity_id","quantity","unit","price","sale_date","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)`
+	expectedSQL := `INSERT INTO "sales" ("id","city_id","commodity_id","buyer_id","quantity","unit","price","sale_date","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)`
 
 	t.Run("should create a new sale successfully", func(t *testing.T) {
 		mockDB.Mock.ExpectBegin()
 		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
-			WithArgs(ids.SaleID, ids.CityID, ids.CommodityID, float64(1), "kg", float64(100), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), nil).
+			WithArgs(ids.SaleID, ids.CityID, ids.CommodityID, nil, float64(1), "kg", float64(100), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), nil).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		mockDB.Mock.ExpectCommit()
 
@@ -111,7 +111,7 @@ func TestSaleRepo_Create(t *testing.T) {
 	t.Run("should return an error if create fails", func(t *testing.T) {
 		mockDB.Mock.ExpectBegin()
 		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
-			WithArgs(ids.SaleID, ids.CityID, ids.CommodityID, float64(1), "kg", float64(100), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), nil).
+			WithArgs(ids.SaleID, ids.CityID, ids.CommodityID, nil, float64(1), "kg", float64(100), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), nil).
 			WillReturnError(utils.NewInternalError("internal error"))
 		mockDB.Mock.ExpectRollback()
 
@@ -492,3 +492,36 @@ func TestSaleRepo_FindDeletedByID(t *testing.T) {
 		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
 	})
 }
+
+func TestSaleRepo_FindByCommodityIDAndCityIDBetween(t *testing.T) {
+	mockDB, repo, ids, rows, _, _ := SaleRepoSetup(t)
+	defer mockDB.SqlDB.Close()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+	expectedSQL := `SELECT * FROM "sales" WHERE (commodity_id = $1 AND city_id = $2 AND sale_date >= $3 AND sale_date < $4) AND "sales"."deleted_at" IS NULL ORDER BY sale_date asc`
+
+	t.Run("should return the sales of the range successfully", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).
+			WithArgs(ids.CommodityID, ids.CityID, start, end).
+			WillReturnRows(rows.Sale)
+
+		result, err := repo.FindByCommodityIDAndCityIDBetween(context.TODO(), ids.CommodityID, ids.CityID, start, end)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+		assert.Equal(t, ids.SaleID, result[0].ID)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return an error if find fails", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).
+			WithArgs(ids.CommodityID, ids.CityID, start, end).
+			WillReturnError(utils.NewInternalError("internal error"))
+
+		result, err := repo.FindByCommodityIDAndCityIDBetween(context.TODO(), ids.CommodityID, ids.CityID, start, end)
+		assert.NotNil(t, err)
+		assert.EqualError(t, err, "internal error")
+		assert.Nil(t, result)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
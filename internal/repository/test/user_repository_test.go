@@ -85,12 +85,12 @@ func TestUserRepository_Create(t *testing.T) {
 
 	defer mockDB.SqlDB.Close()
 
-	expectedSQL := `INSERT INTO "users" ("id","name","email","password","role_id","phone","verified","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)`
+	expectedSQL := `INSERT INTO "users" ("id","name","email","password","role_id","province_id","phone","verified","created_at","updated_at","deleted_at","totp_secret","totp_enabled","recovery_codes") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14)`
 
 	t.Run("should not return error when create successfully", func(t *testing.T) {
 		mockDB.Mock.ExpectBegin()
 		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
-			WithArgs(ids.UserID, "user name", "user@email.com", "password", 1, "123456789", false, sqlmock.AnyArg(), sqlmock.AnyArg(), nil).
+			WithArgs(ids.UserID, "user name", "user@email.com", "password", 1, nil, "123456789", false, sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, nil).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		mockDB.Mock.ExpectCommit()
 
@@ -102,7 +102,7 @@ func TestUserRepository_Create(t *testing.T) {
 	t.Run("should return error when create failed", func(t *testing.T) {
 		mockDB.Mock.ExpectBegin()
 		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
-			WithArgs(ids.UserID, "user name", "user@email.com", "password", 1, "123456789", false, sqlmock.AnyArg(), sqlmock.AnyArg(), nil).
+			WithArgs(ids.UserID, "user name", "user@email.com", "password", 1, nil, "123456789", false, sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, nil).
 			WillReturnError(errors.New("database error"))
 		mockDB.Mock.ExpectRollback()
 
@@ -424,3 +424,151 @@ func TestUserRepository_Count(t *testing.T) {
 		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
 	})
 }
+
+func TestUserRepository_FindAuthByID(t *testing.T) {
+	mockDB, repo, ids, rows, _, _ := UserRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	expectedSQL := `SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT $2`
+
+	t.Run("should return user with credentials when find successfully", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).WithArgs(ids.UserID, 1).WillReturnRows(rows.User)
+		result, err := repo.FindAuthByID(context.TODO(), ids.UserID)
+		assert.Nil(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, ids.UserID, result.ID)
+		assert.Equal(t, "password", result.Password)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+	t.Run("should return error when find by id not found", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).WithArgs(ids.UserID, 1).WillReturnRows(rows.NotFound)
+		result, err := repo.FindAuthByID(context.TODO(), ids.UserID)
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestUserRepository_FindWithRoleByID(t *testing.T) {
+	mockDB, repo, ids, rows, _, _ := UserRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	expectedSQL := `SELECT users.id,users.name,users.email,users.phone,users.role_id,users.province_id,users.created_at,users.updated_at FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT $2`
+	roleSQL := `SELECT * FROM "roles" WHERE "roles"."id" = $1`
+
+	t.Run("should return user with role and province when find successfully", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).WithArgs(ids.UserID, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email", "role_id", "province_id"}).
+				AddRow(ids.UserID, "user name", "user@email.com", int64(4), int64(1)))
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(roleSQL)).WithArgs(int64(4)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(int64(4), "FieldOfficer"))
+
+		result, err := repo.FindWithRoleByID(context.TODO(), ids.UserID)
+		assert.Nil(t, err)
+		assert.Equal(t, ids.UserID, result.ID)
+		assert.Equal(t, "FieldOfficer", result.Role.Name)
+		assert.Equal(t, int64(1), *result.ProvinceID)
+		assert.Empty(t, result.Password)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+	t.Run("should return error when find by id not found", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).WithArgs(ids.UserID, 1).WillReturnRows(rows.NotFound)
+		result, err := repo.FindWithRoleByID(context.TODO(), ids.UserID)
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestUserRepository_UpdateTwoFactor(t *testing.T) {
+	mockDB, repo, ids, _, _, _ := UserRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	expectedSQL := `UPDATE "users" SET "updated_at"=$1,"totp_secret"=$2,"totp_enabled"=$3,"recovery_codes"=$4 WHERE id = $5 AND "users"."deleted_at" IS NULL`
+
+	t.Run("should clear two factor columns successfully", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
+			WithArgs(sqlmock.AnyArg(), nil, false, nil, ids.UserID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mockDB.Mock.ExpectCommit()
+
+		err := repo.UpdateTwoFactor(context.TODO(), ids.UserID, &domain.User{})
+		assert.Nil(t, err)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when update failed", func(t *testing.T) {
+		secret := "SECRET"
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
+			WithArgs(sqlmock.AnyArg(), secret, true, nil, ids.UserID).
+			WillReturnError(errors.New("database error"))
+		mockDB.Mock.ExpectRollback()
+
+		err := repo.UpdateTwoFactor(context.TODO(), ids.UserID, &domain.User{TOTPSecret: &secret, TOTPEnabled: true})
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestUserRepository_Anonymize(t *testing.T) {
+	mockDB, repo, ids, _, _, _ := UserRepositorySetup(t)
+
+	defer mockDB.SqlDB.Close()
+
+	userSQL := `UPDATE "users" SET "deleted_at"=$1,"email"=$2,"name"=$3,"password"=$4,"phone"=$5,"province_id"=$6,"recovery_codes"=$7,"totp_enabled"=$8,"totp_secret"=$9,"verified"=$10,"updated_at"=$11 WHERE id = $12 AND "users"."deleted_at" IS NULL`
+	apiKeySQL := `UPDATE "api_keys" SET "deleted_at"=$1 WHERE owner_id = $2 AND "api_keys"."deleted_at" IS NULL`
+	alertSQL := `UPDATE "price_alerts" SET "active"=$1,"updated_at"=$2 WHERE user_id = $3 AND "price_alerts"."deleted_at" IS NULL`
+
+	t.Run("should anonymize user, remove api keys and pause price alerts successfully", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(userSQL)).
+			WithArgs(sqlmock.AnyArg(), "deleted-"+ids.UserID.String()+"@deleted.invalid", "Deleted User", "", nil, nil, nil, false, nil, false, sqlmock.AnyArg(), ids.UserID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(apiKeySQL)).
+			WithArgs(sqlmock.AnyArg(), ids.UserID).
+			WillReturnResult(sqlmock.NewResult(1, 2))
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(alertSQL)).
+			WithArgs(false, sqlmock.AnyArg(), ids.UserID).
+			WillReturnResult(sqlmock.NewResult(1, 3))
+		mockDB.Mock.ExpectCommit()
+
+		err := repo.Anonymize(context.TODO(), ids.UserID)
+		assert.Nil(t, err)
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should rollback when pausing price alerts failed", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(userSQL)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(apiKeySQL)).
+			WillReturnResult(sqlmock.NewResult(1, 2))
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(alertSQL)).
+			WithArgs(false, sqlmock.AnyArg(), ids.UserID).
+			WillReturnError(errors.New("database error"))
+		mockDB.Mock.ExpectRollback()
+
+		err := repo.Anonymize(context.TODO(), ids.UserID)
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should rollback when removing api keys failed", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(userSQL)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(apiKeySQL)).
+			WithArgs(sqlmock.AnyArg(), ids.UserID).
+			WillReturnError(errors.New("database error"))
+		mockDB.Mock.ExpectRollback()
+
+		err := repo.Anonymize(context.TODO(), ids.UserID)
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
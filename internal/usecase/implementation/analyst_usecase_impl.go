@@ -0,0 +1,202 @@
+package usecase_implementation
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+type AnalystUsecaseImpl struct {
+	commodityRepo repository_interface.CommodityRepository
+	priceRepo     repository_interface.PriceRepository
+	supplyRepo    repository_interface.SupplyRepository
+	demandRepo    repository_interface.DemandRepository
+	unitRepo      repository_interface.UnitRepository
+}
+
+func NewAnalystUsecase(
+	commodityRepo repository_interface.CommodityRepository,
+	priceRepo repository_interface.PriceRepository,
+	supplyRepo repository_interface.SupplyRepository,
+	demandRepo repository_interface.DemandRepository,
+	unitRepo repository_interface.UnitRepository,
+) usecase_interface.AnalystUsecase {
+	return &AnalystUsecaseImpl{commodityRepo, priceRepo, supplyRepo, demandRepo, unitRepo}
+}
+
+// GetMarketOverview puts the current price, supply and demand of a commodity side by side for every city
+func (uc *AnalystUsecaseImpl) GetMarketOverview(ctx context.Context, commodityID uuid.UUID) (*dto.MarketOverviewDTO, error) {
+	commodity, err := uc.commodityRepo.FindByID(ctx, commodityID)
+	if err != nil {
+		return nil, utils.NewNotFoundError("commodity not found")
+	}
+
+	prices, err := uc.priceRepo.FindByCommodityID(ctx, commodityID)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	supplies, err := uc.supplyRepo.FindByCommodityID(ctx, commodityID)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	demands, err := uc.demandRepo.FindByCommodityID(ctx, commodityID)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	cities := make(map[int64]*dto.MarketCityDTO)
+	city := func(id int64) *dto.MarketCityDTO {
+		if _, ok := cities[id]; !ok {
+			cities[id] = &dto.MarketCityDTO{CityID: id}
+		}
+		return cities[id]
+	}
+
+	overview := &dto.MarketOverviewDTO{Commodity: commodity}
+	var priceSum float64
+	for _, price := range prices {
+		c := city(price.CityID)
+		c.City = price.City
+		c.Price = &price.Price
+		c.PriceUnit = price.Unit
+		priceSum += price.Price
+	}
+	for _, supply := range supplies {
+		city(supply.CityID).Supply += supply.Quantity
+		overview.TotalSupply += supply.Quantity
+	}
+	for _, demand := range demands {
+		city(demand.CityID).Demand += demand.Quantity
+		overview.TotalDemand += demand.Quantity
+	}
+	if len(prices) > 0 {
+		overview.AveragePrice = priceSum / float64(len(prices))
+	}
+
+	overview.Cities = make([]*dto.MarketCityDTO, 0, len(cities))
+	for _, c := range cities {
+		c.Balance = c.Supply - c.Demand
+		overview.Cities = append(overview.Cities, c)
+	}
+	sort.Slice(overview.Cities, func(i, j int) bool {
+		return overview.Cities[i].CityID < overview.Cities[j].CityID
+	})
+
+	return overview, nil
+}
+
+// GetPriceComparison ranks every city with a current price from the cheapest and compares it with the national median.
+// Cities of the same province are its neighbors, there are no distances to go by. Supply and demand are summed in kg,
+// quantities in an unknown or non mass unit are left out
+func (uc *AnalystUsecaseImpl) GetPriceComparison(ctx context.Context, commodityID uuid.UUID) (*dto.PriceComparisonDTO, error) {
+	commodity, err := uc.commodityRepo.FindByID(ctx, commodityID)
+	if err != nil {
+		return nil, utils.NewNotFoundError("commodity not found")
+	}
+
+	prices, err := uc.priceRepo.FindByCommodityID(ctx, commodityID)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	supplies, err := uc.supplyRepo.FindByCommodityID(ctx, commodityID)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	demands, err := uc.demandRepo.FindByCommodityID(ctx, commodityID)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	units, err := uc.unitRepo.FindAll(ctx)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	factors := massUnitFactors(units)
+
+	supply := map[int64]float64{}
+	for _, s := range supplies {
+		if kg, ok := kilograms(factors, s.Quantity, s.Unit); ok {
+			supply[s.CityID] += kg
+		}
+	}
+	demand := map[int64]float64{}
+	for _, d := range demands {
+		if kg, ok := kilograms(factors, d.Quantity, d.Unit); ok {
+			demand[d.CityID] += kg
+		}
+	}
+
+	comparison := &dto.PriceComparisonDTO{Commodity: commodity, Cities: make([]*dto.CityPriceComparisonDTO, 0, len(prices))}
+	if len(prices) == 0 {
+		return comparison, nil
+	}
+
+	values := make([]float64, 0, len(prices))
+	provinces := map[int64][]*dto.CityPriceComparisonDTO{}
+	for _, price := range prices {
+		city := &dto.CityPriceComparisonDTO{
+			CityID: price.CityID,
+			City:   price.City,
+			Price:  price.Price,
+			Supply: supply[price.CityID],
+			Demand: demand[price.CityID],
+		}
+		if price.City != nil {
+			city.ProvinceID = price.City.ProvinceID
+		}
+		values = append(values, price.Price)
+		provinces[city.ProvinceID] = append(provinces[city.ProvinceID], city)
+		comparison.Cities = append(comparison.Cities, city)
+	}
+	comparison.NationalMedian = medianOf(values)
+
+	for _, cities := range provinces {
+		provinceValues := make([]float64, len(cities))
+		for i, city := range cities {
+			provinceValues[i] = city.Price
+		}
+		provinceMedian := medianOf(provinceValues)
+
+		for _, city := range cities {
+			city.ProvinceMedian = provinceMedian
+			city.Neighbors = len(cities) - 1
+			for _, neighbor := range cities {
+				if neighbor == city {
+					continue
+				}
+				if city.CheapestNeighbor == nil || neighbor.Price < city.CheapestNeighbor.Price {
+					city.CheapestNeighbor = &dto.PriceNeighborDTO{CityID: neighbor.CityID, City: neighbor.City, Price: neighbor.Price}
+				}
+			}
+			if city.CheapestNeighbor != nil {
+				city.CheapestNeighbor.Spread = city.CheapestNeighbor.Price - city.Price
+			}
+		}
+	}
+
+	for _, city := range comparison.Cities {
+		city.Spread = city.Price - comparison.NationalMedian
+		if comparison.NationalMedian > 0 {
+			city.SpreadPercent = math.Round(city.Spread/comparison.NationalMedian*10000) / 100
+		}
+		city.Surplus = city.Supply > city.Demand
+		city.HighPriceSurplus = city.Surplus && city.Spread > 0
+	}
+
+	sort.SliceStable(comparison.Cities, func(i, j int) bool {
+		if comparison.Cities[i].Price != comparison.Cities[j].Price {
+			return comparison.Cities[i].Price < comparison.Cities[j].Price
+		}
+		return comparison.Cities[i].CityID < comparison.Cities[j].CityID
+	})
+	for i, city := range comparison.Cities {
+		city.Rank = i + 1
+	}
+
+	return comparison, nil
+}
@@ -0,0 +1,162 @@
+package usecase_implementation
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/pkg/logrus"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+const (
+	apiKeyPrefix = "sf"
+	// last_used_at is only written once per interval to avoid an update on every request
+	apiKeyLastUsedInterval = time.Minute
+)
+
+type APIKeyUsecaseImpl struct {
+	apiKeyRepo repository_interface.APIKeyRepository
+	userRepo   repository_interface.UserRepository
+}
+
+func NewAPIKeyUsecase(apiKeyRepo repository_interface.APIKeyRepository, userRepo repository_interface.UserRepository) usecase_interface.APIKeyUsecase {
+	return &APIKeyUsecaseImpl{apiKeyRepo, userRepo}
+}
+
+func (uc *APIKeyUsecaseImpl) CreateAPIKey(ctx context.Context, ownerID uuid.UUID, req *dto.APIKeyCreateDTO) (*dto.APIKeyResponseDTO, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+	if req.ExpiresAt != nil && req.ExpiresAt.Before(time.Now()) {
+		return nil, utils.NewBadRequestError("expires_at must be in the future")
+	}
+
+	prefix, secret, err := generateAPIKey()
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to generate api key")
+	}
+	key := fmt.Sprintf("%s_%s_%s", apiKeyPrefix, prefix, secret)
+
+	apiKey := &domain.APIKey{
+		ID:         uuid.New(),
+		Name:       req.Name,
+		Prefix:     prefix,
+		SecretHash: hashAPIKey(key),
+		OwnerID:    ownerID,
+		Scopes:     strings.Join(req.Scopes, ","),
+		ExpiresAt:  req.ExpiresAt,
+	}
+	err = uc.apiKeyRepo.Create(ctx, apiKey)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	// Plain key hanya dikembalikan sekali saat dibuat
+	res := apiKeyDtoFormat(apiKey)
+	res.Key = key
+	return res, nil
+}
+
+func (uc *APIKeyUsecaseImpl) GetAPIKeys(ctx context.Context, ownerID uuid.UUID) ([]*dto.APIKeyResponseDTO, error) {
+	apiKeys, err := uc.apiKeyRepo.FindByOwnerID(ctx, ownerID)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	res := make([]*dto.APIKeyResponseDTO, len(apiKeys))
+	for i, apiKey := range apiKeys {
+		res[i] = apiKeyDtoFormat(apiKey)
+	}
+	return res, nil
+}
+
+func (uc *APIKeyUsecaseImpl) RevokeAPIKey(ctx context.Context, ownerID, id uuid.UUID) error {
+	apiKey, err := uc.apiKeyRepo.FindByID(ctx, id)
+	if err != nil || apiKey.OwnerID != ownerID {
+		return utils.NewNotFoundError("api key not found")
+	}
+
+	err = uc.apiKeyRepo.Delete(ctx, id)
+	if err != nil {
+		return utils.NewInternalError(err.Error())
+	}
+	return nil
+}
+
+func (uc *APIKeyUsecaseImpl) Authenticate(ctx context.Context, key string) (*domain.APIKey, error) {
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) != 3 || parts[0] != apiKeyPrefix {
+		return nil, utils.NewUnauthorizedError("invalid api key")
+	}
+
+	apiKey, err := uc.apiKeyRepo.FindByPrefix(ctx, parts[1])
+	if err != nil {
+		return nil, utils.NewUnauthorizedError("invalid api key")
+	}
+	if subtle.ConstantTimeCompare([]byte(apiKey.SecretHash), []byte(hashAPIKey(key))) != 1 {
+		return nil, utils.NewUnauthorizedError("invalid api key")
+	}
+
+	now := time.Now()
+	if apiKey.ExpiresAt != nil && apiKey.ExpiresAt.Before(now) {
+		return nil, utils.NewUnauthorizedError("api key has expired")
+	}
+
+	// The key acts on behalf of its owner, so it dies with the owner account
+	owner, err := uc.userRepo.FindWithRoleByID(ctx, apiKey.OwnerID)
+	if err != nil {
+		return nil, utils.NewUnauthorizedError("api key owner not found")
+	}
+	apiKey.Owner = owner
+
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) > apiKeyLastUsedInterval {
+		if err := uc.apiKeyRepo.UpdateLastUsed(ctx, apiKey.ID, now); err != nil {
+			logrus.Log.Error("Failed to update api key last used: ", err)
+		}
+		apiKey.LastUsedAt = &now
+	}
+
+	return apiKey, nil
+}
+
+func generateAPIKey() (string, string, error) {
+	prefix := make([]byte, 4)
+	if _, err := rand.Read(prefix); err != nil {
+		return "", "", err
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	return hex.EncodeToString(prefix), base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func apiKeyDtoFormat(apiKey *domain.APIKey) *dto.APIKeyResponseDTO {
+	return &dto.APIKeyResponseDTO{
+		ID:         apiKey.ID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		OwnerID:    apiKey.OwnerID,
+		Scopes:     strings.Split(apiKey.Scopes, ","),
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		CreatedAt:  apiKey.CreatedAt,
+	}
+}
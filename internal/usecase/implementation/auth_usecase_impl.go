@@ -2,18 +2,54 @@ package usecase_implementation
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
 	"github.com/ryvasa/go-super-farmer/internal/model/dto"
 	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
 	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
 	"github.com/ryvasa/go-super-farmer/pkg/auth/token"
 	"github.com/ryvasa/go-super-farmer/pkg/database/cache"
+	"github.com/ryvasa/go-super-farmer/pkg/logrus"
 	"github.com/ryvasa/go-super-farmer/pkg/messages"
 	"github.com/ryvasa/go-super-farmer/utils"
 )
 
+const (
+	maxEmailAttempts = 5
+	maxIPAttempts    = 20
+	attemptWindow    = 24 * time.Hour
+	baseLockout      = time.Minute
+	maxLockout       = time.Hour
+	passwordResetTTL = 15 * time.Minute
+	mfaChallengeTTL  = 5 * time.Minute
+	totpSetupTTL     = 10 * time.Minute
+	totpIssuer       = "Super Farmer"
+	recoveryCodeSize = 10
+
+	// dummyPasswordHash is a bcrypt hash with the same cost as real passwords, checked for unknown emails
+	dummyPasswordHash = "$2a$10$YXFWvxgYY3qX96gmtM7DB.K3jl56YlxrmGp0kVfqqvogGc/FUvz.i"
+)
+
+// Roles that must have two-factor authentication enabled before getting a full session
+var mfaRequiredRoles = map[string]bool{
+	domain.RoleAdmin: true,
+}
+
+// refreshSession is stored in redis under the refresh token, every rotation keeps the same family
+type refreshSession struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Role     string    `json:"role"`
+	Family   string    `json:"family"`
+	IssuedAt int64     `json:"issued_at"`
+}
+
 type AuthUsecaseImpl struct {
 	userRepo repository_interface.UserRepository
 	token    token.Token
@@ -21,10 +57,11 @@ type AuthUsecaseImpl struct {
 	rabbitMQ messages.RabbitMQ
 	cache    cache.Cache
 	OTP      utils.OTP
+	totp     utils.TOTP
 }
 
-func NewAuthUsecase(userRepo repository_interface.UserRepository, token token.Token, hash utils.Hasher, rabbitMQ messages.RabbitMQ, cache cache.Cache, OTP utils.OTP) usecase_interface.AuthUsecase {
-	return &AuthUsecaseImpl{userRepo, token, hash, rabbitMQ, cache, OTP}
+func NewAuthUsecase(userRepo repository_interface.UserRepository, token token.Token, hash utils.Hasher, rabbitMQ messages.RabbitMQ, cache cache.Cache, OTP utils.OTP, totp utils.TOTP) usecase_interface.AuthUsecase {
+	return &AuthUsecaseImpl{userRepo, token, hash, rabbitMQ, cache, OTP, totp}
 }
 
 func (u *AuthUsecaseImpl) Login(ctx context.Context, req *dto.AuthDTO) (*dto.AuthResponseDTO, error) {
@@ -32,21 +69,205 @@ func (u *AuthUsecaseImpl) Login(ctx context.Context, req *dto.AuthDTO) (*dto.Aut
 		return nil, utils.NewValidationError(err)
 	}
 
+	if err := u.checkLockout(ctx, req.Email, req.IP); err != nil {
+		return nil, err
+	}
+
 	user, err := u.userRepo.FindByEmail(ctx, req.Email)
 	if err != nil {
-		return nil, utils.NewBadRequestError("invalid password or email")
+		// Compare anyway so an unknown email takes as long as a wrong password
+		u.hash.ValidatePassword(req.Password, dummyPasswordHash)
+		return nil, u.recordFailure(ctx, req.Email, req.IP)
 	}
 
 	res := u.hash.ValidatePassword(req.Password, user.Password)
 	if res == false {
-		return nil, utils.NewBadRequestError("invalid password or email")
+		return nil, u.recordFailure(ctx, req.Email, req.IP)
 	}
 
-	token, err := u.token.GenerateToken(user.ID, user.Role.Name)
+	if err := u.resetAttempts(ctx, req.Email, ""); err != nil {
+		return nil, utils.NewInternalError("Failed to reset login attempts")
+	}
+
+	if user.TOTPEnabled {
+		return u.startMFAChallenge(ctx, user)
+	}
+
+	// Admin tanpa 2FA hanya dapat token untuk enrollment, aksesnya dibatasi lewat policy
+	if mfaRequiredRoles[user.Role.Name] {
+		accessToken, err := u.token.GenerateToken(user.ID, token.MFAEnrollmentRole)
+		if err != nil {
+			return nil, utils.NewInternalError(err.Error())
+		}
+		return &dto.AuthResponseDTO{
+			User:                  utils.UserDtoFormat(user),
+			Token:                 accessToken,
+			MFAEnrollmentRequired: true,
+		}, nil
+	}
+
+	session := &refreshSession{
+		UserID:   user.ID,
+		Role:     user.Role.Name,
+		Family:   uuid.New().String(),
+		IssuedAt: time.Now().UnixMilli(),
+	}
+	accessToken, refreshToken, err := u.issueTokens(ctx, session)
 	if err != nil {
 		return nil, utils.NewInternalError(err.Error())
 	}
-	return utils.AuthDtoFormat(user, token), nil
+	return utils.AuthDtoFormat(user, accessToken, refreshToken), nil
+}
+
+func (u *AuthUsecaseImpl) RefreshToken(ctx context.Context, req *dto.AuthRefreshDTO) (*dto.AuthResponseDTO, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+
+	// GETDEL consumes the token atomically, two concurrent refreshes can never both rotate it
+	data, err := u.cache.GetDel(ctx, token.RefreshTokenKey(req.RefreshToken))
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get refresh token")
+	}
+	if data == nil {
+		// A rotated token that comes back means it was stolen, kill the whole family
+		family, err := u.cache.Get(ctx, token.UsedRefreshTokenKey(req.RefreshToken))
+		if err != nil {
+			return nil, utils.NewInternalError("Failed to get refresh token")
+		}
+		if family != nil {
+			logrus.Log.Warnf("refresh token reuse detected for family %s", string(family))
+			if err := u.cache.Delete(ctx, token.RefreshFamilyKey(string(family))); err != nil {
+				return nil, utils.NewInternalError("Failed to revoke refresh token")
+			}
+			return nil, utils.NewUnauthorizedError("refresh token reuse detected")
+		}
+		return nil, utils.NewUnauthorizedError("invalid refresh token")
+	}
+
+	var session refreshSession
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, utils.NewInternalError("invalid refresh token data")
+	}
+
+	err = u.cache.Set(ctx, token.UsedRefreshTokenKey(req.RefreshToken), []byte(session.Family), token.RefreshTokenTTL)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to rotate refresh token")
+	}
+
+	active, err := u.cache.Get(ctx, token.RefreshFamilyKey(session.Family))
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get refresh token")
+	}
+	if active == nil {
+		return nil, utils.NewUnauthorizedError("refresh token has been revoked")
+	}
+
+	revokedAt, err := u.cache.Get(ctx, token.RevokedUserKey(session.UserID.String()))
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get refresh token")
+	}
+	if revokedAt != nil {
+		revokedMilli, err := strconv.ParseInt(string(revokedAt), 10, 64)
+		if err != nil || session.IssuedAt <= revokedMilli {
+			return nil, utils.NewUnauthorizedError("refresh token has been revoked")
+		}
+	}
+
+	// The role may have changed since login, the new access token carries the current one
+	user, err := u.userRepo.FindWithRoleByID(ctx, session.UserID)
+	if err != nil {
+		return nil, utils.NewUnauthorizedError("user not found")
+	}
+	session.Role = user.Role.Name
+
+	accessToken, refreshToken, err := u.issueTokens(ctx, &session)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return utils.AuthDtoFormat(user, accessToken, refreshToken), nil
+}
+
+func (u *AuthUsecaseImpl) Logout(ctx context.Context, claims jwt.MapClaims, req *dto.AuthLogoutDTO) error {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return utils.NewValidationError(err)
+	}
+
+	userID, ok := claims["sub"].(string)
+	if !ok {
+		return utils.NewUnauthorizedError("invalid user id")
+	}
+
+	// Access token tetap valid sampai expired, jadi simpan jti-nya sebagai blacklist
+	if jti, ok := claims["jti"].(string); ok {
+		exp, err := claims.GetExpirationTime()
+		if err == nil && exp != nil && time.Until(exp.Time) > 0 {
+			err = u.cache.Set(ctx, token.RevokedTokenKey(jti), []byte("1"), time.Until(exp.Time))
+			if err != nil {
+				return utils.NewInternalError("Failed to revoke token")
+			}
+		}
+	}
+
+	if req.RefreshToken != "" {
+		data, err := u.cache.Get(ctx, token.RefreshTokenKey(req.RefreshToken))
+		if err != nil {
+			return utils.NewInternalError("Failed to get refresh token")
+		}
+		if data != nil {
+			var session refreshSession
+			if err := json.Unmarshal(data, &session); err != nil {
+				return utils.NewInternalError("invalid refresh token data")
+			}
+			if session.UserID.String() != userID {
+				return utils.NewForbiddenError("refresh token does not belong to user")
+			}
+			if err := u.cache.Delete(ctx, token.RefreshFamilyKey(session.Family)); err != nil {
+				return utils.NewInternalError("Failed to revoke refresh token")
+			}
+			if err := u.cache.Delete(ctx, token.RefreshTokenKey(req.RefreshToken)); err != nil {
+				return utils.NewInternalError("Failed to revoke refresh token")
+			}
+		}
+	}
+
+	if req.All {
+		if err := u.revokeUserSessions(ctx, userID); err != nil {
+			return utils.NewInternalError("Failed to revoke sessions")
+		}
+	}
+
+	return nil
+}
+
+func (u *AuthUsecaseImpl) issueTokens(ctx context.Context, session *refreshSession) (string, string, error) {
+	accessToken, err := u.token.GenerateToken(session.UserID, session.Role)
+	if err != nil {
+		return "", "", err
+	}
+	refreshToken, err := u.token.GenerateRefreshToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return "", "", err
+	}
+	err = u.cache.Set(ctx, token.RefreshTokenKey(refreshToken), data, token.RefreshTokenTTL)
+	if err != nil {
+		return "", "", err
+	}
+	err = u.cache.Set(ctx, token.RefreshFamilyKey(session.Family), []byte(session.UserID.String()), token.RefreshTokenTTL)
+	if err != nil {
+		return "", "", err
+	}
+	return accessToken, refreshToken, nil
+}
+
+// revokeUserSessions invalidates every access and refresh token issued to the user until now
+func (u *AuthUsecaseImpl) revokeUserSessions(ctx context.Context, userID string) error {
+	return token.RevokeUser(ctx, u.cache, userID)
 }
 
 func (u *AuthUsecaseImpl) SendOTP(ctx context.Context, req *dto.AuthSendDTO) error {
@@ -54,9 +275,10 @@ func (u *AuthUsecaseImpl) SendOTP(ctx context.Context, req *dto.AuthSendDTO) err
 		return utils.NewValidationError(err)
 	}
 
+	// Email yang tidak terdaftar tetap dianggap sukses supaya tidak bisa ditebak
 	_, err := u.userRepo.FindByEmail(ctx, req.Email)
 	if err != nil {
-		return utils.NewBadRequestError("user not found")
+		return nil
 	}
 
 	// Generate OTP
@@ -93,18 +315,19 @@ func (u *AuthUsecaseImpl) VerifyOTP(ctx context.Context, req *dto.AuthVerifyDTO)
 	if err := utils.ValidateStruct(req); len(err) > 0 {
 		return utils.NewValidationError(err)
 	}
+	if err := u.checkLockout(ctx, req.Email, req.IP); err != nil {
+		return err
+	}
+
 	// Ambil OTP dari Redis
 	storedOTP, err := u.cache.Get(ctx, fmt.Sprintf("otp:%s", req.Email))
 	if err != nil {
 		return utils.NewInternalError("Failed to get OTP")
 	}
-	if storedOTP == nil {
-		return utils.NewBadRequestError("OTP expired or not found")
-	}
 
 	// Verifikasi OTP
-	if string(storedOTP) != req.OTP {
-		return utils.NewBadRequestError("Invalid OTP")
+	if storedOTP == nil || string(storedOTP) != req.OTP {
+		return u.recordFailure(ctx, req.Email, req.IP)
 	}
 
 	user, err := u.userRepo.FindByEmail(ctx, req.Email)
@@ -125,5 +348,418 @@ func (u *AuthUsecaseImpl) VerifyOTP(ctx context.Context, req *dto.AuthVerifyDTO)
 		return utils.NewInternalError("Failed to delete OTP")
 	}
 
+	if err := u.resetAttempts(ctx, req.Email, ""); err != nil {
+		return utils.NewInternalError("Failed to reset login attempts")
+	}
+
+	return nil
+}
+
+func (u *AuthUsecaseImpl) UnlockAccount(ctx context.Context, req *dto.AuthUnlockDTO) error {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return utils.NewValidationError(err)
+	}
+
+	if err := u.resetAttempts(ctx, req.Email, req.IP); err != nil {
+		return utils.NewInternalError("Failed to unlock account")
+	}
+
+	return nil
+}
+
+func (u *AuthUsecaseImpl) ForgotPassword(ctx context.Context, req *dto.AuthForgotPasswordDTO) error {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return utils.NewValidationError(err)
+	}
+
+	// Email yang tidak terdaftar tetap dianggap sukses supaya tidak bisa ditebak
+	_, err := u.userRepo.FindByEmail(ctx, req.Email)
+	if err != nil {
+		return nil
+	}
+
+	otp, err := u.OTP.GenerateOTP(6)
+	if err != nil {
+		return utils.NewInternalError("Failed to generate OTP")
+	}
+
+	// Request baru menimpa token reset sebelumnya
+	err = u.cache.Set(ctx, passwordResetKey(req.Email), []byte(otp), passwordResetTTL)
+	if err != nil {
+		return utils.NewInternalError("Failed to store reset token")
+	}
+
+	msg := struct {
+		To  string `json:"to"`
+		OTP string `json:"otp"`
+	}{
+		To:  req.Email,
+		OTP: otp,
+	}
+
+	err = u.rabbitMQ.PublishJSON(ctx, "mail-exchange", "reset-password", msg)
+	if err != nil {
+		return utils.NewInternalError(err.Error())
+	}
+
+	return nil
+}
+
+func (u *AuthUsecaseImpl) ResetPassword(ctx context.Context, req *dto.AuthResetPasswordDTO) error {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return utils.NewValidationError(err)
+	}
+
+	if err := u.checkLockout(ctx, req.Email, req.IP); err != nil {
+		return err
+	}
+
+	storedOTP, err := u.cache.Get(ctx, passwordResetKey(req.Email))
+	if err != nil {
+		return utils.NewInternalError("Failed to get reset token")
+	}
+	if storedOTP == nil || string(storedOTP) != req.OTP {
+		return u.recordFailure(ctx, req.Email, req.IP)
+	}
+
+	// Token hanya bisa dipakai sekali, hapus sebelum password diganti
+	err = u.cache.Delete(ctx, passwordResetKey(req.Email))
+	if err != nil {
+		return utils.NewInternalError("Failed to delete reset token")
+	}
+
+	user, err := u.userRepo.FindByEmail(ctx, req.Email)
+	if err != nil {
+		return utils.NewAuthFailedError()
+	}
+
+	hashed, err := u.hash.HashPassword(req.Password)
+	if err != nil {
+		return utils.NewInternalError("Failed to hash password")
+	}
+
+	err = u.userRepo.Update(ctx, user.ID, &domain.User{Password: hashed})
+	if err != nil {
+		return utils.NewInternalError("Failed to update user")
+	}
+
+	if err := u.resetAttempts(ctx, req.Email, ""); err != nil {
+		return utils.NewInternalError("Failed to reset login attempts")
+	}
+
+	if err := u.revokeUserSessions(ctx, user.ID.String()); err != nil {
+		return utils.NewInternalError("Failed to revoke sessions")
+	}
+
+	return nil
+}
+
+func (u *AuthUsecaseImpl) VerifyMFA(ctx context.Context, req *dto.AuthMFAVerifyDTO) (*dto.AuthResponseDTO, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+
+	email, err := u.cache.Get(ctx, mfaChallengeKey(req.MFAToken))
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get mfa challenge")
+	}
+	if email == nil {
+		return nil, utils.NewAuthFailedError()
+	}
+
+	if err := u.checkLockout(ctx, string(email), req.IP); err != nil {
+		return nil, err
+	}
+
+	user, err := u.userRepo.FindByEmail(ctx, string(email))
+	if err != nil || !user.TOTPEnabled || user.TOTPSecret == nil {
+		return nil, utils.NewAuthFailedError()
+	}
+
+	if req.Code != "" {
+		if !u.totp.Validate(*user.TOTPSecret, req.Code) {
+			return nil, u.recordFailure(ctx, user.Email, req.IP)
+		}
+		// Kode yang sama tidak boleh dipakai dua kali dalam window yang sama
+		used, err := u.cache.Get(ctx, totpUsedKey(user.ID.String(), req.Code))
+		if err != nil {
+			return nil, utils.NewInternalError("Failed to check totp code")
+		}
+		if used != nil {
+			return nil, u.recordFailure(ctx, user.Email, req.IP)
+		}
+		err = u.cache.Set(ctx, totpUsedKey(user.ID.String(), req.Code), []byte("1"), 90*time.Second)
+		if err != nil {
+			return nil, utils.NewInternalError("Failed to store totp code")
+		}
+	} else {
+		remaining, ok := consumeRecoveryCode(user.RecoveryCodes, req.RecoveryCode)
+		if !ok {
+			return nil, u.recordFailure(ctx, user.Email, req.IP)
+		}
+		user.RecoveryCodes = &remaining
+		if err := u.userRepo.UpdateTwoFactor(ctx, user.ID, user); err != nil {
+			return nil, utils.NewInternalError("Failed to update recovery codes")
+		}
+	}
+
+	if err := u.cache.Delete(ctx, mfaChallengeKey(req.MFAToken)); err != nil {
+		return nil, utils.NewInternalError("Failed to delete mfa challenge")
+	}
+	if err := u.resetAttempts(ctx, user.Email, ""); err != nil {
+		return nil, utils.NewInternalError("Failed to reset login attempts")
+	}
+
+	session := &refreshSession{
+		UserID:   user.ID,
+		Role:     user.Role.Name,
+		Family:   uuid.New().String(),
+		IssuedAt: time.Now().UnixMilli(),
+	}
+	accessToken, refreshToken, err := u.issueTokens(ctx, session)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return utils.AuthDtoFormat(user, accessToken, refreshToken), nil
+}
+
+func (u *AuthUsecaseImpl) SetupTOTP(ctx context.Context, userID uuid.UUID) (*dto.AuthTOTPSetupResponseDTO, error) {
+	user, err := u.userRepo.FindAuthByID(ctx, userID)
+	if err != nil {
+		return nil, utils.NewNotFoundError("user not found")
+	}
+	if user.TOTPEnabled {
+		return nil, utils.NewConflictError("two-factor authentication already enabled")
+	}
+
+	secret, err := u.totp.GenerateSecret()
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to generate totp secret")
+	}
+
+	// Secret baru disimpan ke database setelah user membuktikan bisa generate kode
+	err = u.cache.Set(ctx, totpSetupKey(userID.String()), []byte(secret), totpSetupTTL)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to store totp secret")
+	}
+
+	return &dto.AuthTOTPSetupResponseDTO{
+		Secret: secret,
+		URI:    u.totp.ProvisioningURI(secret, totpIssuer, user.Email),
+	}, nil
+}
+
+func (u *AuthUsecaseImpl) EnableTOTP(ctx context.Context, userID uuid.UUID, req *dto.AuthTOTPCodeDTO) (*dto.AuthRecoveryCodesResponseDTO, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+
+	secret, err := u.cache.Get(ctx, totpSetupKey(userID.String()))
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to get totp secret")
+	}
+	if secret == nil {
+		return nil, utils.NewBadRequestError("two-factor setup expired or not found")
+	}
+	if !u.totp.Validate(string(secret), req.Code) {
+		return nil, utils.NewBadRequestError("invalid code")
+	}
+
+	codes, err := u.totp.GenerateRecoveryCodes(recoveryCodeSize)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to generate recovery codes")
+	}
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		hashes[i] = utils.HashRecoveryCode(code)
+	}
+
+	secretValue := string(secret)
+	recoveryCodes := strings.Join(hashes, ",")
+	err = u.userRepo.UpdateTwoFactor(ctx, userID, &domain.User{
+		TOTPSecret:    &secretValue,
+		TOTPEnabled:   true,
+		RecoveryCodes: &recoveryCodes,
+	})
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to enable two-factor authentication")
+	}
+
+	if err := u.cache.Delete(ctx, totpSetupKey(userID.String())); err != nil {
+		return nil, utils.NewInternalError("Failed to delete totp secret")
+	}
+
+	// Enrollment session must login again to get a session with the second factor
+	if err := u.revokeUserSessions(ctx, userID.String()); err != nil {
+		return nil, utils.NewInternalError("Failed to revoke sessions")
+	}
+
+	return &dto.AuthRecoveryCodesResponseDTO{RecoveryCodes: codes}, nil
+}
+
+func (u *AuthUsecaseImpl) DisableTOTP(ctx context.Context, userID uuid.UUID, role string, req *dto.AuthTOTPCodeDTO) error {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return utils.NewValidationError(err)
+	}
+
+	if mfaRequiredRoles[role] {
+		return utils.NewForbiddenError("two-factor authentication is mandatory for this role")
+	}
+
+	user, err := u.userRepo.FindAuthByID(ctx, userID)
+	if err != nil {
+		return utils.NewNotFoundError("user not found")
+	}
+	if !user.TOTPEnabled || user.TOTPSecret == nil {
+		return utils.NewBadRequestError("two-factor authentication is not enabled")
+	}
+	if !u.totp.Validate(*user.TOTPSecret, req.Code) {
+		return utils.NewBadRequestError("invalid code")
+	}
+
+	err = u.userRepo.UpdateTwoFactor(ctx, userID, &domain.User{})
+	if err != nil {
+		return utils.NewInternalError("Failed to disable two-factor authentication")
+	}
+
+	return nil
+}
+
+func (u *AuthUsecaseImpl) startMFAChallenge(ctx context.Context, user *domain.User) (*dto.AuthResponseDTO, error) {
+	challenge, err := u.token.GenerateRefreshToken()
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	err = u.cache.Set(ctx, mfaChallengeKey(challenge), []byte(user.Email), mfaChallengeTTL)
+	if err != nil {
+		return nil, utils.NewInternalError("Failed to store mfa challenge")
+	}
+	return &dto.AuthResponseDTO{
+		MFARequired: true,
+		MFAToken:    challenge,
+	}, nil
+}
+
+// consumeRecoveryCode removes the matching hash so every recovery code works only once
+func consumeRecoveryCode(stored *string, code string) (string, bool) {
+	if stored == nil || *stored == "" || code == "" {
+		return "", false
+	}
+	hash := utils.HashRecoveryCode(code)
+	hashes := strings.Split(*stored, ",")
+	for i, h := range hashes {
+		if h == hash {
+			remaining := append(hashes[:i:i], hashes[i+1:]...)
+			return strings.Join(remaining, ","), true
+		}
+	}
+	return "", false
+}
+
+func mfaChallengeKey(challenge string) string {
+	return fmt.Sprintf("mfa_challenge:%s", challenge)
+}
+
+func totpSetupKey(userID string) string {
+	return fmt.Sprintf("totp_setup:%s", userID)
+}
+
+func totpUsedKey(userID, code string) string {
+	return fmt.Sprintf("totp_used:%s:%s", userID, code)
+}
+
+func passwordResetKey(email string) string {
+	return fmt.Sprintf("password_reset:%s", email)
+}
+
+func loginAttemptKey(scope, value string) string {
+	return fmt.Sprintf("login_attempts:%s:%s", scope, value)
+}
+
+func loginLockKey(scope, value string) string {
+	return fmt.Sprintf("login_lock:%s:%s", scope, value)
+}
+
+type attemptScope struct {
+	name  string
+	value string
+	limit int64
+}
+
+func attemptScopes(email, ip string) []attemptScope {
+	scopes := []attemptScope{{"email", email, maxEmailAttempts}}
+	if ip != "" {
+		scopes = append(scopes, attemptScope{"ip", ip, maxIPAttempts})
+	}
+	return scopes
+}
+
+// lockoutDuration doubles for every failure past the limit, capped at maxLockout
+func lockoutDuration(over int64) time.Duration {
+	if over >= 6 {
+		return maxLockout
+	}
+	d := baseLockout << over
+	if d > maxLockout {
+		return maxLockout
+	}
+	return d
+}
+
+func (u *AuthUsecaseImpl) checkLockout(ctx context.Context, email, ip string) error {
+	for _, scope := range attemptScopes(email, ip) {
+		lockedUntil, err := u.cache.Get(ctx, loginLockKey(scope.name, scope.value))
+		if err != nil {
+			return utils.NewInternalError("Failed to check login attempts")
+		}
+		if lockedUntil == nil {
+			continue
+		}
+		until, err := strconv.ParseInt(string(lockedUntil), 10, 64)
+		if err != nil {
+			return utils.NewInternalError("Failed to check login attempts")
+		}
+		retry := until - time.Now().Unix()
+		if retry < 1 {
+			retry = 1
+		}
+		return utils.NewTooManyRequestsError(fmt.Sprintf("too many failed attempts, try again in %d seconds", retry))
+	}
+	return nil
+}
+
+// recordFailure counts a failed attempt and always returns the same auth error
+func (u *AuthUsecaseImpl) recordFailure(ctx context.Context, email, ip string) error {
+	for _, scope := range attemptScopes(email, ip) {
+		count, err := u.cache.Incr(ctx, loginAttemptKey(scope.name, scope.value), attemptWindow)
+		if err != nil {
+			return utils.NewInternalError("Failed to record login attempt")
+		}
+		if count < scope.limit {
+			continue
+		}
+		lockout := lockoutDuration(count - scope.limit)
+		until := strconv.FormatInt(time.Now().Add(lockout).Unix(), 10)
+		err = u.cache.Set(ctx, loginLockKey(scope.name, scope.value), []byte(until), lockout)
+		if err != nil {
+			return utils.NewInternalError("Failed to record login attempt")
+		}
+		logrus.Log.Warnf("login locked for %s %s for %s", scope.name, scope.value, lockout)
+	}
+	return utils.NewAuthFailedError()
+}
+
+// resetAttempts clears the counters and locks of the email and, when given, the ip.
+// A successful sign in passes no ip, one valid account must not lift the throttling of the ip it shares with
+// failed attempts on other accounts, that counter only goes when it expires or an admin unlocks it
+func (u *AuthUsecaseImpl) resetAttempts(ctx context.Context, email, ip string) error {
+	for _, scope := range attemptScopes(email, ip) {
+		if err := u.cache.Delete(ctx, loginAttemptKey(scope.name, scope.value)); err != nil {
+			return err
+		}
+		if err := u.cache.Delete(ctx, loginLockKey(scope.name, scope.value)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -0,0 +1,322 @@
+package usecase_implementation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+type ConversionUsecaseImpl struct {
+	unitRepo     repository_interface.UnitRepository
+	currencyRepo repository_interface.CurrencyRepository
+}
+
+func NewConversionUsecase(unitRepo repository_interface.UnitRepository, currencyRepo repository_interface.CurrencyRepository) usecase_interface.ConversionUsecase {
+	return &ConversionUsecaseImpl{unitRepo, currencyRepo}
+}
+
+// ConvertPrices quotes the prices per the requested unit at today's rate, the unit of a converted price
+// becomes "<currency>/<unit>" since a stored price holds its currency in Unit and is always per kg
+func (uc *ConversionUsecaseImpl) ConvertPrices(ctx context.Context, params *dto.ConversionParamsDTO, prices ...*domain.Price) error {
+	c, err := uc.newConverter(ctx, params)
+	if err != nil || c == nil {
+		return err
+	}
+	now := time.Now()
+	for _, price := range prices {
+		if price.Price, price.Unit, err = c.price(ctx, price.Price, price.Unit, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ConvertPriceHistories converts every entry at the rate of the day the price was set
+func (uc *ConversionUsecaseImpl) ConvertPriceHistories(ctx context.Context, params *dto.ConversionParamsDTO, histories ...*domain.PriceHistory) error {
+	c, err := uc.newConverter(ctx, params)
+	if err != nil || c == nil {
+		return err
+	}
+	for _, history := range histories {
+		if history.Price, history.Unit, err = c.price(ctx, history.Price, history.Unit, history.UpdatedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ConvertSales converts the quantity to the unit and the amount, recorded in the base currency, at the rate of the sale date.
+// Price is the total of the sale, so a change of unit leaves it as it is
+func (uc *ConversionUsecaseImpl) ConvertSales(ctx context.Context, params *dto.ConversionParamsDTO, sales ...*domain.Sale) error {
+	c, err := uc.newConverter(ctx, params)
+	if err != nil || c == nil {
+		return err
+	}
+	for _, sale := range sales {
+		if sale.Quantity, sale.Unit, err = c.quantity(sale.Quantity, sale.Unit); err != nil {
+			return err
+		}
+		if sale.Price, _, err = c.amount(ctx, sale.Price, domain.BaseCurrency, sale.SaleDate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *ConversionUsecaseImpl) ConvertSupplies(ctx context.Context, params *dto.ConversionParamsDTO, supplies ...*domain.Supply) error {
+	c, err := uc.newQuantityConverter(ctx, params)
+	if err != nil || c == nil {
+		return err
+	}
+	for _, supply := range supplies {
+		if supply.Quantity, supply.Unit, err = c.quantity(supply.Quantity, supply.Unit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *ConversionUsecaseImpl) ConvertSupplyHistories(ctx context.Context, params *dto.ConversionParamsDTO, histories ...*domain.SupplyHistory) error {
+	c, err := uc.newQuantityConverter(ctx, params)
+	if err != nil || c == nil {
+		return err
+	}
+	for _, history := range histories {
+		if history.Quantity, history.Unit, err = c.quantity(history.Quantity, history.Unit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *ConversionUsecaseImpl) ConvertDemands(ctx context.Context, params *dto.ConversionParamsDTO, demands ...*domain.Demand) error {
+	c, err := uc.newQuantityConverter(ctx, params)
+	if err != nil || c == nil {
+		return err
+	}
+	for _, demand := range demands {
+		if demand.Quantity, demand.Unit, err = c.quantity(demand.Quantity, demand.Unit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *ConversionUsecaseImpl) ConvertDemandHistories(ctx context.Context, params *dto.ConversionParamsDTO, histories ...*domain.DemandHistory) error {
+	c, err := uc.newQuantityConverter(ctx, params)
+	if err != nil || c == nil {
+		return err
+	}
+	for _, history := range histories {
+		if history.Quantity, history.Unit, err = c.quantity(history.Quantity, history.Unit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// converter holds what one request needs, units and rates are loaded once instead of once per value
+type converter struct {
+	currencyRepo repository_interface.CurrencyRepository
+	units        map[string]*domain.Unit
+	unit         *domain.Unit
+	currency     string
+	// rates of every currency met so far, newest first
+	rates map[string][]*domain.ExchangeRate
+}
+
+// newConverter returns nil when params asks for no conversion
+func (uc *ConversionUsecaseImpl) newConverter(ctx context.Context, params *dto.ConversionParamsDTO) (*converter, error) {
+	unit := normalizeUnitCode(params.Unit)
+	currency := strings.ToLower(strings.TrimSpace(params.Currency))
+	if unit == "" && currency == "" {
+		return nil, nil
+	}
+
+	units, err := uc.unitRepo.FindAll(ctx)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	c := &converter{
+		currencyRepo: uc.currencyRepo,
+		units:        make(map[string]*domain.Unit, len(units)),
+		currency:     currency,
+		rates:        map[string][]*domain.ExchangeRate{},
+	}
+	for _, u := range units {
+		c.units[u.Code] = u
+	}
+
+	if unit != "" {
+		if c.unit = c.units[unit]; c.unit == nil {
+			return nil, utils.NewBadRequestError(fmt.Sprintf("unit %s is not registered", unit))
+		}
+	}
+	if currency != "" && currency != domain.BaseCurrency {
+		if _, err := uc.currencyRepo.FindByCode(ctx, currency); err != nil {
+			return nil, utils.NewBadRequestError(fmt.Sprintf("currency %s is not registered", currency))
+		}
+	}
+	return c, nil
+}
+
+func (uc *ConversionUsecaseImpl) newQuantityConverter(ctx context.Context, params *dto.ConversionParamsDTO) (*converter, error) {
+	if params.Currency != "" {
+		return nil, utils.NewBadRequestError("currency only applies to prices and sales")
+	}
+	return uc.newConverter(ctx, params)
+}
+
+// quantity converts value from the unit it was recorded in to the requested unit
+func (c *converter) quantity(value float64, from string) (float64, string, error) {
+	if c.unit == nil {
+		return value, from, nil
+	}
+	source, err := c.source(from)
+	if err != nil {
+		return 0, "", err
+	}
+	return value * source.Factor / c.unit.Factor, c.unit.Code, nil
+}
+
+// price converts a price per base unit, the inverse of a quantity since a price per ton is 1000 times a price per kg
+func (c *converter) price(ctx context.Context, value float64, currency string, at time.Time) (float64, string, error) {
+	unit := domain.BaseUnit
+	if c.unit != nil {
+		source, err := c.source(domain.BaseUnit)
+		if err != nil {
+			return 0, "", err
+		}
+		value = value * c.unit.Factor / source.Factor
+		unit = c.unit.Code
+	}
+	value, currency, err := c.amount(ctx, value, currency, at)
+	if err != nil {
+		return 0, "", err
+	}
+	return value, currency + "/" + unit, nil
+}
+
+// amount converts money recorded in currency through the base currency at the rates in effect on at
+func (c *converter) amount(ctx context.Context, value float64, currency string, at time.Time) (float64, string, error) {
+	currency = strings.ToLower(currency)
+	if currency == "" {
+		currency = domain.BaseCurrency
+	}
+	if c.currency == "" || c.currency == currency {
+		return value, currency, nil
+	}
+
+	from, err := c.rate(ctx, currency, at)
+	if err != nil {
+		return 0, "", err
+	}
+	to, err := c.rate(ctx, c.currency, at)
+	if err != nil {
+		return 0, "", err
+	}
+	return value * from / to, c.currency, nil
+}
+
+func (c *converter) rate(ctx context.Context, currency string, at time.Time) (float64, error) {
+	if currency == domain.BaseCurrency {
+		return 1, nil
+	}
+	rates, ok := c.rates[currency]
+	if !ok {
+		var err error
+		if rates, err = c.currencyRepo.FindRatesByCurrencyCode(ctx, currency); err != nil {
+			return 0, utils.NewInternalError(err.Error())
+		}
+		c.rates[currency] = rates
+	}
+
+	day := at.Format("2006-01-02")
+	for _, rate := range rates {
+		if rate.EffectiveDate.Format("2006-01-02") <= day {
+			return rate.Rate, nil
+		}
+	}
+	return 0, utils.NewBadRequestError(fmt.Sprintf("no %s exchange rate on or before %s", currency, day))
+}
+
+func (c *converter) source(code string) (*domain.Unit, error) {
+	code = normalizeUnitCode(code)
+	if code == "" {
+		code = domain.BaseUnit
+	}
+	source := c.units[code]
+	if source == nil {
+		return nil, utils.NewBadRequestError(fmt.Sprintf("unit %s is not registered", code))
+	}
+	if source.Dimension != c.unit.Dimension {
+		return nil, utils.NewBadRequestError(fmt.Sprintf("cannot convert %s to %s", source.Code, c.unit.Code))
+	}
+	return source, nil
+}
+
+func normalizeUnitCode(code string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(code)), "²", "2")
+}
+
+// massUnitFactors maps every registered mass unit to its size in kg
+func massUnitFactors(units []*domain.Unit) map[string]float64 {
+	factors := make(map[string]float64, len(units))
+	for _, unit := range units {
+		if unit.Dimension == domain.UnitDimensionMass {
+			factors[unit.Code] = unit.Factor
+		}
+	}
+	return factors
+}
+
+// kilograms converts a quantity recorded in unit to kg, an empty unit is kg.
+// It reports false for a unit that is unknown or not a mass
+func kilograms(factors map[string]float64, quantity float64, unit string) (float64, bool) {
+	code := normalizeUnitCode(unit)
+	if code == "" {
+		code = domain.BaseUnit
+	}
+	factor, ok := factors[code]
+	if !ok {
+		return 0, false
+	}
+	return quantity * factor, true
+}
+
+// areaUnitFactors maps every registered area unit to its size in m2
+func areaUnitFactors(units []*domain.Unit) map[string]float64 {
+	factors := make(map[string]float64, len(units))
+	for _, unit := range units {
+		if unit.Dimension == domain.UnitDimensionArea {
+			factors[unit.Code] = unit.Factor
+		}
+	}
+	return factors
+}
+
+// hectares converts an area recorded in unit to ha, an empty unit is ha as lands default to it.
+// It reports false for a unit that is unknown or not an area
+func hectares(factors map[string]float64, area float64, unit string) (float64, bool) {
+	code := normalizeUnitCode(unit)
+	if code == "" {
+		return area, true
+	}
+	factor, ok := factors[code]
+	if !ok {
+		return 0, false
+	}
+	return area * factor / 10000, true
+}
+
+// isBaseUnit reports whether code is the unit a dimension is measured in
+func isBaseUnit(code string) bool {
+	return code == domain.BaseUnit || code == domain.BaseAreaUnit
+}
@@ -0,0 +1,90 @@
+package usecase_implementation
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+type CurrencyUsecaseImpl struct {
+	currencyRepo repository_interface.CurrencyRepository
+}
+
+func NewCurrencyUsecase(currencyRepo repository_interface.CurrencyRepository) usecase_interface.CurrencyUsecase {
+	return &CurrencyUsecaseImpl{currencyRepo}
+}
+
+func (uc *CurrencyUsecaseImpl) GetCurrencies(ctx context.Context) ([]*domain.Currency, error) {
+	currencies, err := uc.currencyRepo.FindAll(ctx)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return currencies, nil
+}
+
+func (uc *CurrencyUsecaseImpl) CreateCurrency(ctx context.Context, req *dto.CurrencyCreateDTO) (*domain.Currency, error) {
+	req.Code = strings.ToLower(strings.TrimSpace(req.Code))
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+
+	if _, err := uc.currencyRepo.FindByCode(ctx, req.Code); err == nil {
+		return nil, utils.NewConflictError("currency already exists")
+	}
+
+	currency := &domain.Currency{Code: req.Code, Name: req.Name}
+	if err := uc.currencyRepo.Create(ctx, currency); err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return currency, nil
+}
+
+func (uc *CurrencyUsecaseImpl) GetExchangeRates(ctx context.Context, code string) ([]*domain.ExchangeRate, error) {
+	code = strings.ToLower(code)
+	if _, err := uc.currencyRepo.FindByCode(ctx, code); err != nil {
+		return nil, utils.NewNotFoundError("currency not found")
+	}
+
+	rates, err := uc.currencyRepo.FindRatesByCurrencyCode(ctx, code)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return rates, nil
+}
+
+// CreateExchangeRate records the rate from its effective date on, a rate on the same date is replaced
+func (uc *CurrencyUsecaseImpl) CreateExchangeRate(ctx context.Context, code string, req *dto.ExchangeRateCreateDTO) (*domain.ExchangeRate, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+	effectiveDate, err := time.Parse("2006-01-02", req.EffectiveDate)
+	if err != nil {
+		return nil, utils.NewBadRequestError("effective_date format is invalid")
+	}
+
+	code = strings.ToLower(code)
+	if code == domain.BaseCurrency {
+		return nil, utils.NewBadRequestError("the base currency has no exchange rate")
+	}
+	if _, err := uc.currencyRepo.FindByCode(ctx, code); err != nil {
+		return nil, utils.NewNotFoundError("currency not found")
+	}
+
+	rate := &domain.ExchangeRate{
+		ID:            uuid.New(),
+		CurrencyCode:  code,
+		Rate:          req.Rate,
+		EffectiveDate: effectiveDate,
+	}
+	if err := uc.currencyRepo.UpsertRate(ctx, rate); err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return rate, nil
+}
@@ -2,21 +2,48 @@ package usecase_implementation
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
 	"github.com/ryvasa/go-super-farmer/internal/model/dto"
 	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
 	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/pkg/env"
 	"github.com/ryvasa/go-super-farmer/pkg/logrus"
 	"github.com/ryvasa/go-super-farmer/pkg/messages"
 	"github.com/ryvasa/go-super-farmer/utils"
 )
 
+// used when FORECAST_TIMEOUT is not set and the caller has no earlier deadline
+const defaultForecastTimeout = 10 * time.Second
+
+const (
+	// weeks of prices the baseline learns from, two seasons so last year's harvest week is covered
+	baselineHistoryWeeks = 104
+	baselineSeasonWeeks  = 52
+	// smoothing of Holt's damped trend, the trend fades so a short run of rises is not extrapolated for months
+	holtAlpha = 0.5
+	holtBeta  = 0.1
+	holtPhi   = 0.9
+	// the sales term needs this many weeks with sales and a known price change, takes the current sales level
+	// from the last weeks and moves the price by at most this log change
+	salesMinWeeks    = 8
+	salesRecentWeeks = 4
+	salesEffectLimit = 0.25
+	// weeks ahead a backtest forecasts by default, and the most weeks it replays
+	defaultBacktestHorizonWeeks = 12
+	maxBacktestWeeks            = 156
+	// forecasts compared with their actual price in one query
+	forecastEvaluationBatch = 100
+)
+
 func dayOfYear() int {
 	now := time.Now()
 	return now.YearDay()
@@ -36,6 +63,7 @@ type FrecastsMessageReq struct {
 type FrecastsMessageRes struct {
 	OriginalData   FrecastsMessageReq `json:"original_data"`
 	PredictedPrice float64            `json:"predicted_price"`
+	ModelVersion   string             `json:"model_version"`
 }
 
 type ForecastsUsecaseImpl struct {
@@ -50,7 +78,12 @@ type ForecastsUsecaseImpl struct {
 	saleRepo          repository_interface.SaleRepository
 	harvestRepo       repository_interface.HarvestRepository
 	commodityRepo     repository_interface.CommodityRepository
+	provinceRepo      repository_interface.ProvinceRepository
+	unitRepo          repository_interface.UnitRepository
+	forecastRepo      repository_interface.ForecastRepository
 	rabbitMQ          messages.RabbitMQ
+	timeout           time.Duration
+	engine            string
 }
 
 func NewForecastsUsecase(
@@ -65,8 +98,29 @@ func NewForecastsUsecase(
 	saleRepo repository_interface.SaleRepository,
 	harvestRepo repository_interface.HarvestRepository,
 	commodityRepo repository_interface.CommodityRepository,
+	provinceRepo repository_interface.ProvinceRepository,
+	unitRepo repository_interface.UnitRepository,
+	forecastRepo repository_interface.ForecastRepository,
 	rabbitMQ messages.RabbitMQ,
+	env *env.Env,
 ) usecase_interface.ForecastsUsecase {
+	timeout := defaultForecastTimeout
+	if env.Forecast.Timeout != "" {
+		parsed, err := time.ParseDuration(env.Forecast.Timeout)
+		if err != nil || parsed <= 0 {
+			logrus.Log.Warnf("invalid FORECAST_TIMEOUT %q, using %s", env.Forecast.Timeout, timeout)
+		} else {
+			timeout = parsed
+		}
+	}
+	engine := domain.ForecastEngineService
+	switch env.Forecast.Engine {
+	case "", domain.ForecastEngineService:
+	case domain.ForecastEngineBaseline:
+		engine = domain.ForecastEngineBaseline
+	default:
+		logrus.Log.Warnf("invalid FORECAST_ENGINE %q, using %s", env.Forecast.Engine, engine)
+	}
 	return &ForecastsUsecaseImpl{
 		landCommodityRepo: landCommodityRepo,
 		cityRepo:          cityRepo,
@@ -79,11 +133,44 @@ func NewForecastsUsecase(
 		saleRepo:          saleRepo,
 		harvestRepo:       harvestRepo,
 		commodityRepo:     commodityRepo,
+		provinceRepo:      provinceRepo,
+		unitRepo:          unitRepo,
+		forecastRepo:      forecastRepo,
 		rabbitMQ:          rabbitMQ,
+		timeout:           timeout,
+		engine:            engine,
 	}
 }
 
 func (f *ForecastsUsecaseImpl) GetForecastsByCommodityIDAndCityID(ctx context.Context, landCommodityID uuid.UUID, cityID int64) (*dto.ForecastsResponseDTO, error) {
+	forecast, err := f.prepareForecast(ctx, landCommodityID, cityID)
+	if err != nil {
+		return nil, err
+	}
+
+	predictErr := f.predict(ctx, forecast)
+	// A failed forecast is kept as well, the history shows how often the prediction service was missing
+	if err := f.forecastRepo.Create(ctx, forecast); err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	if predictErr != nil {
+		return nil, predictErr
+	}
+
+	var forecastsResponse dto.ForecastsResponseDTO
+	forecastsResponse.ForecastID = forecast.ID
+	forecastsResponse.HarvestPrice = *forecast.PredictedPrice
+	forecastsResponse.HarvestDate = forecast.HarvestDate
+	forecastsResponse.City = forecast.City
+	forecastsResponse.Commodity = forecast.Commodity
+	forecastsResponse.CurrentPrice = forecast.CurrentPrice
+	forecastsResponse.ModelVersion = forecast.ModelVersion
+	forecastsResponse.Engine = forecast.Engine
+	return &forecastsResponse, nil
+}
+
+// prepareForecast gathers the inputs of a forecast from the database, nothing slow happens here
+func (f *ForecastsUsecaseImpl) prepareForecast(ctx context.Context, landCommodityID uuid.UUID, cityID int64) (*domain.Forecast, error) {
 
 	landCommodity, err := f.landCommodityRepo.FindByID(ctx, landCommodityID)
 	if err != nil {
@@ -140,101 +227,754 @@ func (f *ForecastsUsecaseImpl) GetForecastsByCommodityIDAndCityID(ctx context.Co
 		totalHarvest += h.Quantity
 	}
 
-	harvestYield := totalHarvest / float64(len(harvests))
+	// A land commodity without a harvest yet has no yield rather than a NaN the forecast cannot be saved with
+	var harvestYield float64
+	if len(harvests) > 0 {
+		harvestYield = totalHarvest / float64(len(harvests))
+	}
 	logrus.Log.Info("harvestYield", harvestYield)
 
+	duration, err := commodityDuration(commodity)
+	if err != nil {
+		return nil, err
+	}
+
+	harvestTime := landCommodity.CreatedAt.Add(duration)
+	now := time.Now()
+	daysUntilHarvest := int(harvestTime.Sub(now).Hours() / 24)
+
+	logrus.Log.Info("forecasts message sent", "harvestTime")
+
+	var sale float64
+
+	for _, s := range sales {
+		sale += s.Quantity
+	}
+
+	if len(sales) > 0 {
+		sale = sale / float64(len(sales))
+	}
+	logrus.Log.Info("forecasts message sent", "saleQuantity")
+
+	return &domain.Forecast{
+		ID:               uuid.New(),
+		LandCommodityID:  landCommodityID,
+		CommodityID:      commodityID,
+		Commodity:        commodity,
+		CityID:           cityID,
+		City:             city,
+		Status:           domain.ForecastPending,
+		LandArea:         landCommodity.LandArea,
+		HarvestYield:     harvestYield,
+		Demand:           demand.Quantity,
+		Supply:           supply.Quantity,
+		Sale:             sale,
+		CurrentPrice:     price.Price,
+		DayOfYear:        dayOfYear(),
+		DaysUntilHarvest: daysUntilHarvest,
+		HarvestDate:      harvestTime,
+	}, nil
+}
+
+// commodityDuration returns how long a commodity grows from planting to harvest
+func commodityDuration(commodity *domain.Commodity) (time.Duration, error) {
 	// Duration format: HH:MM:SS
 	parts := strings.Split(commodity.Duration, ":")
 	if len(parts) != 3 {
-		return nil, fmt.Errorf("invalid duration format: expected HH:MM:SS, got %s", commodity.Duration)
+		return 0, fmt.Errorf("invalid duration format: expected HH:MM:SS, got %s", commodity.Duration)
 	}
 
 	hours, err := strconv.Atoi(parts[0])
 	if err != nil {
-		return nil, fmt.Errorf("invalid hours in duration: %w", err)
+		return 0, fmt.Errorf("invalid hours in duration: %w", err)
 	}
 	minutes, err := strconv.Atoi(parts[1])
 	if err != nil {
-		return nil, fmt.Errorf("invalid minutes in duration: %w", err)
+		return 0, fmt.Errorf("invalid minutes in duration: %w", err)
 	}
 	seconds, err := strconv.Atoi(parts[2])
 	if err != nil {
-		return nil, fmt.Errorf("invalid seconds in duration: %w", err)
+		return 0, fmt.Errorf("invalid seconds in duration: %w", err)
 	}
 
-	duration := time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second, nil
+}
+
+func forecastMessageOf(forecast *domain.Forecast) FrecastsMessageReq {
+	return FrecastsMessageReq{
+		Area:         forecast.LandArea,
+		HarvestTime:  forecast.DaysUntilHarvest,
+		HarvestYield: forecast.HarvestYield,
+		Demand:       forecast.Demand,
+		Supply:       forecast.Supply,
+		Sale:         forecast.Sale,
+		Price:        forecast.CurrentPrice,
+		Day:          forecast.DayOfYear,
+	}
+}
+
+// predict makes the harvest price forecast with the configured engine and records the outcome on forecast.
+// When the prediction service fails the baseline forecaster answers instead
+func (f *ForecastsUsecaseImpl) predict(ctx context.Context, forecast *domain.Forecast) error {
+	var price float64
+	var modelVersion string
+	var err error
+	if f.engine == domain.ForecastEngineBaseline {
+		forecast.Engine = domain.ForecastEngineBaseline
+		price, modelVersion, err = f.predictBaseline(ctx, forecast)
+	} else {
+		forecast.Engine = domain.ForecastEngineService
+		price, modelVersion, err = f.predictService(ctx, forecast)
+		if err != nil {
+			logrus.Log.Warn("prediction service failed, using the baseline forecaster: ", err)
+			baselinePrice, baselineModelVersion, baselineErr := f.predictBaseline(ctx, forecast)
+			if baselineErr != nil {
+				logrus.Log.Error("baseline forecaster failed: ", baselineErr)
+			} else {
+				forecast.Engine = domain.ForecastEngineBaseline
+				price, modelVersion, err = baselinePrice, baselineModelVersion, nil
+			}
+		}
+	}
 
-	harvestTime := landCommodity.CreatedAt.Add(duration)
 	now := time.Now()
-	daysUntilHarvest := int(harvestTime.Sub(now).Hours() / 24)
+	forecast.CompletedAt = &now
+	if err != nil {
+		forecast.Status = domain.ForecastFailed
+		forecast.Error = err.Error()
+		return err
+	}
+	forecast.Status = domain.ForecastCompleted
+	forecast.PredictedPrice = &price
+	forecast.ModelVersion = modelVersion
+	return nil
+}
 
-	logrus.Log.Info("forecasts message sent", "harvestTime")
+// predictService asks the prediction service for the harvest price
+func (f *ForecastsUsecaseImpl) predictService(ctx context.Context, forecast *domain.Forecast) (float64, string, error) {
+	// The reply is matched by correlation id, so concurrent forecasts never get each other's prediction
+	rpcCtx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+	message := forecastMessageOf(forecast)
+	logrus.Log.Info("forecasts message sent", message)
+	var messageRes FrecastsMessageRes
+	err := f.rabbitMQ.RequestJSON(rpcCtx, "prediction-exchange", "prediction-input", message, &messageRes)
+	if errors.Is(err, context.DeadlineExceeded) {
+		logrus.Log.Error("Timeout waiting for the prediction service")
+		return 0, "", utils.NewInternalError("no response from prediction service")
+	}
+	if err != nil {
+		logrus.Log.Error(err)
+		return 0, "", utils.NewInternalError(err.Error())
+	}
+	logrus.Log.Info("forecasts message received")
+	return messageRes.PredictedPrice, messageRes.ModelVersion, nil
+}
 
-	var sale float64
+// predictBaseline forecasts the harvest price from the weekly closing prices of the last two years, corrected by
+// the weekly sales of the same weeks and the current demand and supply. The model version names the method the
+// history was long enough for
+func (f *ForecastsUsecaseImpl) predictBaseline(ctx context.Context, forecast *domain.Forecast) (float64, string, error) {
+	now := time.Now()
+	end := nextPeriod(truncatePeriod(now, "week"), "week")
+	start := end.AddDate(0, 0, -7*baselineHistoryWeeks)
+	buckets, err := f.priceHistoryRepo.FindBuckets(ctx, forecast.CommodityID, forecast.CityID, "week", start, end)
+	if err != nil {
+		return 0, "", utils.NewInternalError(err.Error())
+	}
 
-	for _, s := range sales {
-		sale += s.Quantity
+	series := weeklyCloses(buckets, start, baselineHistoryWeeks)
+	series[len(series)-1] = forecast.CurrentPrice
+	horizon := int(math.Round(forecast.HarvestDate.Sub(now).Hours() / (24 * 7)))
+	if horizon < 0 {
+		horizon = 0
 	}
+	price, modelVersion := baselineForecast(series, horizon)
 
-	sale = sale / float64(len(sales))
-	logrus.Log.Info("forecasts message sent", "saleQuantity")
+	sales, err := f.saleRepo.FindByCommodityIDAndCityIDBetween(ctx, forecast.CommodityID, forecast.CityID, start, end)
+	if err != nil {
+		return 0, "", utils.NewInternalError(err.Error())
+	}
+	units, err := f.unitRepo.FindAll(ctx)
+	if err != nil {
+		return 0, "", utils.NewInternalError(err.Error())
+	}
+	volumes := weeklySales(sales, start, baselineHistoryWeeks, massUnitFactors(units))
+	if effect, ok := salesEffect(series, volumes, forecast.Demand, forecast.Supply, horizon); ok {
+		price *= math.Exp(effect)
+		modelVersion += "+sales"
+	}
+	return math.Round(math.Max(price, 0)*100) / 100, modelVersion, nil
+}
+
+// weeklySales returns the kg sold in each of the given number of weeks from start, sales recorded in an unknown
+// unit are ignored
+func weeklySales(sales []*domain.Sale, start time.Time, weeks int, massFactors map[string]float64) []float64 {
+	volumes := make([]float64, weeks)
+	for _, sale := range sales {
+		kg, ok := kilograms(massFactors, sale.Quantity, sale.Unit)
+		if !ok {
+			continue
+		}
+		week := int(truncatePeriod(sale.SaleDate, "week").Sub(start).Hours() / (24 * 7))
+		if week >= 0 && week < weeks {
+			volumes[week] += kg
+		}
+	}
+	return volumes
+}
 
-	day := dayOfYear()
+// salesEffect regresses the log change of the close from one week to the next on the log of the week's sales
+// against their mean, then returns the log change the current sales level brings over horizon weeks, fading like
+// the Holt trend. The current level is the mean of the last weeks' sales, raised or lowered by the current demand
+// over supply. It reports false when too few weeks had sales and a known price change, or they all sold the same
+func salesEffect(closes, volumes []float64, demand, supply float64, horizon int) (float64, bool) {
+	var total float64
+	var sold int
+	for _, volume := range volumes {
+		if volume > 0 {
+			total += volume
+			sold++
+		}
+	}
+	if sold == 0 {
+		return 0, false
+	}
+	mean := total / float64(sold)
 
-	message := FrecastsMessageReq{
-		Area:         landCommodity.LandArea,
-		HarvestTime:  daysUntilHarvest,
-		HarvestYield: harvestYield,
-		Demand:       demand.Quantity,
-		Supply:       supply.Quantity,
-		Sale:         sale,
-		Price:        price.Price,
-		Day:          day,
+	xs, ys := []float64{}, []float64{}
+	for i := 0; i+1 < len(closes); i++ {
+		if volumes[i] <= 0 || math.IsNaN(closes[i]) || math.IsNaN(closes[i+1]) || closes[i] <= 0 || closes[i+1] <= 0 {
+			continue
+		}
+		xs = append(xs, math.Log(volumes[i]/mean))
+		ys = append(ys, math.Log(closes[i+1]/closes[i]))
+	}
+	if len(xs) < salesMinWeeks {
+		return 0, false
+	}
+	slope, ok := regressionSlope(xs, ys)
+	if !ok {
+		return 0, false
 	}
 
-	logrus.Log.Info("forecasts message sent", message)
+	var recent float64
+	for _, volume := range volumes[len(volumes)-salesRecentWeeks:] {
+		recent += volume
+	}
+	recent /= salesRecentWeeks
+	if recent <= 0 {
+		return 0, false
+	}
+	pressure := math.Log(recent / mean)
+	if demand > 0 && supply > 0 {
+		pressure += math.Log(demand / supply)
+	}
+
+	effect := slope * pressure * dampedWeeks(horizon)
+	return math.Max(-salesEffectLimit, math.Min(salesEffectLimit, effect)), true
+}
+
+// regressionSlope returns the least squares slope of ys on xs, false when xs do not vary
+func regressionSlope(xs, ys []float64) (float64, bool) {
+	var meanX, meanY float64
+	for i := range xs {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= float64(len(xs))
+	meanY /= float64(len(ys))
+
+	var covariance, variance float64
+	for i := range xs {
+		covariance += (xs[i] - meanX) * (ys[i] - meanY)
+		variance += (xs[i] - meanX) * (xs[i] - meanX)
+	}
+	if variance == 0 {
+		return 0, false
+	}
+	return covariance / variance, true
+}
+
+// dampedWeeks returns the weeks a weekly change adds up to over horizon weeks when it fades by holtPhi every week
+func dampedWeeks(horizon int) float64 {
+	damping := 0.0
+	for i, factor := 1, holtPhi; i <= horizon; i, factor = i+1, factor*holtPhi {
+		damping += factor
+	}
+	return damping
+}
+
+// weeklyCloses returns the close of the given number of weeks from start, carried forward through the weeks
+// without a price change and NaN before the first one
+func weeklyCloses(buckets []*dto.PriceBucketDTO, start time.Time, weeks int) []float64 {
+	closes := make(map[time.Time]float64, len(buckets))
+	for _, bucket := range buckets {
+		closes[truncatePeriod(bucket.Period, "week")] = bucket.Close
+	}
+
+	series := make([]float64, weeks)
+	last := math.NaN()
+	week := start
+	for i := range series {
+		if value, ok := closes[week]; ok {
+			last = value
+		}
+		series[i] = last
+		week = nextPeriod(week, "week")
+	}
+	return series
+}
+
+// baselineForecast forecasts the close horizon weeks after the last one of series.
+// With a year of prices the harvest week of last year is scaled by the change since then (seasonal naive with drift),
+// with a few weeks the damped trend of Holt's method is followed, otherwise the current price is kept
+func baselineForecast(series []float64, horizon int) (float64, string) {
+	last := len(series) - 1
+	current := series[last]
+
+	seasonIndex := last + horizon - baselineSeasonWeeks
+	yearAgo := last - baselineSeasonWeeks
+	if horizon <= baselineSeasonWeeks && yearAgo >= 0 && !math.IsNaN(series[seasonIndex]) &&
+		!math.IsNaN(series[yearAgo]) && series[yearAgo] > 0 {
+		return series[seasonIndex] * current / series[yearAgo], "seasonal-naive"
+	}
+
+	known := []float64{}
+	for _, value := range series {
+		if !math.IsNaN(value) {
+			known = append(known, value)
+		}
+	}
+	if len(known) < 4 {
+		return current, "naive"
+	}
+
+	level, trend := known[0], known[1]-known[0]
+	for _, value := range known[1:] {
+		previous := level
+		level = holtAlpha*value + (1-holtAlpha)*(level+holtPhi*trend)
+		trend = holtBeta*(level-previous) + (1-holtBeta)*holtPhi*trend
+	}
+	return level + dampedWeeks(horizon)*trend, "holt-damped"
+}
+
+// CreateForecastJob stores a pending forecast and returns it right away, the prediction is made in the background
+// by this instance and the job is polled with GetForecastJob
+func (f *ForecastsUsecaseImpl) CreateForecastJob(ctx context.Context, userID uuid.UUID, req *dto.ForecastJobCreateDTO) (*domain.Forecast, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
 
-	err = f.rabbitMQ.PublishJSON(ctx, "prediction-exchange", "prediction-input", message)
+	forecast, err := f.prepareForecast(ctx, req.LandCommodityID, req.CityID)
 	if err != nil {
-		logrus.Log.Error(err)
+		return nil, err
+	}
+	forecast.RequestedBy = &userID
+	if err := f.forecastRepo.Create(ctx, forecast); err != nil {
 		return nil, utils.NewInternalError(err.Error())
 	}
-	logrus.Log.Info("forecasts message sended")
 
-	// Consume message from rabbitMQ
-	msgs, err := f.rabbitMQ.ConsumeMessages("prediction-output-queue")
+	// The request context ends with the response, the job must outlive it
+	job := *forecast
+	go f.runForecastJob(&job)
+
+	return forecast, nil
+}
+
+func (f *ForecastsUsecaseImpl) runForecastJob(forecast *domain.Forecast) {
+	ctx := context.Background()
+	if err := f.predict(ctx, forecast); err != nil {
+		logrus.Log.Error("forecast job ", forecast.ID, " failed: ", err)
+	}
+	updated, err := f.forecastRepo.Update(ctx, forecast)
+	if err != nil {
+		logrus.Log.Error("failed to store forecast job ", forecast.ID, ": ", err)
+		return
+	}
+	if !updated {
+		logrus.Log.Warn("forecast job ", forecast.ID, " was already finished")
+	}
+}
+
+// GetForecastJob returns a forecast job. A job still pending long after it should have timed out belonged to an
+// instance that stopped meanwhile, it is failed so clients stop polling
+func (f *ForecastsUsecaseImpl) GetForecastJob(ctx context.Context, id uuid.UUID) (*domain.Forecast, error) {
+	forecast, err := f.forecastRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, utils.NewNotFoundError("forecast job not found")
+	}
+	if forecast.Status != domain.ForecastPending || time.Since(forecast.CreatedAt) < 2*f.timeout {
+		return forecast, nil
+	}
+
+	now := time.Now()
+	forecast.Status = domain.ForecastFailed
+	forecast.Error = "forecast job was interrupted"
+	forecast.CompletedAt = &now
+	updated, err := f.forecastRepo.Update(ctx, forecast)
 	if err != nil {
-		logrus.Log.Error(err)
 		return nil, utils.NewInternalError(err.Error())
 	}
+	if !updated {
+		// It finished just now
+		if forecast, err = f.forecastRepo.FindByID(ctx, id); err != nil {
+			return nil, utils.NewInternalError(err.Error())
+		}
+	}
+	return forecast, nil
+}
 
-	// Tunggu pesan masuk
-	var forecastsResponse dto.ForecastsResponseDTO
+// GetForecastHistory returns the past forecasts of a land commodity, the latest first
+func (f *ForecastsUsecaseImpl) GetForecastHistory(ctx context.Context, landCommodityID uuid.UUID) ([]*domain.Forecast, error) {
+	if _, err := f.landCommodityRepo.FindByID(ctx, landCommodityID); err != nil {
+		return nil, utils.NewNotFoundError("land commodity not found")
+	}
+	forecasts, err := f.forecastRepo.FindByLandCommodityID(ctx, landCommodityID)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return forecasts, nil
+}
 
-	var messageRes FrecastsMessageRes
-	select {
-	case msg := <-msgs:
-		logrus.Log.Info("Message received: ", string(msg.Body))
-		// res := json.Unmarshal(msg.Body, any)
-		if err := json.Unmarshal(msg.Body, &messageRes); err != nil {
-			logrus.Log.Error("Failed to unmarshal message: ", err)
-			return nil, utils.NewInternalError("invalid message format")
-		}
-	case <-time.After(10 * time.Second): // Timeout 10 detik
-		logrus.Log.Error("Timeout waiting for message from predict-output-queue")
-		return nil, utils.NewInternalError("no response from prediction service")
-	}
-
-	forecastsResponse.HarvestPrice = messageRes.PredictedPrice
-	forecastsResponse.HarvestDate = harvestTime
-	forecastsResponse.City = city
-	forecastsResponse.Commodity = commodity
-	forecastsResponse.CurrentPrice = price.Price
-	return &forecastsResponse, nil
+// EvaluateDueForecasts records the actual price of the completed forecasts whose harvest date passed and returns
+// how many were evaluated. Instances evaluating the same forecast at once write the same price
+func (f *ForecastsUsecaseImpl) EvaluateDueForecasts(ctx context.Context) (int, error) {
+	evaluated := 0
+	for {
+		forecasts, err := f.forecastRepo.FindUnevaluated(ctx, time.Now(), forecastEvaluationBatch)
+		if err != nil {
+			return evaluated, utils.NewInternalError(err.Error())
+		}
+		for _, forecast := range forecasts {
+			now := time.Now()
+			forecast.ActualPrice = f.priceAt(ctx, forecast.CommodityID, forecast.CityID, forecast.HarvestDate)
+			forecast.EvaluatedAt = &now
+			if err := f.forecastRepo.Evaluate(ctx, forecast); err != nil {
+				return evaluated, utils.NewInternalError(err.Error())
+			}
+			evaluated++
+		}
+		if len(forecasts) < forecastEvaluationBatch {
+			return evaluated, nil
+		}
+	}
+}
+
+// priceAt returns the price a commodity had in a city at t, nil when it had none yet
+func (f *ForecastsUsecaseImpl) priceAt(ctx context.Context, commodityID uuid.UUID, cityID int64, t time.Time) *float64 {
+	price, err := f.priceRepo.FindByCommodityIDAndCityID(ctx, commodityID, cityID)
+	if err == nil && !price.UpdatedAt.After(t) {
+		return &price.Price
+	}
+	history, err := f.priceHistoryRepo.FindLatestBefore(ctx, commodityID, cityID, t)
+	if err != nil {
+		return nil
+	}
+	return &history.Price
+}
+
+// GetForecastAccuracy returns the MAE and MAPE of the evaluated forecasts per commodity, city and model
+func (f *ForecastsUsecaseImpl) GetForecastAccuracy(ctx context.Context, params *dto.ForecastAccuracyParamsDTO) ([]*dto.ForecastAccuracyDTO, error) {
+	if !params.StartDate.IsZero() && !params.EndDate.IsZero() && params.EndDate.Before(params.StartDate) {
+		return nil, utils.NewBadRequestError("end_date is before start_date")
+	}
+	accuracy, err := f.forecastRepo.FindAccuracy(ctx, params)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	for _, row := range accuracy {
+		row.MAE = roundForecastError(row.MAE)
+		if row.MAPE != nil {
+			mape := roundForecastError(*row.MAPE)
+			row.MAPE = &mape
+		}
+	}
+	return accuracy, nil
+}
+
+// BacktestForecasts replays the weekly prices of a commodity in a city through the baseline forecaster.
+// Every week of the window gets a forecast made only from the prices known by then, compared with the close
+// HorizonWeeks later. The window defaults to the year up to the last week whose target already closed
+func (f *ForecastsUsecaseImpl) BacktestForecasts(ctx context.Context, params *dto.ForecastBacktestParamsDTO) (*dto.ForecastBacktestDTO, error) {
+	if err := utils.ValidateStruct(params); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+	if params.HorizonWeeks == 0 {
+		params.HorizonWeeks = defaultBacktestHorizonWeeks
+	}
+	currentWeek := truncatePeriod(time.Now(), "week")
+	if params.EndDate.IsZero() {
+		params.EndDate = currentWeek.AddDate(0, 0, -7*params.HorizonWeeks)
+	}
+	if params.StartDate.IsZero() {
+		params.StartDate = params.EndDate.AddDate(0, 0, -7*baselineSeasonWeeks)
+	}
+	firstOrigin := truncatePeriod(params.StartDate, "week")
+	lastOrigin := truncatePeriod(params.EndDate, "week")
+	// A forecast made later has no closed target week to be compared with
+	if firstOrigin.After(currentWeek.AddDate(0, 0, -7*params.HorizonWeeks)) {
+		return nil, utils.NewBadRequestError("start_date must be at least horizon_weeks before the current week")
+	}
+	if lastOrigin.Before(firstOrigin) {
+		return nil, utils.NewBadRequestError("end_date is before start_date")
+	}
+	if int(lastOrigin.Sub(firstOrigin).Hours()/(24*7)) >= maxBacktestWeeks {
+		return nil, utils.NewBadRequestError(fmt.Sprintf("backtest window is longer than %d weeks", maxBacktestWeeks))
+	}
+
+	// One series from the history of the first forecast to the last target that already closed
+	start := firstOrigin.AddDate(0, 0, -7*(baselineHistoryWeeks-1))
+	end := lastOrigin.AddDate(0, 0, 7*params.HorizonWeeks)
+	if end.After(currentWeek) {
+		end = currentWeek
+	}
+	weeks := int(math.Round(end.Sub(start).Hours()/(24*7))) + 1
+	buckets, err := f.priceHistoryRepo.FindBuckets(ctx, params.CommodityID, params.CityID, "week", start, nextPeriod(end, "week"))
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	series := weeklyCloses(buckets, start, weeks)
+
+	backtest := &dto.ForecastBacktestDTO{
+		CommodityID:  params.CommodityID,
+		CityID:       params.CityID,
+		Engine:       domain.ForecastEngineBaseline,
+		StartDate:    firstOrigin,
+		EndDate:      lastOrigin,
+		HorizonWeeks: params.HorizonWeeks,
+		Points:       []*dto.ForecastBacktestPointDTO{},
+	}
+	var absoluteErrors, percentageErrors []float64
+	origin := firstOrigin
+	for i := baselineHistoryWeeks - 1; i+params.HorizonWeeks < len(series) && !origin.After(lastOrigin); i++ {
+		known := series[i-baselineHistoryWeeks+1 : i+1]
+		actual := series[i+params.HorizonWeeks]
+		if !math.IsNaN(known[len(known)-1]) && !math.IsNaN(actual) {
+			predicted, modelVersion := baselineForecast(known, params.HorizonWeeks)
+			predicted = math.Round(math.Max(predicted, 0)*100) / 100
+			backtest.Points = append(backtest.Points, &dto.ForecastBacktestPointDTO{
+				ForecastedAt:   origin,
+				TargetDate:     origin.AddDate(0, 0, 7*params.HorizonWeeks),
+				PredictedPrice: predicted,
+				ActualPrice:    actual,
+				ModelVersion:   modelVersion,
+			})
+			absoluteErrors = append(absoluteErrors, math.Abs(predicted-actual))
+			if actual > 0 {
+				percentageErrors = append(percentageErrors, math.Abs(predicted-actual)/actual*100)
+			}
+		}
+		origin = nextPeriod(origin, "week")
+	}
+
+	backtest.Count = len(backtest.Points)
+	backtest.MAE = meanForecastError(absoluteErrors)
+	backtest.MAPE = meanForecastError(percentageErrors)
+	return backtest, nil
+}
+
+func meanForecastError(values []float64) *float64 {
+	if len(values) == 0 {
+		return nil
+	}
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+	mean := roundForecastError(sum / float64(len(values)))
+	return &mean
+}
+
+func roundForecastError(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+// areaPriceWeight accumulates the prices expected for land commodities, weighted by their land area
+type areaPriceWeight struct {
+	sum  float64
+	area float64
+}
+
+func (w *areaPriceWeight) add(price, area float64) {
+	w.sum += price * area
+	w.area += area
+}
+
+func (w *areaPriceWeight) mean() *float64 {
+	if w.area == 0 {
+		return nil
+	}
+	mean := math.Round(w.sum/w.area*100) / 100
+	return &mean
+}
+
+// GetForecastsByArea adds up the land commodities of a commodity that are not harvested yet in a city or a province:
+// their land area, the yield expected from past harvests and the weeks they are due. The price of every land
+// commodity is forecast by the baseline for its harvest week in the city of its land
+func (f *ForecastsUsecaseImpl) GetForecastsByArea(ctx context.Context, params *dto.AreaForecastParamsDTO) (*dto.AreaForecastDTO, error) {
+	if err := utils.ValidateStruct(params); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+	if (params.CityID == 0) == (params.ProvinceID == 0) {
+		return nil, utils.NewBadRequestError("either city_id or province_id is required")
+	}
+
+	commodity, err := f.commodityRepo.FindByID(ctx, params.CommodityID)
+	if err != nil {
+		return nil, utils.NewNotFoundError("commodity not found")
+	}
+	duration, err := commodityDuration(commodity)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	forecast := &dto.AreaForecastDTO{
+		Commodity: commodity,
+		Engine:    domain.ForecastEngineBaseline,
+		Harvests:  []*dto.AreaHarvestWeekDTO{},
+	}
+	if params.CityID != 0 {
+		if forecast.City, err = f.cityRepo.FindByID(ctx, params.CityID); err != nil {
+			return nil, utils.NewNotFoundError("city not found")
+		}
+	} else if forecast.Province, err = f.provinceRepo.FindByID(ctx, params.ProvinceID); err != nil {
+		return nil, utils.NewNotFoundError("province not found")
+	}
+
+	landCommodities, err := f.landCommodityRepo.FindActiveByArea(ctx, params.CommodityID, params.CityID, params.ProvinceID)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	units, err := f.unitRepo.FindAll(ctx)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	areaFactors, massFactors := areaUnitFactors(units), massUnitFactors(units)
+	yieldPerHectare, yieldSource, err := f.areaYield(ctx, params, areaFactors, massFactors)
+	if err != nil {
+		return nil, err
+	}
+	forecast.YieldPerHectare = math.Round(yieldPerHectare*100) / 100
+	forecast.YieldSource = yieldSource
+
+	weeks := map[time.Time]*dto.AreaHarvestWeekDTO{}
+	weekPrices := map[time.Time]*areaPriceWeight{}
+	totalPrice := &areaPriceWeight{}
+	cityCloses := map[int64][]float64{}
+	for _, landCommodity := range landCommodities {
+		area, ok := hectares(areaFactors, landCommodity.LandArea, landCommodity.Unit)
+		if !ok || landCommodity.Land == nil {
+			forecast.SkippedLandCommodities++
+			continue
+		}
+		week := truncatePeriod(landCommodity.CreatedAt.Add(duration), "week")
+		harvestWeek, ok := weeks[week]
+		if !ok {
+			harvestWeek = &dto.AreaHarvestWeekDTO{Week: week}
+			weeks[week] = harvestWeek
+			weekPrices[week] = &areaPriceWeight{}
+		}
+		harvestWeek.LandCommodities++
+		harvestWeek.LandArea += area
+		harvestWeek.ExpectedYield += area * yieldPerHectare
+		forecast.LandCommodities++
+		forecast.LandArea += area
+		forecast.ExpectedYield += area * yieldPerHectare
+
+		price, err := f.areaPrice(ctx, cityCloses, params.CommodityID, landCommodity.Land.CityID, week)
+		if err != nil {
+			return nil, err
+		}
+		if price != nil {
+			weekPrices[week].add(*price, area)
+			totalPrice.add(*price, area)
+		}
+	}
+
+	for week, harvestWeek := range weeks {
+		harvestWeek.LandArea = math.Round(harvestWeek.LandArea*100) / 100
+		harvestWeek.ExpectedYield = math.Round(harvestWeek.ExpectedYield*100) / 100
+		harvestWeek.ExpectedPrice = weekPrices[week].mean()
+		forecast.Harvests = append(forecast.Harvests, harvestWeek)
+	}
+	sort.Slice(forecast.Harvests, func(i, j int) bool {
+		return forecast.Harvests[i].Week.Before(forecast.Harvests[j].Week)
+	})
+	forecast.LandArea = math.Round(forecast.LandArea*100) / 100
+	forecast.ExpectedYield = math.Round(forecast.ExpectedYield*100) / 100
+	forecast.ExpectedPrice = totalPrice.mean()
+	return forecast, nil
+}
+
+// areaYield returns the kg of the commodity harvested per ha in the area, or in the whole country when the area
+// has no harvest yet. Without any harvest the yield is 0 and its source none
+func (f *ForecastsUsecaseImpl) areaYield(ctx context.Context, params *dto.AreaForecastParamsDTO, areaFactors, massFactors map[string]float64) (float64, string, error) {
+	harvests, err := f.harvestRepo.FindByCommodityIDAndArea(ctx, params.CommodityID, params.CityID, params.ProvinceID)
+	if err != nil {
+		return 0, "", utils.NewInternalError(err.Error())
+	}
+	if perHectare, ok := yieldPerHectare(harvests, areaFactors, massFactors); ok {
+		return perHectare, dto.AreaYieldSourceArea, nil
+	}
 
+	harvests, err = f.harvestRepo.FindByCommodityIDAndArea(ctx, params.CommodityID, 0, 0)
+	if err != nil {
+		return 0, "", utils.NewInternalError(err.Error())
+	}
+	if perHectare, ok := yieldPerHectare(harvests, areaFactors, massFactors); ok {
+		return perHectare, dto.AreaYieldSourceNational, nil
+	}
+	return 0, dto.AreaYieldSourceNone, nil
 }
 
-func (f *ForecastsUsecaseImpl) GetForecastsByArea(ctx context.Context, area string) (*dto.ForecastsResponseDTO, error) {
+// yieldPerHectare divides the kg harvested by the ha they grew on, harvests recorded in an unknown unit are ignored
+func yieldPerHectare(harvests []*domain.Harvest, areaFactors, massFactors map[string]float64) (float64, bool) {
+	var totalKg, totalHa float64
+	for _, harvest := range harvests {
+		if harvest.LandCommodity == nil {
+			continue
+		}
+		kg, ok := kilograms(massFactors, harvest.Quantity, harvest.Unit)
+		if !ok {
+			continue
+		}
+		ha, ok := hectares(areaFactors, harvest.LandCommodity.LandArea, harvest.LandCommodity.Unit)
+		if !ok || ha <= 0 {
+			continue
+		}
+		totalKg += kg
+		totalHa += ha
+	}
+	if totalHa == 0 {
+		return 0, false
+	}
+	return totalKg / totalHa, true
+}
 
-	return nil, nil
+// areaPrice forecasts the price of the commodity in a city for the week of a harvest, nil when the city has no
+// price. The weekly closes of every city are loaded once into cityCloses
+func (f *ForecastsUsecaseImpl) areaPrice(ctx context.Context, cityCloses map[int64][]float64, commodityID uuid.UUID, cityID int64, week time.Time) (*float64, error) {
+	currentWeek := truncatePeriod(time.Now(), "week")
+	series, ok := cityCloses[cityID]
+	if !ok {
+		end := nextPeriod(currentWeek, "week")
+		start := end.AddDate(0, 0, -7*baselineHistoryWeeks)
+		buckets, err := f.priceHistoryRepo.FindBuckets(ctx, commodityID, cityID, "week", start, end)
+		if err != nil {
+			return nil, utils.NewInternalError(err.Error())
+		}
+		series = weeklyCloses(buckets, start, baselineHistoryWeeks)
+		cityCloses[cityID] = series
+	}
+	if math.IsNaN(series[len(series)-1]) {
+		return nil, nil
+	}
+
+	horizon := int(math.Round(week.Sub(currentWeek).Hours() / (24 * 7)))
+	if horizon < 0 {
+		horizon = 0
+	}
+	price, _ := baselineForecast(series, horizon)
+	price = math.Round(math.Max(price, 0)*100) / 100
+	return &price, nil
 }
@@ -39,10 +39,28 @@ func NewHarvestUsecase(harvestRepo repository_interface.HarvestRepository, cityR
 	return &HarvestUsecaseImpl{harvestRepo, cityRepo, landCommodityRepo, rabbitMQ, cache, globFunc, env, txManager}
 }
 
-func (uc *HarvestUsecaseImpl) CreateHarvest(ctx context.Context, req *dto.HarvestCreateDTO) (*domain.Harvest, error) {
+// checkHarvestOwner follows the harvest through its land commodity to the land owner, Admin skips the lookup
+func (uc *HarvestUsecaseImpl) checkHarvestOwner(ctx context.Context, landCommodityID uuid.UUID, userID uuid.UUID, role string) error {
+	if role == domain.RoleAdmin {
+		return nil
+	}
+	landCommodity, err := uc.landCommodityRepo.FindByID(ctx, landCommodityID)
+	if err != nil {
+		return utils.NewNotFoundError("land commodity not found")
+	}
+	if landCommodity.Land == nil {
+		return utils.NewNotFoundError("land not found")
+	}
+	return checkLandOwner(landCommodity.Land, userID, role)
+}
+
+func (uc *HarvestUsecaseImpl) CreateHarvest(ctx context.Context, userID uuid.UUID, role string, req *dto.HarvestCreateDTO) (*domain.Harvest, error) {
 	if err := utils.ValidateStruct(req); len(err) > 0 {
 		return nil, utils.NewValidationError(err)
 	}
+	if err := uc.checkHarvestOwner(ctx, req.LandCommodityID, userID, role); err != nil {
+		return nil, err
+	}
 	harvest := domain.Harvest{}
 	err := uc.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
 		logrus.Log.Info("starting create harvest transaction")
@@ -159,7 +177,7 @@ func (uc *HarvestUsecaseImpl) GetHarvestByCityID(ctx context.Context, id int64)
 	return harvests, nil
 }
 
-func (uc *HarvestUsecaseImpl) UpdateHarvest(ctx context.Context, id uuid.UUID, req *dto.HarvestUpdateDTO) (*domain.Harvest, error) {
+func (uc *HarvestUsecaseImpl) UpdateHarvest(ctx context.Context, userID uuid.UUID, role string, id uuid.UUID, req *dto.HarvestUpdateDTO) (*domain.Harvest, error) {
 	if err := utils.ValidateStruct(req); len(err) > 0 {
 		return nil, utils.NewValidationError(err)
 	}
@@ -167,6 +185,9 @@ func (uc *HarvestUsecaseImpl) UpdateHarvest(ctx context.Context, id uuid.UUID, r
 	if err != nil {
 		return nil, utils.NewNotFoundError("harvest not found")
 	}
+	if err := uc.checkHarvestOwner(ctx, harvest.LandCommodityID, userID, role); err != nil {
+		return nil, err
+	}
 
 	if req.HarvestDate != "" {
 		parsed, err := time.Parse("2006-01-02", req.HarvestDate)
@@ -196,11 +217,14 @@ func (uc *HarvestUsecaseImpl) UpdateHarvest(ctx context.Context, id uuid.UUID, r
 	return updatedHarvest, nil
 }
 
-func (uc *HarvestUsecaseImpl) DeleteHarvest(ctx context.Context, id uuid.UUID) error {
-	_, err := uc.harvestRepo.FindByID(ctx, id)
+func (uc *HarvestUsecaseImpl) DeleteHarvest(ctx context.Context, userID uuid.UUID, role string, id uuid.UUID) error {
+	harvest, err := uc.harvestRepo.FindByID(ctx, id)
 	if err != nil {
 		return utils.NewNotFoundError("harvest not found")
 	}
+	if err := uc.checkHarvestOwner(ctx, harvest.LandCommodityID, userID, role); err != nil {
+		return err
+	}
 	err = uc.harvestRepo.Delete(ctx, id)
 	if err != nil {
 		return utils.NewInternalError(err.Error())
@@ -212,11 +236,14 @@ func (uc *HarvestUsecaseImpl) DeleteHarvest(ctx context.Context, id uuid.UUID) e
 	return nil
 }
 
-func (uc *HarvestUsecaseImpl) RestoreHarvest(ctx context.Context, id uuid.UUID) (*domain.Harvest, error) {
-	_, err := uc.harvestRepo.FindDeletedByID(ctx, id)
+func (uc *HarvestUsecaseImpl) RestoreHarvest(ctx context.Context, userID uuid.UUID, role string, id uuid.UUID) (*domain.Harvest, error) {
+	deletedHarvest, err := uc.harvestRepo.FindDeletedByID(ctx, id)
 	if err != nil {
 		return nil, utils.NewNotFoundError("deleted harvest not found")
 	}
+	if err := uc.checkHarvestOwner(ctx, deletedHarvest.LandCommodityID, userID, role); err != nil {
+		return nil, err
+	}
 	err = uc.harvestRepo.Restore(ctx, id)
 	if err != nil {
 		return nil, utils.NewInternalError(err.Error())
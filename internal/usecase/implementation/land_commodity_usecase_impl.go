@@ -28,7 +28,23 @@ func NewLandCommodityUsecase(landCommodityRepo repository_interface.LandCommodit
 	return &LandCommodityUsecaseImpl{landCommodityRepo, landRepo, cityRepo, commodityRepo, cache}
 }
 
-func (u *LandCommodityUsecaseImpl) CreateLandCommodity(ctx context.Context, req *dto.LandCommodityCreateDTO) (*domain.LandCommodity, error) {
+// checkLandCommodityOwner uses the preloaded land and only queries it when the preload is missing
+func (u *LandCommodityUsecaseImpl) checkLandCommodityOwner(ctx context.Context, landCommodity *domain.LandCommodity, userID uuid.UUID, role string) error {
+	if role == domain.RoleAdmin {
+		return nil
+	}
+	land := landCommodity.Land
+	if land == nil {
+		found, err := u.landRepo.FindByID(ctx, landCommodity.LandID)
+		if err != nil {
+			return utils.NewNotFoundError("land not found")
+		}
+		land = found
+	}
+	return checkLandOwner(land, userID, role)
+}
+
+func (u *LandCommodityUsecaseImpl) CreateLandCommodity(ctx context.Context, userID uuid.UUID, role string, req *dto.LandCommodityCreateDTO) (*domain.LandCommodity, error) {
 	landcommondity := domain.LandCommodity{}
 	if err := utils.ValidateStruct(req); len(err) > 0 {
 		return nil, utils.NewValidationError(err)
@@ -43,6 +59,9 @@ func (u *LandCommodityUsecaseImpl) CreateLandCommodity(ctx context.Context, req
 	if err != nil {
 		return nil, utils.NewNotFoundError("land not found")
 	}
+	if err := checkLandOwner(land, userID, role); err != nil {
+		return nil, err
+	}
 
 	landArea, err := u.landCommodityRepo.SumNotHarvestedLandAreaByLandID(ctx, land.ID)
 	if err != nil {
@@ -123,7 +142,7 @@ func (u *LandCommodityUsecaseImpl) GetLandCommodityByCommodityID(ctx context.Con
 	return landCommodities, nil
 }
 
-func (u *LandCommodityUsecaseImpl) UpdateLandCommodity(ctx context.Context, id uuid.UUID, req *dto.LandCommodityUpdateDTO) (*domain.LandCommodity, error) {
+func (u *LandCommodityUsecaseImpl) UpdateLandCommodity(ctx context.Context, userID uuid.UUID, role string, id uuid.UUID, req *dto.LandCommodityUpdateDTO) (*domain.LandCommodity, error) {
 	if err := utils.ValidateStruct(req); len(err) > 0 {
 		return nil, utils.NewValidationError(err)
 	}
@@ -132,6 +151,9 @@ func (u *LandCommodityUsecaseImpl) UpdateLandCommodity(ctx context.Context, id u
 	if err != nil {
 		return nil, utils.NewNotFoundError("land commodity not found")
 	}
+	if err := u.checkLandCommodityOwner(ctx, landCommodity, userID, role); err != nil {
+		return nil, err
+	}
 
 	if landCommodity.Harvested == true {
 		return nil, utils.NewBadRequestError("land commodity already harvested")
@@ -146,6 +168,10 @@ func (u *LandCommodityUsecaseImpl) UpdateLandCommodity(ctx context.Context, id u
 	if err != nil {
 		return nil, utils.NewNotFoundError("land not found")
 	}
+	// The commodity may only be moved to another land of the same owner
+	if err := checkLandOwner(land, userID, role); err != nil {
+		return nil, err
+	}
 
 	landArea, err := u.landCommodityRepo.SumNotHarvestedLandAreaByLandID(ctx, land.ID)
 	if err != nil {
@@ -174,11 +200,14 @@ func (u *LandCommodityUsecaseImpl) UpdateLandCommodity(ctx context.Context, id u
 	return updatedLandCommodity, nil
 }
 
-func (u *LandCommodityUsecaseImpl) DeleteLandCommodity(ctx context.Context, id uuid.UUID) error {
-	_, err := u.landCommodityRepo.FindByID(ctx, id)
+func (u *LandCommodityUsecaseImpl) DeleteLandCommodity(ctx context.Context, userID uuid.UUID, role string, id uuid.UUID) error {
+	landCommodity, err := u.landCommodityRepo.FindByID(ctx, id)
 	if err != nil {
 		return utils.NewNotFoundError("land commodity not found")
 	}
+	if err := u.checkLandCommodityOwner(ctx, landCommodity, userID, role); err != nil {
+		return err
+	}
 	err = u.landCommodityRepo.Delete(ctx, id)
 	if err != nil {
 		return utils.NewInternalError(err.Error())
@@ -186,7 +215,7 @@ func (u *LandCommodityUsecaseImpl) DeleteLandCommodity(ctx context.Context, id u
 	return nil
 }
 
-func (u *LandCommodityUsecaseImpl) RestoreLandCommodity(ctx context.Context, id uuid.UUID) (*domain.LandCommodity, error) {
+func (u *LandCommodityUsecaseImpl) RestoreLandCommodity(ctx context.Context, userID uuid.UUID, role string, id uuid.UUID) (*domain.LandCommodity, error) {
 	deletedLandCommodity, err := u.landCommodityRepo.FindDeletedByID(ctx, id)
 	if err != nil {
 		return nil, utils.NewNotFoundError("deleted land commodity not found")
@@ -196,6 +225,9 @@ func (u *LandCommodityUsecaseImpl) RestoreLandCommodity(ctx context.Context, id
 	if err != nil {
 		return nil, utils.NewNotFoundError("land not found")
 	}
+	if err := checkLandOwner(land, userID, role); err != nil {
+		return nil, err
+	}
 
 	landArea, err := u.landCommodityRepo.SumLandAreaByLandID(ctx, land.ID)
 	if err != nil {
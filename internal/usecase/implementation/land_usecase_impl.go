@@ -14,10 +14,39 @@ import (
 type LandUsecaseImpl struct {
 	landRepo repository_interface.LandRepository
 	userRepo repository_interface.UserRepository
+	cityRepo repository_interface.CityRepository
 }
 
-func NewLandUsecase(landRepo repository_interface.LandRepository, userRepo repository_interface.UserRepository) usecase_interface.LandUsecase {
-	return &LandUsecaseImpl{landRepo, userRepo}
+func NewLandUsecase(landRepo repository_interface.LandRepository, userRepo repository_interface.UserRepository, cityRepo repository_interface.CityRepository) usecase_interface.LandUsecase {
+	return &LandUsecaseImpl{landRepo, userRepo, cityRepo}
+}
+
+// checkLandOwner lets Admin through and otherwise only the farmer who owns the land
+func checkLandOwner(land *domain.Land, userID uuid.UUID, role string) error {
+	if role == domain.RoleAdmin || land.UserID == userID {
+		return nil
+	}
+	return utils.NewForbiddenError("you do not own this land")
+}
+
+// checkLandSupervisor also lets a field officer through when the land lies in the province they supervise
+func (u *LandUsecaseImpl) checkLandSupervisor(ctx context.Context, land *domain.Land, userID uuid.UUID, role string) error {
+	if role != domain.RoleFieldOfficer {
+		return checkLandOwner(land, userID, role)
+	}
+
+	officer, err := u.userRepo.FindWithRoleByID(ctx, userID)
+	if err != nil {
+		return utils.NewNotFoundError("user not found")
+	}
+	city, err := u.cityRepo.FindByID(ctx, land.CityID)
+	if err != nil {
+		return utils.NewNotFoundError("city not found")
+	}
+	if officer.ProvinceID == nil || *officer.ProvinceID != city.ProvinceID {
+		return utils.NewForbiddenError("land is outside the province you supervise")
+	}
+	return nil
 }
 
 func (u *LandUsecaseImpl) CreateLand(ctx context.Context, userId uuid.UUID, req *dto.LandCreateDTO) (*domain.Land, error) {
@@ -73,7 +102,7 @@ func (u *LandUsecaseImpl) GetAllLands(ctx context.Context) ([]*domain.Land, erro
 	return lands, nil
 }
 
-func (u *LandUsecaseImpl) UpdateLand(ctx context.Context, userId, id uuid.UUID, req *dto.LandUpdateDTO) (*domain.Land, error) {
+func (u *LandUsecaseImpl) UpdateLand(ctx context.Context, userId uuid.UUID, role string, id uuid.UUID, req *dto.LandUpdateDTO) (*domain.Land, error) {
 	if err := utils.ValidateStruct(req); len(err) > 0 {
 		return nil, utils.NewValidationError(err)
 	}
@@ -82,6 +111,9 @@ func (u *LandUsecaseImpl) UpdateLand(ctx context.Context, userId, id uuid.UUID,
 	if err != nil {
 		return nil, utils.NewNotFoundError("land not found")
 	}
+	if err := u.checkLandSupervisor(ctx, land, userId, role); err != nil {
+		return nil, err
+	}
 	land.LandArea = req.LandArea
 	land.Certificate = req.Certificate
 
@@ -98,11 +130,14 @@ func (u *LandUsecaseImpl) UpdateLand(ctx context.Context, userId, id uuid.UUID,
 	return updatedLand, nil
 }
 
-func (u *LandUsecaseImpl) DeleteLand(ctx context.Context, id uuid.UUID) error {
-	_, err := u.landRepo.FindByID(ctx, id)
+func (u *LandUsecaseImpl) DeleteLand(ctx context.Context, userId uuid.UUID, role string, id uuid.UUID) error {
+	land, err := u.landRepo.FindByID(ctx, id)
 	if err != nil {
 		return utils.NewNotFoundError("land not found")
 	}
+	if err := checkLandOwner(land, userId, role); err != nil {
+		return err
+	}
 
 	err = u.landRepo.Delete(ctx, id)
 	if err != nil {
@@ -112,11 +147,14 @@ func (u *LandUsecaseImpl) DeleteLand(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (u *LandUsecaseImpl) RestoreLand(ctx context.Context, id uuid.UUID) (*domain.Land, error) {
-	_, err := u.landRepo.FindDeletedByID(ctx, id)
+func (u *LandUsecaseImpl) RestoreLand(ctx context.Context, userId uuid.UUID, role string, id uuid.UUID) (*domain.Land, error) {
+	deletedLand, err := u.landRepo.FindDeletedByID(ctx, id)
 	if err != nil {
 		return nil, utils.NewNotFoundError("deleted land not found")
 	}
+	if err := checkLandOwner(deletedLand, userId, role); err != nil {
+		return nil, err
+	}
 
 	err = u.landRepo.Restore(ctx, id)
 	if err != nil {
@@ -0,0 +1,104 @@
+package usecase_implementation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+type OfficerUsecaseImpl struct {
+	userRepo     repository_interface.UserRepository
+	provinceRepo repository_interface.ProvinceRepository
+	landRepo     repository_interface.LandRepository
+}
+
+func NewOfficerUsecase(
+	userRepo repository_interface.UserRepository,
+	provinceRepo repository_interface.ProvinceRepository,
+	landRepo repository_interface.LandRepository,
+) usecase_interface.OfficerUsecase {
+	return &OfficerUsecaseImpl{userRepo, provinceRepo, landRepo}
+}
+
+// AssignProvince sets the province a field officer supervises
+func (uc *OfficerUsecaseImpl) AssignProvince(ctx context.Context, officerID uuid.UUID, req *dto.OfficerProvinceDTO) (*dto.UserResponseDTO, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+
+	officer, err := uc.userRepo.FindWithRoleByID(ctx, officerID)
+	if err != nil {
+		return nil, utils.NewNotFoundError("user not found")
+	}
+	if officer.Role.Name != domain.RoleFieldOfficer {
+		return nil, utils.NewBadRequestError("user is not a field officer")
+	}
+
+	if _, err := uc.provinceRepo.FindByID(ctx, req.ProvinceID); err != nil {
+		return nil, utils.NewNotFoundError("province not found")
+	}
+
+	if err := uc.userRepo.Update(ctx, officerID, &domain.User{ProvinceID: &req.ProvinceID}); err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	updatedOfficer, err := uc.userRepo.FindWithRoleByID(ctx, officerID)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	return utils.UserDtoFormat(updatedOfficer), nil
+}
+
+// GetDashboard lists the farms in the province supervised by the officer
+func (uc *OfficerUsecaseImpl) GetDashboard(ctx context.Context, officerID uuid.UUID) (*dto.OfficerDashboardDTO, error) {
+	officer, err := uc.userRepo.FindWithRoleByID(ctx, officerID)
+	if err != nil {
+		return nil, utils.NewNotFoundError("user not found")
+	}
+	if officer.ProvinceID == nil {
+		return nil, utils.NewForbiddenError("no province assigned to this officer")
+	}
+
+	province, err := uc.provinceRepo.FindByID(ctx, *officer.ProvinceID)
+	if err != nil {
+		return nil, utils.NewNotFoundError("province not found")
+	}
+
+	lands, err := uc.landRepo.FindByProvinceID(ctx, province.ID)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	dashboard := &dto.OfficerDashboardDTO{
+		Province: province,
+		Farms:    make([]*dto.OfficerFarmDTO, 0, len(lands)),
+	}
+	farmers := make(map[uuid.UUID]bool)
+	for _, land := range lands {
+		farm := &dto.OfficerFarmDTO{
+			LandID:      land.ID,
+			LandArea:    land.LandArea,
+			Unit:        land.Unit,
+			Certificate: land.Certificate,
+			City:        land.City,
+			FarmerID:    land.UserID,
+		}
+		if land.User != nil {
+			farm.FarmerName = land.User.Name
+			farm.FarmerPhone = land.User.Phone
+		}
+		dashboard.Farms = append(dashboard.Farms, farm)
+		dashboard.TotalLandArea += land.LandArea
+		farmers[land.UserID] = true
+	}
+	dashboard.TotalFarms = len(lands)
+	dashboard.TotalFarmers = len(farmers)
+
+	return dashboard, nil
+}
@@ -0,0 +1,100 @@
+package usecase_implementation
+
+import (
+	"context"
+
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/pkg/auth/casbin"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+// policyAdminRule guards the policy endpoints themselves so Admin can not lock itself out
+var policyAdminRule = dto.PolicyDTO{Subject: domain.RoleAdmin, Object: "/api/policies*", Action: "*"}
+
+type PolicyUsecaseImpl struct {
+	casbin casbin.Casbin
+}
+
+func NewPolicyUsecase(casbin casbin.Casbin) usecase_interface.PolicyUsecase {
+	return &PolicyUsecaseImpl{casbin: casbin}
+}
+
+func (u *PolicyUsecaseImpl) GetPolicies(ctx context.Context, subject string) ([]*dto.PolicyDTO, error) {
+	rules, err := u.casbin.GetPolicies(subject)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	policies := make([]*dto.PolicyDTO, 0, len(rules))
+	for _, rule := range rules {
+		if len(rule) < 3 {
+			continue
+		}
+		policies = append(policies, &dto.PolicyDTO{Subject: rule[0], Object: rule[1], Action: rule[2]})
+	}
+	return policies, nil
+}
+
+func (u *PolicyUsecaseImpl) CreatePolicy(ctx context.Context, req *dto.PolicyDTO) (*dto.PolicyDTO, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+
+	added, err := u.casbin.AddPolicy(req.Subject, req.Object, req.Action)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	if !added {
+		return nil, utils.NewConflictError("policy already exists")
+	}
+	return req, nil
+}
+
+func (u *PolicyUsecaseImpl) UpdatePolicy(ctx context.Context, req *dto.PolicyUpdateDTO) (*dto.PolicyDTO, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+	if req.Old == policyAdminRule {
+		return nil, utils.NewForbiddenError("policy administration rule can not be changed")
+	}
+
+	exists, err := u.casbin.HasPolicy(req.New.Subject, req.New.Object, req.New.Action)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	if exists {
+		return nil, utils.NewConflictError("policy already exists")
+	}
+
+	updated, err := u.casbin.UpdatePolicy(
+		[]string{req.Old.Subject, req.Old.Object, req.Old.Action},
+		[]string{req.New.Subject, req.New.Object, req.New.Action},
+	)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	if !updated {
+		return nil, utils.NewNotFoundError("policy not found")
+	}
+	return &req.New, nil
+}
+
+func (u *PolicyUsecaseImpl) DeletePolicy(ctx context.Context, req *dto.PolicyDTO) error {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return utils.NewValidationError(err)
+	}
+	if *req == policyAdminRule {
+		return utils.NewForbiddenError("policy administration rule can not be deleted")
+	}
+
+	removed, err := u.casbin.RemovePolicy(req.Subject, req.Object, req.Action)
+	if err != nil {
+		return utils.NewInternalError(err.Error())
+	}
+	if !removed {
+		return utils.NewNotFoundError("policy not found")
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package usecase_implementation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+const (
+	defaultPriceAlertWindowDays      = 7
+	defaultPriceAlertCooldownMinutes = 24 * 60
+)
+
+type PriceAlertUsecaseImpl struct {
+	priceAlertRepo repository_interface.PriceAlertRepository
+	commodityRepo  repository_interface.CommodityRepository
+	cityRepo       repository_interface.CityRepository
+}
+
+func NewPriceAlertUsecase(priceAlertRepo repository_interface.PriceAlertRepository, commodityRepo repository_interface.CommodityRepository, cityRepo repository_interface.CityRepository) usecase_interface.PriceAlertUsecase {
+	return &PriceAlertUsecaseImpl{priceAlertRepo, commodityRepo, cityRepo}
+}
+
+func (uc *PriceAlertUsecaseImpl) CreatePriceAlert(ctx context.Context, userID uuid.UUID, req *dto.PriceAlertCreateDTO) (*dto.PriceAlertResponseDTO, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+
+	if _, err := uc.commodityRepo.FindByID(ctx, req.CommodityID); err != nil {
+		return nil, utils.NewNotFoundError("commodity not found")
+	}
+	if _, err := uc.cityRepo.FindByID(ctx, req.CityID); err != nil {
+		return nil, utils.NewNotFoundError("city not found")
+	}
+
+	alert := &domain.PriceAlert{
+		ID:              uuid.New(),
+		UserID:          userID,
+		CommodityID:     req.CommodityID,
+		CityID:          req.CityID,
+		Condition:       req.Condition,
+		WindowDays:      req.WindowDays,
+		CooldownMinutes: req.CooldownMinutes,
+		Active:          true,
+	}
+	// Only the value the condition reads is stored
+	if req.Condition == domain.PriceAlertChange {
+		alert.ChangePercent = req.ChangePercent
+	} else {
+		alert.TargetPrice = req.TargetPrice
+	}
+	if alert.WindowDays == 0 {
+		alert.WindowDays = defaultPriceAlertWindowDays
+	}
+	if alert.CooldownMinutes == 0 {
+		alert.CooldownMinutes = defaultPriceAlertCooldownMinutes
+	}
+
+	err := uc.priceAlertRepo.Create(ctx, alert)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return priceAlertDtoFormat(alert), nil
+}
+
+func (uc *PriceAlertUsecaseImpl) GetPriceAlerts(ctx context.Context, userID uuid.UUID) ([]*dto.PriceAlertResponseDTO, error) {
+	alerts, err := uc.priceAlertRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	res := make([]*dto.PriceAlertResponseDTO, len(alerts))
+	for i, alert := range alerts {
+		res[i] = priceAlertDtoFormat(alert)
+	}
+	return res, nil
+}
+
+func (uc *PriceAlertUsecaseImpl) GetPriceAlertByID(ctx context.Context, userID, id uuid.UUID) (*dto.PriceAlertResponseDTO, error) {
+	alert, err := uc.findOwnAlert(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return priceAlertDtoFormat(alert), nil
+}
+
+func (uc *PriceAlertUsecaseImpl) UpdatePriceAlert(ctx context.Context, userID, id uuid.UUID, req *dto.PriceAlertUpdateDTO) (*dto.PriceAlertResponseDTO, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+	alert, err := uc.findOwnAlert(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TargetPrice != nil {
+		if alert.Condition == domain.PriceAlertChange {
+			return nil, utils.NewBadRequestError("target_price only applies to above and below alerts")
+		}
+		alert.TargetPrice = req.TargetPrice
+	}
+	if req.ChangePercent != nil {
+		if alert.Condition != domain.PriceAlertChange {
+			return nil, utils.NewBadRequestError("change_percent only applies to change alerts")
+		}
+		alert.ChangePercent = req.ChangePercent
+	}
+	if req.WindowDays != nil {
+		alert.WindowDays = *req.WindowDays
+	}
+	if req.CooldownMinutes != nil {
+		alert.CooldownMinutes = *req.CooldownMinutes
+	}
+	if req.Active != nil {
+		alert.Active = *req.Active
+	}
+
+	err = uc.priceAlertRepo.Update(ctx, alert)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return priceAlertDtoFormat(alert), nil
+}
+
+func (uc *PriceAlertUsecaseImpl) DeletePriceAlert(ctx context.Context, userID, id uuid.UUID) error {
+	if _, err := uc.findOwnAlert(ctx, userID, id); err != nil {
+		return err
+	}
+
+	err := uc.priceAlertRepo.Delete(ctx, id)
+	if err != nil {
+		return utils.NewInternalError(err.Error())
+	}
+	return nil
+}
+
+// findOwnAlert hides alerts of other users behind the same not found error
+func (uc *PriceAlertUsecaseImpl) findOwnAlert(ctx context.Context, userID, id uuid.UUID) (*domain.PriceAlert, error) {
+	alert, err := uc.priceAlertRepo.FindByID(ctx, id)
+	if err != nil || alert.UserID != userID {
+		return nil, utils.NewNotFoundError("price alert not found")
+	}
+	return alert, nil
+}
+
+func priceAlertDtoFormat(alert *domain.PriceAlert) *dto.PriceAlertResponseDTO {
+	return &dto.PriceAlertResponseDTO{
+		ID:              alert.ID,
+		CommodityID:     alert.CommodityID,
+		CityID:          alert.CityID,
+		Condition:       alert.Condition,
+		TargetPrice:     alert.TargetPrice,
+		ChangePercent:   alert.ChangePercent,
+		WindowDays:      alert.WindowDays,
+		CooldownMinutes: alert.CooldownMinutes,
+		Active:          alert.Active,
+		LastTriggeredAt: alert.LastTriggeredAt,
+		CreatedAt:       alert.CreatedAt,
+		UpdatedAt:       alert.UpdatedAt,
+	}
+}
@@ -0,0 +1,424 @@
+package usecase_implementation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/pkg/database/cache"
+	"github.com/ryvasa/go-super-farmer/pkg/env"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+// maxPriceIndexPeriods is the most periods the range and the base period may span together
+const maxPriceIndexPeriods = 1000
+
+type PriceIndexUsecaseImpl struct {
+	priceHistoryRepo repository_interface.PriceHistoryRepository
+	supplyRepo       repository_interface.SupplyRepository
+	commodityRepo    repository_interface.CommodityRepository
+	provinceRepo     repository_interface.ProvinceRepository
+	unitRepo         repository_interface.UnitRepository
+	cache            cache.Cache
+	env              *env.Env
+}
+
+func NewPriceIndexUsecase(priceHistoryRepo repository_interface.PriceHistoryRepository, supplyRepo repository_interface.SupplyRepository, commodityRepo repository_interface.CommodityRepository, provinceRepo repository_interface.ProvinceRepository, unitRepo repository_interface.UnitRepository, cache cache.Cache, env *env.Env) usecase_interface.PriceIndexUsecase {
+	return &PriceIndexUsecaseImpl{priceHistoryRepo, supplyRepo, commodityRepo, provinceRepo, unitRepo, cache, env}
+}
+
+// priceIndexRange is a resolved request, periods spans both the requested range and the base period
+type priceIndexRange struct {
+	interval  string
+	start     time.Time
+	end       time.Time
+	baseStart time.Time
+	baseEnd   time.Time
+	periods   []time.Time
+}
+
+// priceIndexCity holds the price a city had at the close of every period, NaN before its first price
+type priceIndexCity struct {
+	provinceID int64
+	weight     float64
+	prices     []float64
+}
+
+// GetPriceIndex returns the supply weighted index of a province, or the nationwide one when ProvinceID is 0
+func (uc *PriceIndexUsecaseImpl) GetPriceIndex(ctx context.Context, params *dto.PriceIndexParamsDTO) (*dto.PriceIndexDTO, error) {
+	r, err := uc.resolveRange(params)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.commodityRepo.FindByID(ctx, params.CommodityID); err != nil {
+		return nil, utils.NewNotFoundError("commodity not found")
+	}
+	var province *domain.Province
+	if params.ProvinceID != 0 {
+		if province, err = uc.provinceRepo.FindByID(ctx, params.ProvinceID); err != nil {
+			return nil, utils.NewNotFoundError("province not found")
+		}
+	}
+
+	cacheKey := fmt.Sprintf("price_index_%s_%d_%s", params.CommodityID, params.ProvinceID, r.key())
+	cached, err := uc.cache.Get(ctx, cacheKey)
+	if err == nil && cached != nil {
+		var index dto.PriceIndexDTO
+		if err := json.Unmarshal(cached, &index); err != nil {
+			return nil, utils.NewInternalError("invalid data")
+		}
+		return &index, nil
+	}
+
+	cities, err := uc.loadCities(ctx, params, r)
+	if err != nil {
+		return nil, err
+	}
+	index := r.compute(cities, func(int64) bool { return true })
+	if index == nil {
+		return nil, utils.NewNotFoundError("no city has both a supply and a price in the base period")
+	}
+	index.CommodityID = params.CommodityID
+	if province != nil {
+		index.ProvinceID = &province.ID
+		index.Province = province.Name
+	}
+
+	indexJSON, err := json.Marshal(index)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	if err := uc.cache.Set(ctx, cacheKey, indexJSON, 4*time.Minute); err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return index, nil
+}
+
+// GetProvincePriceIndices returns the index of every province with a weighted price, from one nationwide query
+func (uc *PriceIndexUsecaseImpl) GetProvincePriceIndices(ctx context.Context, params *dto.PriceIndexParamsDTO) ([]*dto.PriceIndexDTO, error) {
+	params.ProvinceID = 0
+	r, err := uc.resolveRange(params)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.commodityRepo.FindByID(ctx, params.CommodityID); err != nil {
+		return nil, utils.NewNotFoundError("commodity not found")
+	}
+
+	cacheKey := fmt.Sprintf("price_index_provinces_%s_%s", params.CommodityID, r.key())
+	cached, err := uc.cache.Get(ctx, cacheKey)
+	if err == nil && cached != nil {
+		var indices []*dto.PriceIndexDTO
+		if err := json.Unmarshal(cached, &indices); err != nil {
+			return nil, utils.NewInternalError("invalid data")
+		}
+		return indices, nil
+	}
+
+	provinces, err := uc.provinceRepo.FindAll(ctx)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	cities, err := uc.loadCities(ctx, params, r)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := []*dto.PriceIndexDTO{}
+	for _, province := range provinces {
+		provinceID := province.ID
+		index := r.compute(cities, func(id int64) bool { return id == provinceID })
+		if index == nil {
+			continue
+		}
+		index.CommodityID = params.CommodityID
+		index.ProvinceID = &provinceID
+		index.Province = province.Name
+		indices = append(indices, index)
+	}
+
+	indicesJSON, err := json.Marshal(indices)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	if err := uc.cache.Set(ctx, cacheKey, indicesJSON, 4*time.Minute); err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return indices, nil
+}
+
+// resolveRange fills the defaults, a base period that is neither requested nor configured is the first period of the range
+func (uc *PriceIndexUsecaseImpl) resolveRange(params *dto.PriceIndexParamsDTO) (*priceIndexRange, error) {
+	if err := utils.ValidateStruct(params); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+
+	r := &priceIndexRange{
+		interval:  params.Interval,
+		start:     params.StartDate,
+		end:       params.EndDate,
+		baseStart: params.BaseStartDate,
+		baseEnd:   params.BaseEndDate,
+	}
+	if r.interval == "" {
+		r.interval = "month"
+	}
+	if r.end.IsZero() {
+		r.end = time.Now().UTC().Truncate(24 * time.Hour)
+	}
+	if r.start.IsZero() {
+		r.start = defaultSeriesStart(r.end, r.interval)
+	}
+	if r.start.After(r.end) {
+		return nil, utils.NewBadRequestError("start_date must not be after end_date")
+	}
+
+	if r.baseStart.IsZero() && r.baseEnd.IsZero() {
+		var err error
+		if r.baseStart, r.baseEnd, err = uc.configuredBasePeriod(); err != nil {
+			return nil, err
+		}
+	}
+	if r.baseStart.IsZero() {
+		r.baseStart = r.start
+	}
+	if r.baseEnd.IsZero() {
+		r.baseEnd = r.baseStart
+	}
+	if r.baseStart.After(r.baseEnd) {
+		return nil, utils.NewBadRequestError("base_start_date must not be after base_end_date")
+	}
+
+	first := r.start
+	if r.baseStart.Before(first) {
+		first = r.baseStart
+	}
+	last := r.end
+	if r.baseEnd.After(last) {
+		last = r.baseEnd
+	}
+	for period := truncatePeriod(first, r.interval); !period.After(last); period = nextPeriod(period, r.interval) {
+		if len(r.periods) == maxPriceIndexPeriods {
+			return nil, utils.NewBadRequestError(fmt.Sprintf("the range and the base period must not span more than %d periods", maxPriceIndexPeriods))
+		}
+		r.periods = append(r.periods, period)
+	}
+	return r, nil
+}
+
+func (uc *PriceIndexUsecaseImpl) configuredBasePeriod() (time.Time, time.Time, error) {
+	var start, end time.Time
+	var err error
+	if uc.env.PriceIndex.BaseStart != "" {
+		if start, err = time.Parse("2006-01-02", uc.env.PriceIndex.BaseStart); err != nil {
+			return start, end, utils.NewInternalError("PRICE_INDEX_BASE_START is not a YYYY-MM-DD date")
+		}
+	}
+	if uc.env.PriceIndex.BaseEnd != "" {
+		if end, err = time.Parse("2006-01-02", uc.env.PriceIndex.BaseEnd); err != nil {
+			return start, end, utils.NewInternalError("PRICE_INDEX_BASE_END is not a YYYY-MM-DD date")
+		}
+	}
+	return start, end, nil
+}
+
+// loadCities returns the cities that have a supply, with their price carried forward through every period
+func (uc *PriceIndexUsecaseImpl) loadCities(ctx context.Context, params *dto.PriceIndexParamsDTO, r *priceIndexRange) (map[int64]*priceIndexCity, error) {
+	weights, err := uc.supplyWeights(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	last := r.periods[len(r.periods)-1]
+	closes, err := uc.priceHistoryRepo.FindCityCloses(ctx, params.CommodityID, params.ProvinceID, r.interval, r.periods[0], nextPeriod(last, r.interval))
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	keys := make([]string, len(r.periods))
+	for i, period := range r.periods {
+		keys[i] = period.Format("2006-01-02")
+	}
+
+	cities := map[int64]*priceIndexCity{}
+	opening := map[int64]float64{}
+	for _, row := range closes {
+		weight := weights[row.CityID]
+		if weight <= 0 {
+			continue
+		}
+		city, ok := cities[row.CityID]
+		if !ok {
+			city = &priceIndexCity{provinceID: row.ProvinceID, weight: weight, prices: make([]float64, len(keys))}
+			for i := range city.prices {
+				city.prices[i] = math.NaN()
+			}
+			cities[row.CityID] = city
+		}
+
+		// The period of a row is the last one starting on or before it, rows before the first one are opening prices
+		i := sort.SearchStrings(keys, row.Period)
+		if i == len(keys) || keys[i] != row.Period {
+			i--
+		}
+		if i < 0 {
+			opening[row.CityID] = row.Close
+			continue
+		}
+		city.prices[i] = row.Close
+	}
+
+	for id, city := range cities {
+		price, ok := opening[id]
+		if !ok {
+			price = math.NaN()
+		}
+		for i := range city.prices {
+			if math.IsNaN(city.prices[i]) {
+				city.prices[i] = price
+			} else {
+				price = city.prices[i]
+			}
+		}
+	}
+	return cities, nil
+}
+
+// supplyWeights sums the supply of every city in kg, supplies recorded in an unknown or non mass unit are ignored
+func (uc *PriceIndexUsecaseImpl) supplyWeights(ctx context.Context, params *dto.PriceIndexParamsDTO) (map[int64]float64, error) {
+	supplies, err := uc.supplyRepo.FindByCommodityID(ctx, params.CommodityID)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	units, err := uc.unitRepo.FindAll(ctx)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	factors := massUnitFactors(units)
+
+	weights := map[int64]float64{}
+	for _, supply := range supplies {
+		if kg, ok := kilograms(factors, supply.Quantity, supply.Unit); ok && kg > 0 {
+			weights[supply.CityID] += kg
+		}
+	}
+	return weights, nil
+}
+
+// compute builds the index of the cities inScope accepts. Every city keeps its weight and base price, a period
+// only covers the cities that already had a price, so the index compares the same basket on both sides.
+// It returns nil when no city has both a weight and a price in the base period.
+func (r *priceIndexRange) compute(cities map[int64]*priceIndexCity, inScope func(provinceID int64) bool) *dto.PriceIndexDTO {
+	baseFrom := truncatePeriod(r.baseStart, r.interval)
+	from := truncatePeriod(r.start, r.interval)
+
+	type basket struct {
+		city *priceIndexCity
+		base float64
+	}
+	var baskets []basket
+	var weighted, weights float64
+	for _, city := range cities {
+		if !inScope(city.provinceID) {
+			continue
+		}
+		var sum float64
+		var n int
+		for i, period := range r.periods {
+			if period.Before(baseFrom) || period.After(r.baseEnd) || math.IsNaN(city.prices[i]) {
+				continue
+			}
+			sum += city.prices[i]
+			n++
+		}
+		if n == 0 {
+			continue
+		}
+		base := sum / float64(n)
+		baskets = append(baskets, basket{city, base})
+		weighted += city.weight * base
+		weights += city.weight
+	}
+	if len(baskets) == 0 {
+		return nil
+	}
+
+	index := &dto.PriceIndexDTO{
+		Interval:      r.interval,
+		StartDate:     r.start,
+		EndDate:       r.end,
+		BaseStartDate: r.baseStart,
+		BaseEndDate:   r.baseEnd,
+		BasePrice:     roundIndex(weighted / weights),
+		Points:        []*dto.PriceIndexPointDTO{},
+	}
+	for i, period := range r.periods {
+		if period.Before(from) || period.After(r.end) {
+			continue
+		}
+		point := &dto.PriceIndexPointDTO{Period: period}
+		var current, base, weight float64
+		for _, b := range baskets {
+			if math.IsNaN(b.city.prices[i]) {
+				continue
+			}
+			current += b.city.weight * b.city.prices[i]
+			base += b.city.weight * b.base
+			weight += b.city.weight
+			point.Cities++
+		}
+		if point.Cities > 0 {
+			value := roundIndex(100 * current / base)
+			price := roundIndex(current / weight)
+			point.Index = &value
+			point.Price = &price
+		}
+		index.Points = append(index.Points, point)
+	}
+	return index
+}
+
+func (r *priceIndexRange) key() string {
+	return fmt.Sprintf("%s_%s_%s_%s_%s",
+		r.interval,
+		r.start.Format("2006-01-02"),
+		r.end.Format("2006-01-02"),
+		r.baseStart.Format("2006-01-02"),
+		r.baseEnd.Format("2006-01-02"),
+	)
+}
+
+// truncatePeriod matches postgres date_trunc, weeks start on monday
+func truncatePeriod(t time.Time, interval string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch interval {
+	case "week":
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case "month":
+		return day.AddDate(0, 0, 1-day.Day())
+	default:
+		return day
+	}
+}
+
+func nextPeriod(t time.Time, interval string) time.Time {
+	switch interval {
+	case "week":
+		return t.AddDate(0, 0, 7)
+	case "month":
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
+
+func roundIndex(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,171 @@
+package usecase_implementation
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rabbitmq/amqp091-go"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/pkg/logrus"
+	"github.com/ryvasa/go-super-farmer/pkg/messages"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+const (
+	// every instance gets every price event, whichever instance made the change
+	priceEventExchange = "price-event-exchange"
+	// events kept for the clients resuming after a dropped connection
+	priceStreamRecentEvents = 1000
+	// a client that falls this far behind is dropped instead of holding up the others, it resumes from its last event
+	priceStreamClientBuffer = 64
+)
+
+type priceStreamClient struct {
+	params *dto.PriceStreamParamsDTO
+	events chan *dto.PriceEventDTO
+}
+
+func (c *priceStreamClient) matches(event *dto.PriceEventDTO) bool {
+	return (c.params.CommodityID == uuid.Nil || c.params.CommodityID == event.CommodityID) &&
+		(c.params.CityID == 0 || c.params.CityID == event.CityID)
+}
+
+type PriceStreamUsecaseImpl struct {
+	rabbitMQ   messages.RabbitMQ
+	mu         sync.Mutex
+	subscribed bool
+	recent     []*dto.PriceEventDTO
+	clients    map[*priceStreamClient]struct{}
+}
+
+func NewPriceStreamUsecase(rabbitMQ messages.RabbitMQ) usecase_interface.PriceStreamUsecase {
+	u := &PriceStreamUsecaseImpl{
+		rabbitMQ: rabbitMQ,
+		clients:  map[*priceStreamClient]struct{}{},
+	}
+	// Subscribing right away fills the recent events before the first client connects, Subscribe retries on failure
+	if err := u.subscribe(); err != nil {
+		logrus.Log.Error("failed to subscribe to price events: ", err)
+	}
+	return u
+}
+
+// subscribe starts consuming price-event-exchange unless it already does, the caller holds mu
+func (u *PriceStreamUsecaseImpl) subscribe() error {
+	if u.subscribed {
+		return nil
+	}
+	msgs, err := u.rabbitMQ.SubscribeFanout(priceEventExchange)
+	if err != nil {
+		return err
+	}
+	u.subscribed = true
+	go u.consume(msgs)
+	return nil
+}
+
+func (u *PriceStreamUsecaseImpl) consume(msgs <-chan amqp091.Delivery) {
+	for msg := range msgs {
+		var event dto.PriceEventDTO
+		if err := json.Unmarshal(msg.Body, &event); err != nil {
+			logrus.Log.Error("failed to decode price event: ", err)
+			continue
+		}
+		u.broadcast(&event)
+	}
+
+	// Events published until the next subscription are lost, so the recent events can no longer
+	// tell a resuming client it missed nothing. Its clients reconnect and get a reset instead
+	logrus.Log.Warn("price event subscription closed")
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.subscribed = false
+	u.recent = nil
+	for client := range u.clients {
+		u.drop(client)
+	}
+}
+
+func (u *PriceStreamUsecaseImpl) broadcast(event *dto.PriceEventDTO) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if len(u.recent) == priceStreamRecentEvents {
+		u.recent = u.recent[1:]
+	}
+	u.recent = append(u.recent, event)
+
+	for client := range u.clients {
+		if !client.matches(event) {
+			continue
+		}
+		select {
+		case client.events <- event:
+		default:
+			logrus.Log.Warn("price stream client fell behind, dropping it")
+			u.drop(client)
+		}
+	}
+}
+
+// drop closes the channel of a client that is still registered, the caller holds mu
+func (u *PriceStreamUsecaseImpl) drop(client *priceStreamClient) {
+	if _, ok := u.clients[client]; !ok {
+		return
+	}
+	delete(u.clients, client)
+	close(client.events)
+}
+
+func (u *PriceStreamUsecaseImpl) Subscribe(ctx context.Context, params *dto.PriceStreamParamsDTO) ([]*dto.PriceEventDTO, <-chan *dto.PriceEventDTO, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if err := u.subscribe(); err != nil {
+		return nil, nil, utils.NewInternalError(err.Error())
+	}
+
+	client := &priceStreamClient{params: params, events: make(chan *dto.PriceEventDTO, priceStreamClientBuffer)}
+	replay := u.replay(client)
+	u.clients[client] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		u.mu.Lock()
+		defer u.mu.Unlock()
+		u.drop(client)
+	}()
+
+	return replay, client.events, nil
+}
+
+// replay returns the events of the client after its LastEventID. When that event is no longer kept
+// the client gets a reset carrying the newest event ID, so it can resume from there after reloading
+func (u *PriceStreamUsecaseImpl) replay(client *priceStreamClient) []*dto.PriceEventDTO {
+	if client.params.LastEventID == "" {
+		return nil
+	}
+
+	for i := len(u.recent) - 1; i >= 0; i-- {
+		if u.recent[i].ID.String() != client.params.LastEventID {
+			continue
+		}
+		events := []*dto.PriceEventDTO{}
+		for _, event := range u.recent[i+1:] {
+			if client.matches(event) {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+
+	reset := &dto.PriceEventDTO{Type: dto.PriceEventReset, OccurredAt: time.Now()}
+	if len(u.recent) > 0 {
+		reset.ID = u.recent[len(u.recent)-1].ID
+	}
+	return []*dto.PriceEventDTO{reset}
+}
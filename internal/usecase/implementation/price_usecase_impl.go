@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -27,20 +30,59 @@ type PriceMessage struct {
 	EndDate     time.Time `json:"EndDate"`
 }
 
+// PriceAlertMessage is published on mail-exchange with the price-alert routing key,
+// ReferencePrice is the price the change is measured against
+type PriceAlertMessage struct {
+	To             string    `json:"to"`
+	Name           string    `json:"name"`
+	AlertID        uuid.UUID `json:"alert_id"`
+	Condition      string    `json:"condition"`
+	CommodityID    uuid.UUID `json:"commodity_id"`
+	Commodity      string    `json:"commodity"`
+	CityID         int64     `json:"city_id"`
+	City           string    `json:"city"`
+	TargetPrice    *float64  `json:"target_price,omitempty"`
+	ChangePercent  *float64  `json:"change_percent,omitempty"`
+	WindowDays     int       `json:"window_days,omitempty"`
+	ReferencePrice float64   `json:"reference_price"`
+	Price          float64   `json:"price"`
+}
+
+const (
+	// the same alert is not sent again for the same price within this window, even after its cooldown
+	priceAlertDedupWindow = 24 * time.Hour
+	// larger imports have to be split, the whole file runs in one transaction
+	priceImportMaxRows = 5000
+	// the reason of an imported price is the file name, the column holds 255 characters
+	priceImportMaxReason = 255
+	// a written price is compared with the recent prices of its city,
+	// a new price with too little history of its own with the prices of the other cities
+	priceAnomalyHistorySize = 30
+	priceAnomalyMinSamples  = 5
+	priceAnomalyMinCities   = 3
+	// modified z-score above which a price is held, 3.5 is the usual cut-off
+	priceAnomalyMaxScore = 3.5
+	// moves below this share of the median are never held, however stable the recent prices were
+	priceAnomalyMinChange = 0.2
+)
+
 type PriceUsecaseImpl struct {
-	priceRepo        repository_interface.PriceRepository
-	priceHistoryRepo repository_interface.PriceHistoryRepository
-	cityRepo         repository_interface.CityRepository
-	commodityRepo    repository_interface.CommodityRepository
-	rabbitMQ         messages.RabbitMQ
-	txManager        transaction.TransactionManager
-	cache            cache.Cache
-	globFunc         utils.GlobFunc
-	env              *env.Env
+	priceRepo         repository_interface.PriceRepository
+	priceHistoryRepo  repository_interface.PriceHistoryRepository
+	priceAlertRepo    repository_interface.PriceAlertRepository
+	priceReviewRepo   repository_interface.PriceReviewRepository
+	priceScheduleRepo repository_interface.PriceScheduleRepository
+	cityRepo          repository_interface.CityRepository
+	commodityRepo     repository_interface.CommodityRepository
+	rabbitMQ          messages.RabbitMQ
+	txManager         transaction.TransactionManager
+	cache             cache.Cache
+	globFunc          utils.GlobFunc
+	env               *env.Env
 }
 
-func NewPriceUsecase(priceRepo repository_interface.PriceRepository, priceHistoryRepo repository_interface.PriceHistoryRepository, cityRepo repository_interface.CityRepository, commodityRepo repository_interface.CommodityRepository, rabbitMQ messages.RabbitMQ, txManager transaction.TransactionManager, cache cache.Cache, globFunc utils.GlobFunc, env *env.Env) usecase_interface.PriceUsecase {
-	return &PriceUsecaseImpl{priceRepo, priceHistoryRepo, cityRepo, commodityRepo, rabbitMQ, txManager, cache, globFunc, env}
+func NewPriceUsecase(priceRepo repository_interface.PriceRepository, priceHistoryRepo repository_interface.PriceHistoryRepository, priceAlertRepo repository_interface.PriceAlertRepository, priceReviewRepo repository_interface.PriceReviewRepository, priceScheduleRepo repository_interface.PriceScheduleRepository, cityRepo repository_interface.CityRepository, commodityRepo repository_interface.CommodityRepository, rabbitMQ messages.RabbitMQ, txManager transaction.TransactionManager, cache cache.Cache, globFunc utils.GlobFunc, env *env.Env) usecase_interface.PriceUsecase {
+	return &PriceUsecaseImpl{priceRepo, priceHistoryRepo, priceAlertRepo, priceReviewRepo, priceScheduleRepo, cityRepo, commodityRepo, rabbitMQ, txManager, cache, globFunc, env}
 }
 
 func (u *PriceUsecaseImpl) CreatePrice(ctx context.Context, req *dto.PriceCreateDTO) (*domain.Price, error) {
@@ -57,12 +99,45 @@ func (u *PriceUsecaseImpl) CreatePrice(ctx context.Context, req *dto.PriceCreate
 		return nil, utils.NewNotFoundError("city not found")
 	}
 
+	source := priceChangeSource(req.Source)
+	review, err := u.checkPriceAnomaly(ctx, req.CommodityID, req.CityID, nil, req.Price)
+	if err != nil {
+		return nil, err
+	}
+	if review != nil {
+		review.ChangedBy = req.ChangedBy
+		review.Reason = req.Reason
+		review.Source = source
+		if !req.Force {
+			return nil, u.holdPriceReview(ctx, review)
+		}
+	}
+
 	price.CommodityID = req.CommodityID
 	price.CityID = req.CityID
 	price.Price = req.Price
+	price.ChangedBy = req.ChangedBy
+	price.Reason = req.Reason
+	price.Source = source
 	price.ID = uuid.New()
 
-	err := u.priceRepo.Create(ctx, &price)
+	// A forced price is written together with its review
+	create := func(ctx context.Context) error {
+		if err := u.priceRepo.Create(ctx, &price); err != nil {
+			return err
+		}
+		if review == nil {
+			return nil
+		}
+		review.PriceID = &price.ID
+		review.Status = domain.PriceReviewForced
+		return u.priceReviewRepo.Create(ctx, review)
+	}
+	if review == nil {
+		err = create(ctx)
+	} else {
+		err = u.txManager.WithTransaction(ctx, create)
+	}
 	if err != nil {
 		return nil, utils.NewInternalError(err.Error())
 	}
@@ -77,6 +152,8 @@ func (u *PriceUsecaseImpl) CreatePrice(ctx context.Context, req *dto.PriceCreate
 		return nil, utils.NewInternalError(err.Error())
 	}
 
+	u.publishPriceEvent(ctx, dto.PriceEventCreated, createdPrice, nil)
+
 	return createdPrice, nil
 }
 func (u *PriceUsecaseImpl) GetAllPrices(ctx context.Context, queryParams *dto.PaginationDTO) (*dto.PaginationResponseDTO, error) {
@@ -172,11 +249,13 @@ func (u *PriceUsecaseImpl) GetPricesByCityID(ctx context.Context, cityID int64)
 
 func (u *PriceUsecaseImpl) UpdatePrice(ctx context.Context, id uuid.UUID, req *dto.PriceUpdateDTO) (*domain.Price, error) {
 	price := domain.Price{}
+	previous := domain.Price{}
 
 	if err := utils.ValidateStruct(req); len(err) > 0 {
 		return nil, utils.NewValidationError(err)
 	}
 
+	var review *domain.PriceReview
 	err := u.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
 
 		logrus.Log.Info("starting price update transaction")
@@ -186,16 +265,30 @@ func (u *PriceUsecaseImpl) UpdatePrice(ctx context.Context, id uuid.UUID, req *d
 			logrus.Log.Error(err, "failed to find price")
 			return utils.NewNotFoundError(err.Error())
 		}
+		previous = *existingPrice
 
-		historyPrice := domain.PriceHistory{
-			ID:          uuid.New(),
-			CommodityID: existingPrice.CommodityID,
-			CityID:      existingPrice.CityID,
-			Price:       existingPrice.Price,
-			CreatedAt:   existingPrice.CreatedAt,
-			UpdatedAt:   existingPrice.UpdatedAt,
+		review, err = u.checkPriceAnomaly(txCtx, existingPrice.CommodityID, existingPrice.CityID, &existingPrice.Price, req.Price)
+		if err != nil {
+			return err
+		}
+		if review != nil {
+			review.PriceID = &id
+			review.ChangedBy = req.ChangedBy
+			review.Reason = req.Reason
+			review.Source = priceChangeSource(req.Source)
+			// A held change is recorded once the transaction is over, nothing is written here
+			if !req.Force {
+				return nil
+			}
+			review.Status = domain.PriceReviewForced
+			if err := u.priceReviewRepo.Create(txCtx, review); err != nil {
+				logrus.Log.Error(err, "failed to create price review")
+				return err
+			}
 		}
 
+		historyPrice := priceHistoryOf(existingPrice)
+
 		err = u.priceHistoryRepo.Create(txCtx, &historyPrice)
 		if err != nil {
 			logrus.Log.Error(err, "failed to create price history")
@@ -204,6 +297,9 @@ func (u *PriceUsecaseImpl) UpdatePrice(ctx context.Context, id uuid.UUID, req *d
 		logrus.Log.Info("price history created")
 
 		price.Price = req.Price
+		price.ChangedBy = req.ChangedBy
+		price.Reason = req.Reason
+		price.Source = priceChangeSource(req.Source)
 		price.ID = id
 
 		err = u.priceRepo.Update(txCtx, id, &price)
@@ -225,6 +321,9 @@ func (u *PriceUsecaseImpl) UpdatePrice(ctx context.Context, id uuid.UUID, req *d
 	if err != nil {
 		return nil, utils.NewInternalError(err.Error())
 	}
+	if review != nil && !req.Force {
+		return nil, u.holdPriceReview(ctx, review)
+	}
 
 	logrus.Log.Info("price update transaction completed")
 
@@ -233,11 +332,938 @@ func (u *PriceUsecaseImpl) UpdatePrice(ctx context.Context, id uuid.UUID, req *d
 		return nil, utils.NewInternalError(err.Error())
 	}
 
+	u.notifyPriceAlerts(ctx, &previous, &price)
+	u.publishPriceEvent(ctx, dto.PriceEventUpdated, &price, &previous.Price)
+
 	return &price, nil
 }
 
+// checkPriceAnomaly compares value with the median of the recent prices using the median absolute deviation,
+// which one earlier typo can not skew the way it skews a mean. It returns the review to record when value looks wrong
+func (u *PriceUsecaseImpl) checkPriceAnomaly(ctx context.Context, commodityID uuid.UUID, cityID int64, current *float64, value float64) (*domain.PriceReview, error) {
+	samples, err := u.priceHistoryRepo.FindRecentPrices(ctx, commodityID, cityID, priceAnomalyHistorySize)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	if current != nil {
+		samples = append(samples, *current)
+	}
+	if len(samples) < priceAnomalyMinSamples {
+		if current != nil {
+			return nil, nil
+		}
+		prices, err := u.priceRepo.FindByCommodityID(ctx, commodityID)
+		if err != nil {
+			return nil, utils.NewInternalError(err.Error())
+		}
+		samples = samples[:0]
+		for _, price := range prices {
+			if price.CityID != cityID {
+				samples = append(samples, price.Price)
+			}
+		}
+		if len(samples) < priceAnomalyMinCities {
+			return nil, nil
+		}
+	}
+
+	median := medianOf(samples)
+	if median <= 0 || math.Abs(value-median)/median <= priceAnomalyMinChange {
+		return nil, nil
+	}
+	deviations := make([]float64, len(samples))
+	for i, sample := range samples {
+		deviations[i] = math.Abs(sample - median)
+	}
+
+	review := &domain.PriceReview{
+		ID:            uuid.New(),
+		CommodityID:   commodityID,
+		CityID:        cityID,
+		PreviousPrice: current,
+		Price:         value,
+		Median:        median,
+		Samples:       len(samples),
+	}
+	// Without any spread every move past the minimum change is held
+	if mad := medianOf(deviations); mad > 0 {
+		score := 0.6745 * math.Abs(value-median) / mad
+		if score <= priceAnomalyMaxScore {
+			return nil, nil
+		}
+		score = math.Round(score*100) / 100
+		review.Score = &score
+	}
+	return review, nil
+}
+
+func medianOf(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// holdPriceReview records a change that waits for an admin, the returned error carries the review to the caller
+func (u *PriceUsecaseImpl) holdPriceReview(ctx context.Context, review *domain.PriceReview) error {
+	review.Status = domain.PriceReviewPending
+	if err := u.priceReviewRepo.Create(ctx, review); err != nil {
+		return utils.NewInternalError(err.Error())
+	}
+	return utils.NewPendingReviewError("the price is far from the recent prices and is held for review, send it with force to apply it", review)
+}
+
+func (u *PriceUsecaseImpl) GetPriceReviews(ctx context.Context, status string) ([]*domain.PriceReview, error) {
+	switch status {
+	case "", domain.PriceReviewPending, domain.PriceReviewForced, domain.PriceReviewApproved, domain.PriceReviewRejected:
+	default:
+		return nil, utils.NewBadRequestError("status must be pending, forced, approved or rejected")
+	}
+	reviews, err := u.priceReviewRepo.FindAll(ctx, status)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return reviews, nil
+}
+
+func (u *PriceUsecaseImpl) GetPriceReviewByID(ctx context.Context, id uuid.UUID) (*domain.PriceReview, error) {
+	review, err := u.priceReviewRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, utils.NewNotFoundError("price review not found")
+	}
+	return review, nil
+}
+
+// ApprovePriceReview applies a held change the way a price write would, a forced change is already applied and only marked
+func (u *PriceUsecaseImpl) ApprovePriceReview(ctx context.Context, id uuid.UUID, reviewerID uuid.UUID, req *dto.PriceReviewDecisionDTO) (*domain.PriceReview, error) {
+	review, err := u.decidePriceReview(ctx, id, reviewerID, req)
+	if err != nil {
+		return nil, err
+	}
+	held := review.Status == domain.PriceReviewPending
+	review.Status = domain.PriceReviewApproved
+	if !held {
+		if err := u.closePriceReview(ctx, review); err != nil {
+			return nil, err
+		}
+		return review, nil
+	}
+
+	var previous, created *domain.Price
+	err = u.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		existingPrice, err := u.priceRepo.FindByCommodityIDAndCityID(txCtx, review.CommodityID, review.CityID)
+		if err != nil {
+			// The price was deleted since, or the held change was the first price of the city
+			price := domain.Price{
+				ID:          uuid.New(),
+				CommodityID: review.CommodityID,
+				CityID:      review.CityID,
+				Price:       review.Price,
+				ChangedBy:   review.ChangedBy,
+				Reason:      review.Reason,
+				Source:      priceChangeSource(review.Source),
+			}
+			if err := u.priceRepo.Create(txCtx, &price); err != nil {
+				return err
+			}
+			created = &price
+			review.PriceID = &price.ID
+			return u.closePriceReview(txCtx, review)
+		}
+
+		previous = existingPrice
+		change := domain.Price{Price: review.Price, ChangedBy: review.ChangedBy, Reason: review.Reason, Source: priceChangeSource(review.Source)}
+		if err := u.applyPriceChange(txCtx, existingPrice, &change); err != nil {
+			return err
+		}
+		review.PriceID = &existingPrice.ID
+		return u.closePriceReview(txCtx, review)
+	})
+	if err != nil {
+		// A review decided by another request rolls the change back and is reported as it is
+		if appErr, ok := err.(utils.AppError); ok {
+			return nil, appErr
+		}
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	if err := u.cache.DeleteByPattern(ctx, "price"); err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	if previous != nil {
+		current := *previous
+		current.Price = review.Price
+		u.notifyPriceAlerts(ctx, previous, &current)
+		u.publishPriceEvent(ctx, dto.PriceEventUpdated, &current, &previous.Price)
+	}
+	if created != nil {
+		u.publishPriceEvent(ctx, dto.PriceEventCreated, created, nil)
+	}
+	return review, nil
+}
+
+// RejectPriceReview drops a held change, a forced change stays applied and has to be corrected with a new price
+func (u *PriceUsecaseImpl) RejectPriceReview(ctx context.Context, id uuid.UUID, reviewerID uuid.UUID, req *dto.PriceReviewDecisionDTO) (*domain.PriceReview, error) {
+	review, err := u.decidePriceReview(ctx, id, reviewerID, req)
+	if err != nil {
+		return nil, err
+	}
+	review.Status = domain.PriceReviewRejected
+	if err := u.closePriceReview(ctx, review); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// decidePriceReview loads a review that is still open and fills in who decided it, approved and rejected reviews are final
+func (u *PriceUsecaseImpl) decidePriceReview(ctx context.Context, id uuid.UUID, reviewerID uuid.UUID, req *dto.PriceReviewDecisionDTO) (*domain.PriceReview, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+	review, err := u.priceReviewRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, utils.NewNotFoundError("price review not found")
+	}
+	if review.Status != domain.PriceReviewPending && review.Status != domain.PriceReviewForced {
+		return nil, utils.NewConflictError("price review is already " + review.Status)
+	}
+
+	now := time.Now()
+	review.ReviewedBy = &reviewerID
+	review.ReviewedAt = &now
+	review.Note = req.Note
+	return review, nil
+}
+
+// closePriceReview writes the decision, only when the review is still open so two decisions never both apply
+func (u *PriceUsecaseImpl) closePriceReview(ctx context.Context, review *domain.PriceReview) error {
+	updated, err := u.priceReviewRepo.Update(ctx, review)
+	if err != nil {
+		return utils.NewInternalError(err.Error())
+	}
+	if !updated {
+		return utils.NewConflictError("price review was already decided")
+	}
+	return nil
+}
+
+// applyPriceChange moves the current price to the history and writes the price of change with who made it,
+// it runs inside the caller's transaction
+func (u *PriceUsecaseImpl) applyPriceChange(ctx context.Context, existingPrice *domain.Price, change *domain.Price) error {
+	historyPrice := priceHistoryOf(existingPrice)
+	if err := u.priceHistoryRepo.Create(ctx, &historyPrice); err != nil {
+		return err
+	}
+	change.ID = existingPrice.ID
+	return u.priceRepo.Update(ctx, existingPrice.ID, change)
+}
+
+// priceHistoryOf copies a price that is about to be replaced into its history row
+func priceHistoryOf(price *domain.Price) domain.PriceHistory {
+	return domain.PriceHistory{
+		ID:          uuid.New(),
+		CommodityID: price.CommodityID,
+		CityID:      price.CityID,
+		Price:       price.Price,
+		ChangedBy:   price.ChangedBy,
+		Reason:      price.Reason,
+		Source:      price.Source,
+		CreatedAt:   price.CreatedAt,
+		UpdatedAt:   price.UpdatedAt,
+	}
+}
+
+// priceChangeSource defaults the source of a change to manual
+func priceChangeSource(source string) string {
+	if source == "" {
+		return domain.PriceSourceManual
+	}
+	return source
+}
+
+// SchedulePriceUpdate keeps a change that takes effect at req.EffectiveAt, ApplyDuePriceSchedules applies it once it is due
+func (u *PriceUsecaseImpl) SchedulePriceUpdate(ctx context.Context, id uuid.UUID, userID uuid.UUID, req *dto.PriceUpdateDTO) (*domain.PriceSchedule, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+	if req.EffectiveAt == nil || !req.EffectiveAt.After(time.Now()) {
+		return nil, utils.NewBadRequestError("effective_at must be in the future")
+	}
+
+	price, err := u.priceRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, utils.NewNotFoundError("price not found")
+	}
+
+	schedule := domain.PriceSchedule{
+		ID:          uuid.New(),
+		PriceID:     id,
+		CommodityID: price.CommodityID,
+		CityID:      price.CityID,
+		Price:       req.Price,
+		EffectiveAt: *req.EffectiveAt,
+		Reason:      req.Reason,
+		Status:      domain.PriceSchedulePending,
+		CreatedBy:   &userID,
+	}
+	if err := u.priceScheduleRepo.Create(ctx, &schedule); err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return &schedule, nil
+}
+
+func (u *PriceUsecaseImpl) GetPriceSchedules(ctx context.Context, status string) ([]*domain.PriceSchedule, error) {
+	switch status {
+	case "", domain.PriceSchedulePending, domain.PriceScheduleApplied, domain.PriceScheduleCancelled, domain.PriceScheduleHeld, domain.PriceScheduleFailed:
+	default:
+		return nil, utils.NewBadRequestError("status must be pending, applied, cancelled, held or failed")
+	}
+	schedules, err := u.priceScheduleRepo.FindAll(ctx, status)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return schedules, nil
+}
+
+func (u *PriceUsecaseImpl) GetPriceScheduleByID(ctx context.Context, id uuid.UUID) (*domain.PriceSchedule, error) {
+	schedule, err := u.priceScheduleRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, utils.NewNotFoundError("price schedule not found")
+	}
+	return schedule, nil
+}
+
+func (u *PriceUsecaseImpl) CancelPriceSchedule(ctx context.Context, id uuid.UUID) (*domain.PriceSchedule, error) {
+	schedule, err := u.priceScheduleRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, utils.NewNotFoundError("price schedule not found")
+	}
+	if schedule.Status != domain.PriceSchedulePending {
+		return nil, utils.NewConflictError("price schedule is already " + schedule.Status)
+	}
+
+	now := time.Now()
+	schedule.Status = domain.PriceScheduleCancelled
+	schedule.CancelledAt = &now
+	updated, err := u.priceScheduleRepo.Update(ctx, schedule)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	// The scheduler handled it between the read and the update
+	if !updated {
+		return nil, utils.NewConflictError("price schedule is no longer " + domain.PriceSchedulePending)
+	}
+	return schedule, nil
+}
+
+// ApplyDuePriceSchedules applies the schedules that are due, one transaction each, and returns how many it applied.
+// A schedule whose price was deleted since is cancelled, one far from the recent prices is held as a pending review
+// and one that fails is marked failed so the schedules after it are still applied
+func (u *PriceUsecaseImpl) ApplyDuePriceSchedules(ctx context.Context) (int, error) {
+	applied := 0
+	var err error
+	for {
+		var schedule *domain.PriceSchedule
+		var previous *domain.Price
+		err = u.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
+			due, err := u.priceScheduleRepo.FindDue(txCtx, time.Now(), 1)
+			if err != nil {
+				return err
+			}
+			if len(due) == 0 {
+				return nil
+			}
+			schedule = due[0]
+
+			now := time.Now()
+			existingPrice, err := u.priceRepo.FindByID(txCtx, schedule.PriceID)
+			if err != nil {
+				logrus.Log.Warnf("price %s of schedule %s was not found, cancelling the schedule", schedule.PriceID, schedule.ID)
+				schedule.Status = domain.PriceScheduleCancelled
+				schedule.CancelledAt = &now
+				_, err := u.priceScheduleRepo.Update(txCtx, schedule)
+				return err
+			}
+
+			review, err := u.checkPriceAnomaly(txCtx, existingPrice.CommodityID, existingPrice.CityID, &existingPrice.Price, schedule.Price)
+			if err != nil {
+				return err
+			}
+			if review != nil {
+				// The change waits for an admin like a held update, approving the review applies it
+				logrus.Log.Warnf("price of schedule %s is far from the recent prices, holding it for review", schedule.ID)
+				review.PriceID = &existingPrice.ID
+				review.ChangedBy = schedule.CreatedBy
+				review.Reason = schedule.Reason
+				review.Source = domain.PriceSourceScheduler
+				review.Status = domain.PriceReviewPending
+				if err := u.priceReviewRepo.Create(txCtx, review); err != nil {
+					return err
+				}
+				schedule.Status = domain.PriceScheduleHeld
+				_, err := u.priceScheduleRepo.Update(txCtx, schedule)
+				return err
+			}
+
+			change := domain.Price{Price: schedule.Price, ChangedBy: schedule.CreatedBy, Reason: schedule.Reason, Source: domain.PriceSourceScheduler}
+			if err := u.applyPriceChange(txCtx, existingPrice, &change); err != nil {
+				return err
+			}
+			previous = existingPrice
+			schedule.Status = domain.PriceScheduleApplied
+			schedule.AppliedAt = &now
+			_, err = u.priceScheduleRepo.Update(txCtx, schedule)
+			return err
+		})
+		if err != nil && schedule != nil {
+			// The transaction was rolled back, the schedule is marked outside of it so the next one is not blocked
+			logrus.Log.Errorf("failed to apply price schedule %s: %v", schedule.ID, err)
+			schedule.Status = domain.PriceScheduleFailed
+			schedule.AppliedAt = nil
+			schedule.CancelledAt = nil
+			if _, err = u.priceScheduleRepo.Update(ctx, schedule); err != nil {
+				break
+			}
+			continue
+		}
+		if err != nil || schedule == nil {
+			break
+		}
+		if previous != nil {
+			applied++
+			current := *previous
+			current.Price = schedule.Price
+			u.notifyPriceAlerts(ctx, previous, &current)
+			u.publishPriceEvent(ctx, dto.PriceEventUpdated, &current, &previous.Price)
+		}
+	}
+
+	// The prices applied before a failure are committed, their cache has to go either way
+	if applied > 0 {
+		if cacheErr := u.cache.DeleteByPattern(ctx, "price"); cacheErr != nil && err == nil {
+			err = cacheErr
+		}
+	}
+	if err != nil {
+		return applied, utils.NewInternalError(err.Error())
+	}
+	return applied, nil
+}
+
+// notifyPriceAlerts runs once a price change is committed, failures are only logged so they never undo the update
+func (u *PriceUsecaseImpl) notifyPriceAlerts(ctx context.Context, previous, current *domain.Price) {
+	alerts, err := u.priceAlertRepo.FindActiveByCommodityIDAndCityID(ctx, current.CommodityID, current.CityID)
+	if err != nil {
+		logrus.Log.Error("failed to load price alerts: ", err)
+		return
+	}
+
+	now := time.Now()
+	// Change alerts sharing a window share the reference price
+	references := map[int]float64{}
+	for _, alert := range alerts {
+		reference := previous.Price
+		triggered := false
+		switch alert.Condition {
+		case domain.PriceAlertAbove:
+			triggered = alert.TargetPrice != nil && previous.Price < *alert.TargetPrice && current.Price >= *alert.TargetPrice
+		case domain.PriceAlertBelow:
+			triggered = alert.TargetPrice != nil && previous.Price > *alert.TargetPrice && current.Price <= *alert.TargetPrice
+		case domain.PriceAlertChange:
+			var ok bool
+			if reference, ok = references[alert.WindowDays]; !ok {
+				reference = u.priceAt(ctx, previous, now.AddDate(0, 0, -alert.WindowDays))
+				references[alert.WindowDays] = reference
+			}
+			triggered = alert.ChangePercent != nil && reference > 0 &&
+				math.Abs(current.Price-reference)/reference*100 >= *alert.ChangePercent
+		}
+		if !triggered {
+			continue
+		}
+
+		if err := u.sendPriceAlert(ctx, alert, reference, current, now); err != nil {
+			logrus.Log.Error("failed to send price alert ", alert.ID, ": ", err)
+		}
+	}
+}
+
+// publishPriceEvent runs once a price change is committed, failures are only logged like the alerts
+func (u *PriceUsecaseImpl) publishPriceEvent(ctx context.Context, eventType string, price *domain.Price, previous *float64) {
+	event := dto.PriceEventDTO{
+		ID:            uuid.New(),
+		Type:          eventType,
+		PriceID:       price.ID,
+		CommodityID:   price.CommodityID,
+		CityID:        price.CityID,
+		Price:         price.Price,
+		PreviousPrice: previous,
+		Unit:          price.Unit,
+		OccurredAt:    time.Now(),
+	}
+	if err := u.rabbitMQ.PublishJSON(ctx, priceEventExchange, "", event); err != nil {
+		logrus.Log.Error("failed to publish price event: ", err)
+	}
+}
+
+// priceAt returns the price in effect at t, when the history does not reach back that far the replaced price is used
+func (u *PriceUsecaseImpl) priceAt(ctx context.Context, previous *domain.Price, t time.Time) float64 {
+	if !previous.UpdatedAt.After(t) {
+		return previous.Price
+	}
+	history, err := u.priceHistoryRepo.FindLatestBefore(ctx, previous.CommodityID, previous.CityID, t)
+	if err != nil {
+		return previous.Price
+	}
+	return history.Price
+}
+
+func (u *PriceUsecaseImpl) sendPriceAlert(ctx context.Context, alert *domain.PriceAlert, reference float64, current *domain.Price, now time.Time) error {
+	// The owner was deleted, there is no one to send it to
+	if alert.User == nil || alert.User.Email == "" {
+		return nil
+	}
+	cooldown := time.Duration(alert.CooldownMinutes) * time.Minute
+	if alert.LastTriggeredAt != nil && now.Sub(*alert.LastTriggeredAt) < cooldown {
+		return nil
+	}
+
+	// Incr is atomic, so concurrent updates on other instances cannot both send
+	dedupKey := fmt.Sprintf("alert_dedup:%s:%s", alert.ID, strconv.FormatFloat(current.Price, 'f', -1, 64))
+	sent, err := u.cache.Incr(ctx, dedupKey, priceAlertDedupWindow)
+	if err != nil {
+		return err
+	}
+	if sent > 1 {
+		return nil
+	}
+	cooldownKey := fmt.Sprintf("alert_cooldown:%s", alert.ID)
+	sent, err = u.cache.Incr(ctx, cooldownKey, cooldown)
+	if err != nil {
+		return err
+	}
+	if sent > 1 {
+		return nil
+	}
+
+	msg := PriceAlertMessage{
+		To:             alert.User.Email,
+		Name:           alert.User.Name,
+		AlertID:        alert.ID,
+		Condition:      alert.Condition,
+		CommodityID:    current.CommodityID,
+		CityID:         current.CityID,
+		TargetPrice:    alert.TargetPrice,
+		ChangePercent:  alert.ChangePercent,
+		ReferencePrice: reference,
+		Price:          current.Price,
+	}
+	if alert.Condition == domain.PriceAlertChange {
+		msg.WindowDays = alert.WindowDays
+	}
+	if current.Commodity != nil {
+		msg.Commodity = current.Commodity.Name
+	}
+	if current.City != nil {
+		msg.City = current.City.Name
+	}
+	if err := u.rabbitMQ.PublishJSON(ctx, "mail-exchange", "price-alert", msg); err != nil {
+		// Release the claim so the next update of this price can send the alert
+		for _, key := range []string{dedupKey, cooldownKey} {
+			if delErr := u.cache.Delete(ctx, key); delErr != nil {
+				logrus.Log.Error("failed to release price alert key ", key, ": ", delErr)
+			}
+		}
+		return err
+	}
+
+	return u.priceAlertRepo.UpdateLastTriggered(ctx, alert.ID, now)
+}
+
+// ImportPrices upserts the current price of every row in one transaction, the replaced prices go to the history
+// like UpdatePrice does. The file is only written when every row is valid, a dry run reports without writing.
+func (u *PriceUsecaseImpl) ImportPrices(ctx context.Context, req *dto.PriceImportDTO) (*dto.PriceImportReportDTO, error) {
+	records, err := utils.ReadSpreadsheet(req.FileName, req.Content)
+	if err != nil {
+		return nil, utils.NewBadRequestError(err.Error())
+	}
+
+	rows, err := parsePriceImportRows(records)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &dto.PriceImportReportDTO{
+		DryRun:    req.DryRun,
+		TotalRows: len(rows),
+		ChangedBy: req.ChangedBy,
+		Reason:    priceImportReason(req.FileName),
+		Source:    domain.PriceSourceImport,
+	}
+	for _, row := range rows {
+		report.Rows = append(report.Rows, row.report)
+	}
+
+	if err := u.resolvePriceImportRows(ctx, rows); err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	for _, row := range rows {
+		if len(row.report.Errors) > 0 {
+			row.report.Status = dto.PriceImportInvalid
+			report.Invalid++
+		}
+	}
+
+	var updated []*priceImportRow
+	if req.DryRun || report.Invalid > 0 {
+		if _, err := u.applyPriceImport(ctx, req, rows, false); err != nil {
+			return nil, utils.NewInternalError(err.Error())
+		}
+	} else {
+		err = u.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
+			updated, err = u.applyPriceImport(txCtx, req, rows, true)
+			return err
+		})
+		if err != nil {
+			return nil, utils.NewInternalError(err.Error())
+		}
+		report.Committed = true
+
+		if err := u.cache.DeleteByPattern(ctx, "price"); err != nil {
+			return nil, utils.NewInternalError(err.Error())
+		}
+	}
+
+	for _, row := range rows {
+		switch row.report.Status {
+		case dto.PriceImportCreated:
+			report.Created++
+		case dto.PriceImportUpdated:
+			report.Updated++
+		case dto.PriceImportUnchanged:
+			report.Unchanged++
+		case dto.PriceImportHeld:
+			report.Held++
+		}
+	}
+
+	for _, row := range updated {
+		current := *row.previous
+		current.Price = row.price
+		current.UpdatedAt = time.Now()
+		current.Commodity = row.commodity
+		current.City = row.city
+		u.notifyPriceAlerts(ctx, row.previous, &current)
+		u.publishPriceEvent(ctx, dto.PriceEventUpdated, &current, &row.previous.Price)
+	}
+	for _, row := range rows {
+		if row.created != nil {
+			u.publishPriceEvent(ctx, dto.PriceEventCreated, row.created, nil)
+		}
+	}
+
+	return report, nil
+}
+
+var priceImportColumns = map[string]string{
+	"commodity_code": "commodity_code",
+	"commodity":      "commodity_code",
+	"code":           "commodity_code",
+	"city":           "city",
+	"city_name":      "city",
+	"price":          "price",
+}
+
+type priceImportRow struct {
+	report    *dto.PriceImportRowDTO
+	price     float64
+	commodity *domain.Commodity
+	city      *domain.City
+	previous  *domain.Price
+	created   *domain.Price
+}
+
+func (r *priceImportRow) valid() bool {
+	return len(r.report.Errors) == 0
+}
+
+// parsePriceImportRows reads the header from the first non empty line, blank lines are skipped
+func parsePriceImportRows(records [][]string) ([]*priceImportRow, error) {
+	header := -1
+	columns := map[string]int{}
+	var rows []*priceImportRow
+	for i, record := range records {
+		if isBlankRecord(record) {
+			continue
+		}
+		if header < 0 {
+			header = i
+			for j, name := range record {
+				name = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "_")
+				if column, ok := priceImportColumns[name]; ok {
+					if _, seen := columns[column]; !seen {
+						columns[column] = j
+					}
+				}
+			}
+			for _, column := range []string{"commodity_code", "city", "price"} {
+				if _, ok := columns[column]; !ok {
+					return nil, utils.NewBadRequestError(fmt.Sprintf("missing column %s", column))
+				}
+			}
+			continue
+		}
+
+		if len(rows) == priceImportMaxRows {
+			return nil, utils.NewBadRequestError(fmt.Sprintf("file has more than %d rows", priceImportMaxRows))
+		}
+
+		cell := func(column string) string {
+			if j := columns[column]; j < len(record) {
+				return strings.TrimSpace(record[j])
+			}
+			return ""
+		}
+		row := &priceImportRow{report: &dto.PriceImportRowDTO{
+			Row:           i + 1,
+			CommodityCode: cell("commodity_code"),
+			City:          cell("city"),
+			Price:         cell("price"),
+		}}
+		if row.report.CommodityCode == "" {
+			row.report.Errors = append(row.report.Errors, "commodity_code is required")
+		}
+		if row.report.City == "" {
+			row.report.Errors = append(row.report.Errors, "city is required")
+		}
+		if row.report.Price == "" {
+			row.report.Errors = append(row.report.Errors, "price is required")
+		} else if price, err := strconv.ParseFloat(row.report.Price, 64); err != nil || math.IsNaN(price) || math.IsInf(price, 0) {
+			row.report.Errors = append(row.report.Errors, "price must be a number")
+		} else if price < 1 {
+			row.report.Errors = append(row.report.Errors, "price must be at least 1")
+		} else {
+			row.price = price
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, utils.NewBadRequestError("file has no price rows")
+	}
+	return rows, nil
+}
+
+func isBlankRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// resolvePriceImportRows looks up commodities by code and cities by name, two rows for the same price are an error
+func (u *PriceUsecaseImpl) resolvePriceImportRows(ctx context.Context, rows []*priceImportRow) error {
+	codes := []string{}
+	names := []string{}
+	seenCodes := map[string]bool{}
+	seenNames := map[string]bool{}
+	for _, row := range rows {
+		if code := row.report.CommodityCode; code != "" && !seenCodes[code] {
+			seenCodes[code] = true
+			codes = append(codes, code)
+		}
+		if name := strings.ToLower(row.report.City); name != "" && !seenNames[name] {
+			seenNames[name] = true
+			names = append(names, name)
+		}
+	}
+
+	commodities := map[string]*domain.Commodity{}
+	if len(codes) > 0 {
+		found, err := u.commodityRepo.FindByCodes(ctx, codes)
+		if err != nil {
+			return err
+		}
+		for _, commodity := range found {
+			commodities[commodity.Code] = commodity
+		}
+	}
+	cities := map[string][]*domain.City{}
+	if len(names) > 0 {
+		found, err := u.cityRepo.FindByNames(ctx, names)
+		if err != nil {
+			return err
+		}
+		for _, city := range found {
+			name := strings.ToLower(city.Name)
+			cities[name] = append(cities[name], city)
+		}
+	}
+
+	firstRow := map[string]int{}
+	for _, row := range rows {
+		if row.report.CommodityCode != "" {
+			if row.commodity = commodities[row.report.CommodityCode]; row.commodity == nil {
+				row.report.Errors = append(row.report.Errors, "commodity not found")
+			}
+		}
+		if row.report.City != "" {
+			switch matches := cities[strings.ToLower(row.report.City)]; len(matches) {
+			case 0:
+				row.report.Errors = append(row.report.Errors, "city not found")
+			case 1:
+				row.city = matches[0]
+			default:
+				row.report.Errors = append(row.report.Errors, "city name matches several cities")
+			}
+		}
+		if row.commodity == nil || row.city == nil {
+			continue
+		}
+
+		key := fmt.Sprintf("%s_%d", row.commodity.ID, row.city.ID)
+		if first, ok := firstRow[key]; ok {
+			row.report.Errors = append(row.report.Errors, fmt.Sprintf("duplicate of row %d", first))
+			continue
+		}
+		firstRow[key] = row.report.Row
+	}
+	return nil
+}
+
+// holdPriceImportRow checks the price of a row like a price write, a row far from the recent prices is not written
+// but recorded as a pending review when write is true. It reports whether the row was held
+func (u *PriceUsecaseImpl) holdPriceImportRow(ctx context.Context, req *dto.PriceImportDTO, row *priceImportRow, reason string, write bool) (bool, error) {
+	review, err := u.checkPriceAnomaly(ctx, row.commodity.ID, row.city.ID, row.report.PreviousPrice, row.price)
+	if err != nil {
+		return false, err
+	}
+	if review == nil {
+		return false, nil
+	}
+	row.report.Status = dto.PriceImportHeld
+	if !write {
+		return true, nil
+	}
+
+	review.PriceID = row.report.PriceID
+	review.ChangedBy = req.ChangedBy
+	review.Reason = reason
+	review.Source = domain.PriceSourceImport
+	review.Status = domain.PriceReviewPending
+	if err := u.priceReviewRepo.Create(ctx, review); err != nil {
+		return false, err
+	}
+	row.report.ReviewID = &review.ID
+	return true, nil
+}
+
+// priceImportReason names the file in the reason, cut to the 255 characters the column holds
+func priceImportReason(fileName string) string {
+	reason := []rune("imported from " + fileName)
+	if len(reason) > priceImportMaxReason {
+		reason = reason[:priceImportMaxReason]
+	}
+	return string(reason)
+}
+
+// applyPriceImport sets the status of every valid row and writes them when write is true, a row far from the recent
+// prices is held for review instead. It returns the rows whose price changed
+func (u *PriceUsecaseImpl) applyPriceImport(ctx context.Context, req *dto.PriceImportDTO, rows []*priceImportRow, write bool) ([]*priceImportRow, error) {
+	commodityIDs := []uuid.UUID{}
+	cityIDs := []int64{}
+	for _, row := range rows {
+		if row.valid() {
+			commodityIDs = append(commodityIDs, row.commodity.ID)
+			cityIDs = append(cityIDs, row.city.ID)
+		}
+	}
+	if len(commodityIDs) == 0 {
+		return nil, nil
+	}
+
+	found, err := u.priceRepo.FindByCommodityIDsAndCityIDs(ctx, commodityIDs, cityIDs)
+	if err != nil {
+		return nil, err
+	}
+	existing := map[string]*domain.Price{}
+	for _, price := range found {
+		existing[fmt.Sprintf("%s_%d", price.CommodityID, price.CityID)] = price
+	}
+
+	reason := priceImportReason(req.FileName)
+	var updated []*priceImportRow
+	for _, row := range rows {
+		if !row.valid() {
+			continue
+		}
+
+		previous, ok := existing[fmt.Sprintf("%s_%d", row.commodity.ID, row.city.ID)]
+		if ok {
+			row.report.PriceID = &previous.ID
+			row.report.PreviousPrice = &previous.Price
+			if previous.Price == row.price {
+				row.report.Status = dto.PriceImportUnchanged
+				continue
+			}
+		}
+
+		held, err := u.holdPriceImportRow(ctx, req, row, reason, write)
+		if err != nil {
+			return nil, err
+		}
+		if held {
+			continue
+		}
+
+		if !ok {
+			row.report.Status = dto.PriceImportCreated
+			if !write {
+				continue
+			}
+			price := domain.Price{
+				ID:          uuid.New(),
+				CommodityID: row.commodity.ID,
+				CityID:      row.city.ID,
+				Price:       row.price,
+				ChangedBy:   req.ChangedBy,
+				Reason:      reason,
+				Source:      domain.PriceSourceImport,
+			}
+			if err := u.priceRepo.Create(ctx, &price); err != nil {
+				return nil, err
+			}
+			row.report.PriceID = &price.ID
+			row.created = &price
+			continue
+		}
+
+		row.report.Status = dto.PriceImportUpdated
+		if !write {
+			continue
+		}
+
+		history := priceHistoryOf(previous)
+		if err := u.priceHistoryRepo.Create(ctx, &history); err != nil {
+			return nil, err
+		}
+		change := domain.Price{ID: previous.ID, Price: row.price, ChangedBy: req.ChangedBy, Reason: reason, Source: domain.PriceSourceImport}
+		if err := u.priceRepo.Update(ctx, previous.ID, &change); err != nil {
+			return nil, err
+		}
+		row.previous = previous
+		updated = append(updated, row)
+	}
+	return updated, nil
+}
+
 func (u *PriceUsecaseImpl) DeletePrice(ctx context.Context, id uuid.UUID) error {
-	_, err := u.priceRepo.FindByID(ctx, id)
+	price, err := u.priceRepo.FindByID(ctx, id)
 	if err != nil {
 		return utils.NewNotFoundError(err.Error())
 	}
@@ -251,6 +1277,8 @@ func (u *PriceUsecaseImpl) DeletePrice(ctx context.Context, id uuid.UUID) error
 		return utils.NewInternalError(err.Error())
 	}
 
+	u.publishPriceEvent(ctx, dto.PriceEventDeleted, price, nil)
+
 	return nil
 }
 
@@ -273,6 +1301,9 @@ func (u *PriceUsecaseImpl) RestorePrice(ctx context.Context, id uuid.UUID) (*dom
 		return nil, utils.NewInternalError(err.Error())
 	}
 
+	// To a client the restored price is new again
+	u.publishPriceEvent(ctx, dto.PriceEventCreated, restoredPrice, nil)
+
 	return restoredPrice, nil
 }
 
@@ -311,6 +1342,9 @@ func (u *PriceUsecaseImpl) GetPriceHistoryByCommodityIDAndCityID(ctx context.Con
 		Commodity:   currentPrice.Commodity,
 		City:        currentPrice.City,
 		Price:       currentPrice.Price,
+		ChangedBy:   currentPrice.ChangedBy,
+		Reason:      currentPrice.Reason,
+		Source:      currentPrice.Source,
 		CreatedAt:   currentPrice.CreatedAt,
 		UpdatedAt:   currentPrice.UpdatedAt,
 		DeletedAt:   currentPrice.DeletedAt,
@@ -328,6 +1362,81 @@ func (u *PriceUsecaseImpl) GetPriceHistoryByCommodityIDAndCityID(ctx context.Con
 	return newHistoryPrices, nil
 }
 
+// GetPriceHistorySeries aggregates the price history into chart buckets, without dates it covers a default window ending today
+func (u *PriceUsecaseImpl) GetPriceHistorySeries(ctx context.Context, params *dto.PriceHistorySeriesParamsDTO) (*dto.PriceHistorySeriesDTO, error) {
+	if err := utils.ValidateStruct(params); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+
+	interval := params.Interval
+	if interval == "" {
+		interval = "day"
+	}
+	endDate := params.EndDate
+	if endDate.IsZero() {
+		endDate = time.Now().UTC().Truncate(24 * time.Hour)
+	}
+	startDate := params.StartDate
+	if startDate.IsZero() {
+		startDate = defaultSeriesStart(endDate, interval)
+	}
+	if startDate.After(endDate) {
+		return nil, utils.NewBadRequestError("start_date must not be after end_date")
+	}
+
+	cacheKey := fmt.Sprintf("price_series_%s_%d_%s_%s_%s",
+		params.CommodityID,
+		params.CityID,
+		interval,
+		startDate.Format("2006-01-02"),
+		endDate.Format("2006-01-02"),
+	)
+	cached, err := u.cache.Get(ctx, cacheKey)
+	if err == nil && cached != nil {
+		var series dto.PriceHistorySeriesDTO
+		if err := json.Unmarshal(cached, &series); err != nil {
+			return nil, utils.NewInternalError("invalid data")
+		}
+		return &series, nil
+	}
+
+	// end_date is inclusive, the query bound is not
+	buckets, err := u.priceHistoryRepo.FindBuckets(ctx, params.CommodityID, params.CityID, interval, startDate, endDate.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	series := &dto.PriceHistorySeriesDTO{
+		CommodityID: params.CommodityID,
+		CityID:      params.CityID,
+		Interval:    interval,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Buckets:     buckets,
+	}
+
+	seriesJSON, err := json.Marshal(series)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	err = u.cache.Set(ctx, cacheKey, seriesJSON, 4*time.Minute)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return series, nil
+}
+
+func defaultSeriesStart(end time.Time, interval string) time.Time {
+	switch interval {
+	case "week":
+		return end.AddDate(0, -3, 0)
+	case "month":
+		return end.AddDate(-1, 0, 0)
+	default:
+		return end.AddDate(0, -1, 0)
+	}
+}
+
 // TODO: change to gRPC
 // Todo: implement downloading price history from MinIO
 func (u *PriceUsecaseImpl) DownloadPriceHistoryByCommodityIDAndCityID(ctx context.Context, params *dto.PriceParamsDTO) (*dto.DownloadResponseDTO, error) {
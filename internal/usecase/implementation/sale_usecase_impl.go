@@ -36,7 +36,8 @@ func NewSaleUsecase(
 	}
 }
 
-func (uc *SaleUsecaseImpl) CreateSale(ctx context.Context, req *dto.SaleCreateDTO) (*domain.Sale, error) {
+// CreateSale records the sale, buyers are linked to the sales they record
+func (uc *SaleUsecaseImpl) CreateSale(ctx context.Context, userID uuid.UUID, role string, req *dto.SaleCreateDTO) (*domain.Sale, error) {
 	sale := domain.Sale{}
 	if err := utils.ValidateStruct(req); len(err) > 0 {
 		return nil, utils.NewValidationError(err)
@@ -62,6 +63,9 @@ func (uc *SaleUsecaseImpl) CreateSale(ctx context.Context, req *dto.SaleCreateDT
 	sale.Price = req.Price
 	sale.SaleDate = parseDate
 	sale.ID = uuid.New()
+	if role == domain.RoleBuyer {
+		sale.BuyerID = &userID
+	}
 
 	err = uc.saleRepo.Create(ctx, &sale)
 	if err != nil {
@@ -163,6 +167,33 @@ func (uc *SaleUsecaseImpl) GetSalesByCityID(ctx context.Context, params *dto.Pag
 	return response, nil
 }
 
+func (uc *SaleUsecaseImpl) GetSalesByBuyerID(ctx context.Context, params *dto.PaginationDTO, buyerID uuid.UUID) (*dto.PaginationResponseDTO, error) {
+	if err := params.Validate(); err != nil {
+		return nil, utils.NewBadRequestError(err.Error())
+	}
+
+	params.Filter.BuyerID = &buyerID
+
+	sales, err := uc.saleRepo.FindAll(ctx, params)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	count, err := uc.saleRepo.Count(ctx, &params.Filter)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	response := &dto.PaginationResponseDTO{
+		TotalRows:  count,
+		TotalPages: int(math.Ceil(float64(count) / float64(params.Limit))),
+		Page:       params.Page,
+		Limit:      params.Limit,
+		Data:       sales,
+	}
+	return response, nil
+}
+
 func (uc *SaleUsecaseImpl) UpdateSale(ctx context.Context, id uuid.UUID, req *dto.SaleUpdateDTO) (*domain.Sale, error) {
 	sale := &domain.Sale{}
 	if err := utils.ValidateStruct(req); len(err) > 0 {
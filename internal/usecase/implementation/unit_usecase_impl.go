@@ -0,0 +1,75 @@
+package usecase_implementation
+
+import (
+	"context"
+
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+)
+
+type UnitUsecaseImpl struct {
+	unitRepo repository_interface.UnitRepository
+}
+
+func NewUnitUsecase(unitRepo repository_interface.UnitRepository) usecase_interface.UnitUsecase {
+	return &UnitUsecaseImpl{unitRepo}
+}
+
+func (uc *UnitUsecaseImpl) GetUnits(ctx context.Context) ([]*domain.Unit, error) {
+	units, err := uc.unitRepo.FindAll(ctx)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return units, nil
+}
+
+func (uc *UnitUsecaseImpl) CreateUnit(ctx context.Context, req *dto.UnitCreateDTO) (*domain.Unit, error) {
+	req.Code = normalizeUnitCode(req.Code)
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+
+	if _, err := uc.unitRepo.FindByCode(ctx, req.Code); err == nil {
+		return nil, utils.NewConflictError("unit already exists")
+	}
+
+	unit := &domain.Unit{
+		Code:      req.Code,
+		Name:      req.Name,
+		Dimension: req.Dimension,
+		Factor:    req.Factor,
+	}
+	if err := uc.unitRepo.Create(ctx, unit); err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	return unit, nil
+}
+
+func (uc *UnitUsecaseImpl) UpdateUnit(ctx context.Context, code string, req *dto.UnitUpdateDTO) (*domain.Unit, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+
+	code = normalizeUnitCode(code)
+	unit, err := uc.unitRepo.FindByCode(ctx, code)
+	if err != nil {
+		return nil, utils.NewNotFoundError("unit not found")
+	}
+	if req.Factor != 0 && req.Factor != 1 && isBaseUnit(code) {
+		return nil, utils.NewBadRequestError("the factor of a base unit is always 1")
+	}
+
+	if err := uc.unitRepo.Update(ctx, code, &domain.Unit{Name: req.Name, Factor: req.Factor}); err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	if req.Name != "" {
+		unit.Name = req.Name
+	}
+	if req.Factor != 0 {
+		unit.Factor = req.Factor
+	}
+	return unit, nil
+}
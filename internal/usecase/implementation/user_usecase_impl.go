@@ -12,19 +12,34 @@ import (
 	"github.com/ryvasa/go-super-farmer/internal/model/dto"
 	repository_interface "github.com/ryvasa/go-super-farmer/internal/repository/interface"
 	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/pkg/auth/token"
 	"github.com/ryvasa/go-super-farmer/pkg/database/cache"
 	"github.com/ryvasa/go-super-farmer/pkg/logrus"
+	"github.com/ryvasa/go-super-farmer/pkg/messages"
 	"github.com/ryvasa/go-super-farmer/utils"
 )
 
+const (
+	emailChangeTTL         = 15 * time.Minute
+	maxEmailChangeAttempts = 5
+)
+
 type UserUsecaseImpl struct {
-	repo  repository_interface.UserRepository
-	hash  utils.Hasher
-	cache cache.Cache
+	repo     repository_interface.UserRepository
+	hash     utils.Hasher
+	cache    cache.Cache
+	otp      utils.OTP
+	rabbitMQ messages.RabbitMQ
 }
 
-func NewUserUsecase(repo repository_interface.UserRepository, hash utils.Hasher, cache cache.Cache) usecase_interface.UserUsecase {
-	return &UserUsecaseImpl{repo, hash, cache}
+// pendingEmailChange is kept in redis until the owner of the new address confirms the otp
+type pendingEmailChange struct {
+	Email string `json:"email"`
+	OTP   string `json:"otp"`
+}
+
+func NewUserUsecase(repo repository_interface.UserRepository, hash utils.Hasher, cache cache.Cache, otp utils.OTP, rabbitMQ messages.RabbitMQ) usecase_interface.UserUsecase {
+	return &UserUsecaseImpl{repo, hash, cache, otp, rabbitMQ}
 }
 
 func (uc *UserUsecaseImpl) Register(ctx context.Context, req *dto.UserCreateDTO) (*dto.UserResponseDTO, error) {
@@ -162,7 +177,7 @@ func (uc *UserUsecaseImpl) UpdateUser(ctx context.Context, id uuid.UUID, authRol
 	logrus.Log.Info(authRole)
 
 	if req.RoleID != 0 {
-		if authRole != "Admin" {
+		if authRole != domain.RoleAdmin {
 			logrus.Log.Info("Forbidden")
 			return nil, utils.NewForbiddenError("forbidden")
 		}
@@ -237,3 +252,198 @@ func (uc *UserUsecaseImpl) RestoreUser(ctx context.Context, id uuid.UUID) (*dto.
 
 	return utils.UserDtoFormat(restoredUser), err
 }
+
+func (uc *UserUsecaseImpl) UpdateProfile(ctx context.Context, id uuid.UUID, req *dto.UserProfileUpdateDTO) (*dto.UserResponseDTO, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+	_, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, utils.NewNotFoundError(err.Error())
+	}
+
+	user := domain.User{Name: req.Name}
+	if req.Phone != "" {
+		user.Phone = &req.Phone
+	}
+	err = uc.repo.Update(ctx, id, &user)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	updatedUser, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	err = uc.cache.DeleteByPattern(ctx, "user")
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	return utils.UserDtoFormat(updatedUser), nil
+}
+
+func (uc *UserUsecaseImpl) ChangePassword(ctx context.Context, id uuid.UUID, req *dto.UserChangePasswordDTO) error {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return utils.NewValidationError(err)
+	}
+	user, err := uc.repo.FindAuthByID(ctx, id)
+	if err != nil {
+		return utils.NewNotFoundError(err.Error())
+	}
+	if !uc.hash.ValidatePassword(req.CurrentPassword, user.Password) {
+		return utils.NewUnauthorizedError("current password is incorrect")
+	}
+
+	hashedPassword, err := uc.hash.HashPassword(req.NewPassword)
+	if err != nil {
+		return utils.NewInternalError(err.Error())
+	}
+	err = uc.repo.Update(ctx, id, &domain.User{Password: hashedPassword})
+	if err != nil {
+		return utils.NewInternalError(err.Error())
+	}
+	// Sessions opened with the old password end here, the current one included
+	err = token.RevokeUser(ctx, uc.cache, id.String())
+	if err != nil {
+		return utils.NewInternalError(err.Error())
+	}
+	return nil
+}
+
+// RequestEmailChange sends an otp to the new address, the email is only replaced once it is confirmed
+func (uc *UserUsecaseImpl) RequestEmailChange(ctx context.Context, id uuid.UUID, req *dto.UserEmailChangeDTO) error {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return utils.NewValidationError(err)
+	}
+	user, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return utils.NewNotFoundError(err.Error())
+	}
+	if user.Email == req.Email {
+		return utils.NewBadRequestError("new email is the same as the current one")
+	}
+	if existUser, err := uc.repo.FindByEmail(ctx, req.Email); err == nil && existUser != nil {
+		return utils.NewConflictError("email already exists")
+	}
+
+	otp, err := uc.otp.GenerateOTP(6)
+	if err != nil {
+		return utils.NewInternalError("Failed to generate OTP")
+	}
+	pending, err := json.Marshal(pendingEmailChange{Email: req.Email, OTP: otp})
+	if err != nil {
+		return utils.NewInternalError(err.Error())
+	}
+	err = uc.cache.Set(ctx, emailChangeKey(id), pending, emailChangeTTL)
+	if err != nil {
+		return utils.NewInternalError("Failed to store OTP")
+	}
+	// A new code gets a fresh set of attempts
+	err = uc.cache.Delete(ctx, emailChangeAttemptsKey(id))
+	if err != nil {
+		return utils.NewInternalError(err.Error())
+	}
+
+	msg := struct {
+		To  string `json:"to"`
+		OTP string `json:"otp"`
+	}{
+		To:  req.Email,
+		OTP: otp,
+	}
+	err = uc.rabbitMQ.PublishJSON(ctx, "mail-exchange", "change-email", msg)
+	if err != nil {
+		return utils.NewInternalError(err.Error())
+	}
+	return nil
+}
+
+func (uc *UserUsecaseImpl) ConfirmEmailChange(ctx context.Context, id uuid.UUID, req *dto.UserEmailConfirmDTO) (*dto.UserResponseDTO, error) {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return nil, utils.NewValidationError(err)
+	}
+	cached, err := uc.cache.Get(ctx, emailChangeKey(id))
+	if err != nil || cached == nil {
+		return nil, utils.NewBadRequestError("no pending email change")
+	}
+	var pending pendingEmailChange
+	if err := json.Unmarshal(cached, &pending); err != nil {
+		return nil, utils.NewInternalError("invalid data")
+	}
+
+	attempts, err := uc.cache.Incr(ctx, emailChangeAttemptsKey(id), emailChangeTTL)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	if attempts > maxEmailChangeAttempts {
+		return nil, utils.NewTooManyRequestsError("too many attempts, request a new code")
+	}
+	if pending.OTP != req.OTP {
+		return nil, utils.NewBadRequestError("invalid OTP")
+	}
+
+	// The address may have been taken while the code was pending
+	if existUser, err := uc.repo.FindByEmail(ctx, pending.Email); err == nil && existUser != nil {
+		return nil, utils.NewConflictError("email already exists")
+	}
+	err = uc.repo.Update(ctx, id, &domain.User{Email: pending.Email, Verified: true})
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	if err := uc.cache.Delete(ctx, emailChangeKey(id)); err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	if err := uc.cache.Delete(ctx, emailChangeAttemptsKey(id)); err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	updatedUser, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+	err = uc.cache.DeleteByPattern(ctx, "user")
+	if err != nil {
+		return nil, utils.NewInternalError(err.Error())
+	}
+
+	return utils.UserDtoFormat(updatedUser), nil
+}
+
+// DeleteAccount anonymizes the caller, pauses their price alerts and signs out every session,
+// their lands and harvests are kept for statistics
+func (uc *UserUsecaseImpl) DeleteAccount(ctx context.Context, id uuid.UUID, req *dto.UserDeleteAccountDTO) error {
+	if err := utils.ValidateStruct(req); len(err) > 0 {
+		return utils.NewValidationError(err)
+	}
+	user, err := uc.repo.FindAuthByID(ctx, id)
+	if err != nil {
+		return utils.NewNotFoundError(err.Error())
+	}
+	if !uc.hash.ValidatePassword(req.Password, user.Password) {
+		return utils.NewUnauthorizedError("password is incorrect")
+	}
+
+	err = uc.repo.Anonymize(ctx, id)
+	if err != nil {
+		return utils.NewInternalError(err.Error())
+	}
+
+	err = token.RevokeUser(ctx, uc.cache, id.String())
+	if err != nil {
+		return utils.NewInternalError(err.Error())
+	}
+	err = uc.cache.DeleteByPattern(ctx, "user")
+	if err != nil {
+		return utils.NewInternalError(err.Error())
+	}
+	return nil
+}
+
+func emailChangeKey(id uuid.UUID) string {
+	return fmt.Sprintf("email_change:%s", id)
+}
+
+func emailChangeAttemptsKey(id uuid.UUID) string {
+	return fmt.Sprintf("email_change_attempts:%s", id)
+}
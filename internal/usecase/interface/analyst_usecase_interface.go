@@ -0,0 +1,13 @@
+package usecase_interface
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+type AnalystUsecase interface {
+	GetMarketOverview(ctx context.Context, commodityID uuid.UUID) (*dto.MarketOverviewDTO, error)
+	GetPriceComparison(ctx context.Context, commodityID uuid.UUID) (*dto.PriceComparisonDTO, error)
+}
@@ -0,0 +1,16 @@
+package usecase_interface
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+type APIKeyUsecase interface {
+	CreateAPIKey(ctx context.Context, ownerID uuid.UUID, req *dto.APIKeyCreateDTO) (*dto.APIKeyResponseDTO, error)
+	GetAPIKeys(ctx context.Context, ownerID uuid.UUID) ([]*dto.APIKeyResponseDTO, error)
+	RevokeAPIKey(ctx context.Context, ownerID, id uuid.UUID) error
+	Authenticate(ctx context.Context, key string) (*domain.APIKey, error)
+}
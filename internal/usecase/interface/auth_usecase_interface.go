@@ -3,6 +3,8 @@ package usecase_interface
 import (
 	"context"
 
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/ryvasa/go-super-farmer/internal/model/dto"
 )
 
@@ -10,4 +12,13 @@ type AuthUsecase interface {
 	Login(ctx context.Context, req *dto.AuthDTO) (*dto.AuthResponseDTO, error)
 	SendOTP(ctx context.Context, req *dto.AuthSendDTO) error
 	VerifyOTP(ctx context.Context, req *dto.AuthVerifyDTO) error
+	RefreshToken(ctx context.Context, req *dto.AuthRefreshDTO) (*dto.AuthResponseDTO, error)
+	Logout(ctx context.Context, claims jwt.MapClaims, req *dto.AuthLogoutDTO) error
+	UnlockAccount(ctx context.Context, req *dto.AuthUnlockDTO) error
+	ForgotPassword(ctx context.Context, req *dto.AuthForgotPasswordDTO) error
+	ResetPassword(ctx context.Context, req *dto.AuthResetPasswordDTO) error
+	VerifyMFA(ctx context.Context, req *dto.AuthMFAVerifyDTO) (*dto.AuthResponseDTO, error)
+	SetupTOTP(ctx context.Context, userID uuid.UUID) (*dto.AuthTOTPSetupResponseDTO, error)
+	EnableTOTP(ctx context.Context, userID uuid.UUID, req *dto.AuthTOTPCodeDTO) (*dto.AuthRecoveryCodesResponseDTO, error)
+	DisableTOTP(ctx context.Context, userID uuid.UUID, role string, req *dto.AuthTOTPCodeDTO) error
 }
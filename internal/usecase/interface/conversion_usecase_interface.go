@@ -0,0 +1,19 @@
+package usecase_interface
+
+import (
+	"context"
+
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+// ConversionUsecase rewrites values in place to the unit and currency of params
+type ConversionUsecase interface {
+	ConvertPrices(ctx context.Context, params *dto.ConversionParamsDTO, prices ...*domain.Price) error
+	ConvertPriceHistories(ctx context.Context, params *dto.ConversionParamsDTO, histories ...*domain.PriceHistory) error
+	ConvertSales(ctx context.Context, params *dto.ConversionParamsDTO, sales ...*domain.Sale) error
+	ConvertSupplies(ctx context.Context, params *dto.ConversionParamsDTO, supplies ...*domain.Supply) error
+	ConvertSupplyHistories(ctx context.Context, params *dto.ConversionParamsDTO, histories ...*domain.SupplyHistory) error
+	ConvertDemands(ctx context.Context, params *dto.ConversionParamsDTO, demands ...*domain.Demand) error
+	ConvertDemandHistories(ctx context.Context, params *dto.ConversionParamsDTO, histories ...*domain.DemandHistory) error
+}
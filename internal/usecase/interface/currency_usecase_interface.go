@@ -0,0 +1,15 @@
+package usecase_interface
+
+import (
+	"context"
+
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+type CurrencyUsecase interface {
+	GetCurrencies(ctx context.Context) ([]*domain.Currency, error)
+	CreateCurrency(ctx context.Context, req *dto.CurrencyCreateDTO) (*domain.Currency, error)
+	GetExchangeRates(ctx context.Context, code string) ([]*domain.ExchangeRate, error)
+	CreateExchangeRate(ctx context.Context, code string, req *dto.ExchangeRateCreateDTO) (*domain.ExchangeRate, error)
+}
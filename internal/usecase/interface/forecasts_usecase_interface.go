@@ -4,9 +4,17 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
 	"github.com/ryvasa/go-super-farmer/internal/model/dto"
 )
 
 type ForecastsUsecase interface {
 	GetForecastsByCommodityIDAndCityID(ctx context.Context, commodityID uuid.UUID, cityID int64) (*dto.ForecastsResponseDTO, error)
+	CreateForecastJob(ctx context.Context, userID uuid.UUID, req *dto.ForecastJobCreateDTO) (*domain.Forecast, error)
+	GetForecastJob(ctx context.Context, id uuid.UUID) (*domain.Forecast, error)
+	GetForecastHistory(ctx context.Context, landCommodityID uuid.UUID) ([]*domain.Forecast, error)
+	EvaluateDueForecasts(ctx context.Context) (int, error)
+	GetForecastAccuracy(ctx context.Context, params *dto.ForecastAccuracyParamsDTO) ([]*dto.ForecastAccuracyDTO, error)
+	BacktestForecasts(ctx context.Context, params *dto.ForecastBacktestParamsDTO) (*dto.ForecastBacktestDTO, error)
+	GetForecastsByArea(ctx context.Context, params *dto.AreaForecastParamsDTO) (*dto.AreaForecastDTO, error)
 }
@@ -13,7 +13,7 @@ type LandUsecase interface {
 	GetLandByID(ctx context.Context, id uuid.UUID) (*domain.Land, error)
 	GetLandByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.Land, error)
 	GetAllLands(ctx context.Context) ([]*domain.Land, error)
-	UpdateLand(ctx context.Context, userId, id uuid.UUID, req *dto.LandUpdateDTO) (*domain.Land, error)
-	DeleteLand(ctx context.Context, id uuid.UUID) error
-	RestoreLand(ctx context.Context, id uuid.UUID) (*domain.Land, error)
+	UpdateLand(ctx context.Context, userId uuid.UUID, role string, id uuid.UUID, req *dto.LandUpdateDTO) (*domain.Land, error)
+	DeleteLand(ctx context.Context, userId uuid.UUID, role string, id uuid.UUID) error
+	RestoreLand(ctx context.Context, userId uuid.UUID, role string, id uuid.UUID) (*domain.Land, error)
 }
@@ -0,0 +1,13 @@
+package usecase_interface
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+type OfficerUsecase interface {
+	AssignProvince(ctx context.Context, officerID uuid.UUID, req *dto.OfficerProvinceDTO) (*dto.UserResponseDTO, error)
+	GetDashboard(ctx context.Context, officerID uuid.UUID) (*dto.OfficerDashboardDTO, error)
+}
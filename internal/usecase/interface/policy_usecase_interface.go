@@ -0,0 +1,14 @@
+package usecase_interface
+
+import (
+	"context"
+
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+type PolicyUsecase interface {
+	GetPolicies(ctx context.Context, subject string) ([]*dto.PolicyDTO, error)
+	CreatePolicy(ctx context.Context, req *dto.PolicyDTO) (*dto.PolicyDTO, error)
+	UpdatePolicy(ctx context.Context, req *dto.PolicyUpdateDTO) (*dto.PolicyDTO, error)
+	DeletePolicy(ctx context.Context, req *dto.PolicyDTO) error
+}
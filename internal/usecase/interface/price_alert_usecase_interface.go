@@ -0,0 +1,16 @@
+package usecase_interface
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+type PriceAlertUsecase interface {
+	CreatePriceAlert(ctx context.Context, userID uuid.UUID, req *dto.PriceAlertCreateDTO) (*dto.PriceAlertResponseDTO, error)
+	GetPriceAlerts(ctx context.Context, userID uuid.UUID) ([]*dto.PriceAlertResponseDTO, error)
+	GetPriceAlertByID(ctx context.Context, userID, id uuid.UUID) (*dto.PriceAlertResponseDTO, error)
+	UpdatePriceAlert(ctx context.Context, userID, id uuid.UUID, req *dto.PriceAlertUpdateDTO) (*dto.PriceAlertResponseDTO, error)
+	DeletePriceAlert(ctx context.Context, userID, id uuid.UUID) error
+}
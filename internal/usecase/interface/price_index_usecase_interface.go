@@ -0,0 +1,12 @@
+package usecase_interface
+
+import (
+	"context"
+
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+type PriceIndexUsecase interface {
+	GetPriceIndex(ctx context.Context, params *dto.PriceIndexParamsDTO) (*dto.PriceIndexDTO, error)
+	GetProvincePriceIndices(ctx context.Context, params *dto.PriceIndexParamsDTO) ([]*dto.PriceIndexDTO, error)
+}
@@ -0,0 +1,12 @@
+package usecase_interface
+
+import (
+	"context"
+
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+type PriceStreamUsecase interface {
+	// Subscribe returns the missed events to replay and the channel of the live ones, which is closed once ctx is done
+	Subscribe(ctx context.Context, params *dto.PriceStreamParamsDTO) ([]*dto.PriceEventDTO, <-chan *dto.PriceEventDTO, error)
+}
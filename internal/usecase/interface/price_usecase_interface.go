@@ -19,5 +19,16 @@ type PriceUsecase interface {
 	RestorePrice(ctx context.Context, id uuid.UUID) (*domain.Price, error)
 	GetPriceByCommodityIDAndCityID(ctx context.Context, commodityID uuid.UUID, cityID int64) (*domain.Price, error)
 	GetPriceHistoryByCommodityIDAndCityID(ctx context.Context, commodityID uuid.UUID, cityID int64) ([]*domain.PriceHistory, error)
+	GetPriceHistorySeries(ctx context.Context, params *dto.PriceHistorySeriesParamsDTO) (*dto.PriceHistorySeriesDTO, error)
+	GetPriceReviews(ctx context.Context, status string) ([]*domain.PriceReview, error)
+	GetPriceReviewByID(ctx context.Context, id uuid.UUID) (*domain.PriceReview, error)
+	ApprovePriceReview(ctx context.Context, id uuid.UUID, reviewerID uuid.UUID, req *dto.PriceReviewDecisionDTO) (*domain.PriceReview, error)
+	RejectPriceReview(ctx context.Context, id uuid.UUID, reviewerID uuid.UUID, req *dto.PriceReviewDecisionDTO) (*domain.PriceReview, error)
+	SchedulePriceUpdate(ctx context.Context, id uuid.UUID, userID uuid.UUID, req *dto.PriceUpdateDTO) (*domain.PriceSchedule, error)
+	GetPriceSchedules(ctx context.Context, status string) ([]*domain.PriceSchedule, error)
+	GetPriceScheduleByID(ctx context.Context, id uuid.UUID) (*domain.PriceSchedule, error)
+	CancelPriceSchedule(ctx context.Context, id uuid.UUID) (*domain.PriceSchedule, error)
+	ApplyDuePriceSchedules(ctx context.Context) (int, error)
+	ImportPrices(ctx context.Context, req *dto.PriceImportDTO) (*dto.PriceImportReportDTO, error)
 	DownloadPriceHistoryByCommodityIDAndCityID(ctx context.Context, params *dto.PriceParamsDTO) (*dto.DownloadResponseDTO, error)
 }
@@ -9,11 +9,12 @@ import (
 )
 
 type SaleUsecase interface {
-	CreateSale(ctx context.Context, req *dto.SaleCreateDTO) (*domain.Sale, error)
+	CreateSale(ctx context.Context, userID uuid.UUID, role string, req *dto.SaleCreateDTO) (*domain.Sale, error)
 	GetAllSales(ctx context.Context, pagination *dto.PaginationDTO) (*dto.PaginationResponseDTO, error)
 	GetSaleByID(ctx context.Context, id uuid.UUID) (*domain.Sale, error)
 	GetSalesByCommodityID(ctx context.Context, pagination *dto.PaginationDTO, id uuid.UUID) (*dto.PaginationResponseDTO, error)
 	GetSalesByCityID(ctx context.Context, pagination *dto.PaginationDTO, id int64) (*dto.PaginationResponseDTO, error)
+	GetSalesByBuyerID(ctx context.Context, pagination *dto.PaginationDTO, buyerID uuid.UUID) (*dto.PaginationResponseDTO, error)
 	UpdateSale(ctx context.Context, id uuid.UUID, req *dto.SaleUpdateDTO) (*domain.Sale, error)
 	DeleteSale(ctx context.Context, id uuid.UUID) error
 	RestoreSale(ctx context.Context, id uuid.UUID) (*domain.Sale, error)
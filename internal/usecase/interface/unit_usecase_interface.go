@@ -0,0 +1,14 @@
+package usecase_interface
+
+import (
+	"context"
+
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+type UnitUsecase interface {
+	GetUnits(ctx context.Context) ([]*domain.Unit, error)
+	CreateUnit(ctx context.Context, req *dto.UnitCreateDTO) (*domain.Unit, error)
+	UpdateUnit(ctx context.Context, code string, req *dto.UnitUpdateDTO) (*domain.Unit, error)
+}
@@ -14,4 +14,9 @@ type UserUsecase interface {
 	UpdateUser(ctx context.Context, id uuid.UUID, role string, req *dto.UserUpdateDTO) (*dto.UserResponseDTO, error)
 	DeleteUser(ctx context.Context, id uuid.UUID) error
 	RestoreUser(ctx context.Context, id uuid.UUID) (*dto.UserResponseDTO, error)
+	UpdateProfile(ctx context.Context, id uuid.UUID, req *dto.UserProfileUpdateDTO) (*dto.UserResponseDTO, error)
+	ChangePassword(ctx context.Context, id uuid.UUID, req *dto.UserChangePasswordDTO) error
+	RequestEmailChange(ctx context.Context, id uuid.UUID, req *dto.UserEmailChangeDTO) error
+	ConfirmEmailChange(ctx context.Context, id uuid.UUID, req *dto.UserEmailConfirmDTO) (*dto.UserResponseDTO, error)
+	DeleteAccount(ctx context.Context, id uuid.UUID, req *dto.UserDeleteAccountDTO) error
 }
@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/interface/analyst_usecase_interface.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	dto "github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+// MockAnalystUsecase is a mock of AnalystUsecase interface.
+type MockAnalystUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnalystUsecaseMockRecorder
+}
+
+// MockAnalystUsecaseMockRecorder is the mock recorder for MockAnalystUsecase.
+type MockAnalystUsecaseMockRecorder struct {
+	mock *MockAnalystUsecase
+}
+
+// NewMockAnalystUsecase creates a new mock instance.
+func NewMockAnalystUsecase(ctrl *gomock.Controller) *MockAnalystUsecase {
+	mock := &MockAnalystUsecase{ctrl: ctrl}
+	mock.recorder = &MockAnalystUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnalystUsecase) EXPECT() *MockAnalystUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetMarketOverview mocks base method.
+func (m *MockAnalystUsecase) GetMarketOverview(ctx context.Context, commodityID uuid.UUID) (*dto.MarketOverviewDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMarketOverview", ctx, commodityID)
+	ret0, _ := ret[0].(*dto.MarketOverviewDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMarketOverview indicates an expected call of GetMarketOverview.
+func (mr *MockAnalystUsecaseMockRecorder) GetMarketOverview(ctx, commodityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketOverview", reflect.TypeOf((*MockAnalystUsecase)(nil).GetMarketOverview), ctx, commodityID)
+}
+
+// GetPriceComparison mocks base method.
+func (m *MockAnalystUsecase) GetPriceComparison(ctx context.Context, commodityID uuid.UUID) (*dto.PriceComparisonDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceComparison", ctx, commodityID)
+	ret0, _ := ret[0].(*dto.PriceComparisonDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceComparison indicates an expected call of GetPriceComparison.
+func (mr *MockAnalystUsecaseMockRecorder) GetPriceComparison(ctx, commodityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceComparison", reflect.TypeOf((*MockAnalystUsecase)(nil).GetPriceComparison), ctx, commodityID)
+}
@@ -8,7 +8,9 @@ import (
 	context "context"
 	reflect "reflect"
 
+	jwt "github.com/golang-jwt/jwt/v5"
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 	dto "github.com/ryvasa/go-super-farmer/internal/model/dto"
 )
 
@@ -35,6 +37,49 @@ func (m *MockAuthUsecase) EXPECT() *MockAuthUsecaseMockRecorder {
 	return m.recorder
 }
 
+// DisableTOTP mocks base method.
+func (m *MockAuthUsecase) DisableTOTP(ctx context.Context, userID uuid.UUID, role string, req *dto.AuthTOTPCodeDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTOTP", ctx, userID, role, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTOTP indicates an expected call of DisableTOTP.
+func (mr *MockAuthUsecaseMockRecorder) DisableTOTP(ctx, userID, role, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTOTP", reflect.TypeOf((*MockAuthUsecase)(nil).DisableTOTP), ctx, userID, role, req)
+}
+
+// EnableTOTP mocks base method.
+func (m *MockAuthUsecase) EnableTOTP(ctx context.Context, userID uuid.UUID, req *dto.AuthTOTPCodeDTO) (*dto.AuthRecoveryCodesResponseDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableTOTP", ctx, userID, req)
+	ret0, _ := ret[0].(*dto.AuthRecoveryCodesResponseDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableTOTP indicates an expected call of EnableTOTP.
+func (mr *MockAuthUsecaseMockRecorder) EnableTOTP(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTOTP", reflect.TypeOf((*MockAuthUsecase)(nil).EnableTOTP), ctx, userID, req)
+}
+
+// ForgotPassword mocks base method.
+func (m *MockAuthUsecase) ForgotPassword(ctx context.Context, req *dto.AuthForgotPasswordDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgotPassword", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForgotPassword indicates an expected call of ForgotPassword.
+func (mr *MockAuthUsecaseMockRecorder) ForgotPassword(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgotPassword", reflect.TypeOf((*MockAuthUsecase)(nil).ForgotPassword), ctx, req)
+}
+
 // Login mocks base method.
 func (m *MockAuthUsecase) Login(ctx context.Context, req *dto.AuthDTO) (*dto.AuthResponseDTO, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +95,49 @@ func (mr *MockAuthUsecaseMockRecorder) Login(ctx, req interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthUsecase)(nil).Login), ctx, req)
 }
 
+// Logout mocks base method.
+func (m *MockAuthUsecase) Logout(ctx context.Context, claims jwt.MapClaims, req *dto.AuthLogoutDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, claims, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthUsecaseMockRecorder) Logout(ctx, claims, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthUsecase)(nil).Logout), ctx, claims, req)
+}
+
+// RefreshToken mocks base method.
+func (m *MockAuthUsecase) RefreshToken(ctx context.Context, req *dto.AuthRefreshDTO) (*dto.AuthResponseDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshToken", ctx, req)
+	ret0, _ := ret[0].(*dto.AuthResponseDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockAuthUsecaseMockRecorder) RefreshToken(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockAuthUsecase)(nil).RefreshToken), ctx, req)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAuthUsecase) ResetPassword(ctx context.Context, req *dto.AuthResetPasswordDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthUsecaseMockRecorder) ResetPassword(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthUsecase)(nil).ResetPassword), ctx, req)
+}
+
 // SendOTP mocks base method.
 func (m *MockAuthUsecase) SendOTP(ctx context.Context, req *dto.AuthSendDTO) error {
 	m.ctrl.T.Helper()
@@ -64,6 +152,50 @@ func (mr *MockAuthUsecaseMockRecorder) SendOTP(ctx, req interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendOTP", reflect.TypeOf((*MockAuthUsecase)(nil).SendOTP), ctx, req)
 }
 
+// SetupTOTP mocks base method.
+func (m *MockAuthUsecase) SetupTOTP(ctx context.Context, userID uuid.UUID) (*dto.AuthTOTPSetupResponseDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetupTOTP", ctx, userID)
+	ret0, _ := ret[0].(*dto.AuthTOTPSetupResponseDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetupTOTP indicates an expected call of SetupTOTP.
+func (mr *MockAuthUsecaseMockRecorder) SetupTOTP(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupTOTP", reflect.TypeOf((*MockAuthUsecase)(nil).SetupTOTP), ctx, userID)
+}
+
+// UnlockAccount mocks base method.
+func (m *MockAuthUsecase) UnlockAccount(ctx context.Context, req *dto.AuthUnlockDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockAccount", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockAccount indicates an expected call of UnlockAccount.
+func (mr *MockAuthUsecaseMockRecorder) UnlockAccount(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockAccount", reflect.TypeOf((*MockAuthUsecase)(nil).UnlockAccount), ctx, req)
+}
+
+// VerifyMFA mocks base method.
+func (m *MockAuthUsecase) VerifyMFA(ctx context.Context, req *dto.AuthMFAVerifyDTO) (*dto.AuthResponseDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMFA", ctx, req)
+	ret0, _ := ret[0].(*dto.AuthResponseDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMFA indicates an expected call of VerifyMFA.
+func (mr *MockAuthUsecaseMockRecorder) VerifyMFA(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMFA", reflect.TypeOf((*MockAuthUsecase)(nil).VerifyMFA), ctx, req)
+}
+
 // VerifyOTP mocks base method.
 func (m *MockAuthUsecase) VerifyOTP(ctx context.Context, req *dto.AuthVerifyDTO) error {
 	m.ctrl.T.Helper()
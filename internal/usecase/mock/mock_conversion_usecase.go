@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/interface/conversion_usecase_interface.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/ryvasa/go-super-farmer/internal/model/domain"
+	dto "github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+// MockConversionUsecase is a mock of ConversionUsecase interface.
+type MockConversionUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockConversionUsecaseMockRecorder
+}
+
+// MockConversionUsecaseMockRecorder is the mock recorder for MockConversionUsecase.
+type MockConversionUsecaseMockRecorder struct {
+	mock *MockConversionUsecase
+}
+
+// NewMockConversionUsecase creates a new mock instance.
+func NewMockConversionUsecase(ctrl *gomock.Controller) *MockConversionUsecase {
+	mock := &MockConversionUsecase{ctrl: ctrl}
+	mock.recorder = &MockConversionUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConversionUsecase) EXPECT() *MockConversionUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ConvertDemandHistories mocks base method.
+func (m *MockConversionUsecase) ConvertDemandHistories(ctx context.Context, params *dto.ConversionParamsDTO, histories ...*domain.DemandHistory) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range histories {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConvertDemandHistories", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConvertDemandHistories indicates an expected call of ConvertDemandHistories.
+func (mr *MockConversionUsecaseMockRecorder) ConvertDemandHistories(ctx, params interface{}, histories ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, histories...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertDemandHistories", reflect.TypeOf((*MockConversionUsecase)(nil).ConvertDemandHistories), varargs...)
+}
+
+// ConvertDemands mocks base method.
+func (m *MockConversionUsecase) ConvertDemands(ctx context.Context, params *dto.ConversionParamsDTO, demands ...*domain.Demand) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range demands {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConvertDemands", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConvertDemands indicates an expected call of ConvertDemands.
+func (mr *MockConversionUsecaseMockRecorder) ConvertDemands(ctx, params interface{}, demands ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, demands...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertDemands", reflect.TypeOf((*MockConversionUsecase)(nil).ConvertDemands), varargs...)
+}
+
+// ConvertPriceHistories mocks base method.
+func (m *MockConversionUsecase) ConvertPriceHistories(ctx context.Context, params *dto.ConversionParamsDTO, histories ...*domain.PriceHistory) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range histories {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConvertPriceHistories", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConvertPriceHistories indicates an expected call of ConvertPriceHistories.
+func (mr *MockConversionUsecaseMockRecorder) ConvertPriceHistories(ctx, params interface{}, histories ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, histories...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertPriceHistories", reflect.TypeOf((*MockConversionUsecase)(nil).ConvertPriceHistories), varargs...)
+}
+
+// ConvertPrices mocks base method.
+func (m *MockConversionUsecase) ConvertPrices(ctx context.Context, params *dto.ConversionParamsDTO, prices ...*domain.Price) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range prices {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConvertPrices", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConvertPrices indicates an expected call of ConvertPrices.
+func (mr *MockConversionUsecaseMockRecorder) ConvertPrices(ctx, params interface{}, prices ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, prices...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertPrices", reflect.TypeOf((*MockConversionUsecase)(nil).ConvertPrices), varargs...)
+}
+
+// ConvertSales mocks base method.
+func (m *MockConversionUsecase) ConvertSales(ctx context.Context, params *dto.ConversionParamsDTO, sales ...*domain.Sale) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range sales {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConvertSales", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConvertSales indicates an expected call of ConvertSales.
+func (mr *MockConversionUsecaseMockRecorder) ConvertSales(ctx, params interface{}, sales ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, sales...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertSales", reflect.TypeOf((*MockConversionUsecase)(nil).ConvertSales), varargs...)
+}
+
+// ConvertSupplies mocks base method.
+func (m *MockConversionUsecase) ConvertSupplies(ctx context.Context, params *dto.ConversionParamsDTO, supplies ...*domain.Supply) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range supplies {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConvertSupplies", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConvertSupplies indicates an expected call of ConvertSupplies.
+func (mr *MockConversionUsecaseMockRecorder) ConvertSupplies(ctx, params interface{}, supplies ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, supplies...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertSupplies", reflect.TypeOf((*MockConversionUsecase)(nil).ConvertSupplies), varargs...)
+}
+
+// ConvertSupplyHistories mocks base method.
+func (m *MockConversionUsecase) ConvertSupplyHistories(ctx context.Context, params *dto.ConversionParamsDTO, histories ...*domain.SupplyHistory) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range histories {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConvertSupplyHistories", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConvertSupplyHistories indicates an expected call of ConvertSupplyHistories.
+func (mr *MockConversionUsecaseMockRecorder) ConvertSupplyHistories(ctx, params interface{}, histories ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, histories...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertSupplyHistories", reflect.TypeOf((*MockConversionUsecase)(nil).ConvertSupplyHistories), varargs...)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/interface/currency_usecase_interface.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/ryvasa/go-super-farmer/internal/model/domain"
+	dto "github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+// MockCurrencyUsecase is a mock of CurrencyUsecase interface.
+type MockCurrencyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCurrencyUsecaseMockRecorder
+}
+
+// MockCurrencyUsecaseMockRecorder is the mock recorder for MockCurrencyUsecase.
+type MockCurrencyUsecaseMockRecorder struct {
+	mock *MockCurrencyUsecase
+}
+
+// NewMockCurrencyUsecase creates a new mock instance.
+func NewMockCurrencyUsecase(ctrl *gomock.Controller) *MockCurrencyUsecase {
+	mock := &MockCurrencyUsecase{ctrl: ctrl}
+	mock.recorder = &MockCurrencyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCurrencyUsecase) EXPECT() *MockCurrencyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateCurrency mocks base method.
+func (m *MockCurrencyUsecase) CreateCurrency(ctx context.Context, req *dto.CurrencyCreateDTO) (*domain.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCurrency", ctx, req)
+	ret0, _ := ret[0].(*domain.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCurrency indicates an expected call of CreateCurrency.
+func (mr *MockCurrencyUsecaseMockRecorder) CreateCurrency(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCurrency", reflect.TypeOf((*MockCurrencyUsecase)(nil).CreateCurrency), ctx, req)
+}
+
+// CreateExchangeRate mocks base method.
+func (m *MockCurrencyUsecase) CreateExchangeRate(ctx context.Context, code string, req *dto.ExchangeRateCreateDTO) (*domain.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExchangeRate", ctx, code, req)
+	ret0, _ := ret[0].(*domain.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExchangeRate indicates an expected call of CreateExchangeRate.
+func (mr *MockCurrencyUsecaseMockRecorder) CreateExchangeRate(ctx, code, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExchangeRate", reflect.TypeOf((*MockCurrencyUsecase)(nil).CreateExchangeRate), ctx, code, req)
+}
+
+// GetCurrencies mocks base method.
+func (m *MockCurrencyUsecase) GetCurrencies(ctx context.Context) ([]*domain.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrencies", ctx)
+	ret0, _ := ret[0].([]*domain.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrencies indicates an expected call of GetCurrencies.
+func (mr *MockCurrencyUsecaseMockRecorder) GetCurrencies(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrencies", reflect.TypeOf((*MockCurrencyUsecase)(nil).GetCurrencies), ctx)
+}
+
+// GetExchangeRates mocks base method.
+func (m *MockCurrencyUsecase) GetExchangeRates(ctx context.Context, code string) ([]*domain.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRates", ctx, code)
+	ret0, _ := ret[0].([]*domain.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRates indicates an expected call of GetExchangeRates.
+func (mr *MockCurrencyUsecaseMockRecorder) GetExchangeRates(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRates", reflect.TypeOf((*MockCurrencyUsecase)(nil).GetExchangeRates), ctx, code)
+}
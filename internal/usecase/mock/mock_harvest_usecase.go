@@ -38,32 +38,32 @@ func (m *MockHarvestUsecase) EXPECT() *MockHarvestUsecaseMockRecorder {
 }
 
 // CreateHarvest mocks base method.
-func (m *MockHarvestUsecase) CreateHarvest(ctx context.Context, req *dto.HarvestCreateDTO) (*domain.Harvest, error) {
+func (m *MockHarvestUsecase) CreateHarvest(ctx context.Context, userID uuid.UUID, role string, req *dto.HarvestCreateDTO) (*domain.Harvest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateHarvest", ctx, req)
+	ret := m.ctrl.Call(m, "CreateHarvest", ctx, userID, role, req)
 	ret0, _ := ret[0].(*domain.Harvest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateHarvest indicates an expected call of CreateHarvest.
-func (mr *MockHarvestUsecaseMockRecorder) CreateHarvest(ctx, req interface{}) *gomock.Call {
+func (mr *MockHarvestUsecaseMockRecorder) CreateHarvest(ctx, userID, role, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHarvest", reflect.TypeOf((*MockHarvestUsecase)(nil).CreateHarvest), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHarvest", reflect.TypeOf((*MockHarvestUsecase)(nil).CreateHarvest), ctx, userID, role, req)
 }
 
 // DeleteHarvest mocks base method.
-func (m *MockHarvestUsecase) DeleteHarvest(ctx context.Context, id uuid.UUID) error {
+func (m *MockHarvestUsecase) DeleteHarvest(ctx context.Context, userID uuid.UUID, role string, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteHarvest", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteHarvest", ctx, userID, role, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteHarvest indicates an expected call of DeleteHarvest.
-func (mr *MockHarvestUsecaseMockRecorder) DeleteHarvest(ctx, id interface{}) *gomock.Call {
+func (mr *MockHarvestUsecaseMockRecorder) DeleteHarvest(ctx, userID, role, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHarvest", reflect.TypeOf((*MockHarvestUsecase)(nil).DeleteHarvest), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHarvest", reflect.TypeOf((*MockHarvestUsecase)(nil).DeleteHarvest), ctx, userID, role, id)
 }
 
 // DownloadHarvestByLandCommodityID mocks base method.
@@ -201,47 +201,32 @@ func (mr *MockHarvestUsecaseMockRecorder) GetHarvestDeletedByID(ctx, id interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHarvestDeletedByID", reflect.TypeOf((*MockHarvestUsecase)(nil).GetHarvestDeletedByID), ctx, id)
 }
 
-// GetHarvestExcelFile mocks base method.
-func (m *MockHarvestUsecase) GetHarvestExcelFile(ctx context.Context, params *dto.HarvestParamsDTO) (*string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHarvestExcelFile", ctx, params)
-	ret0, _ := ret[0].(*string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetHarvestExcelFile indicates an expected call of GetHarvestExcelFile.
-func (mr *MockHarvestUsecaseMockRecorder) GetHarvestExcelFile(ctx, params interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHarvestExcelFile", reflect.TypeOf((*MockHarvestUsecase)(nil).GetHarvestExcelFile), ctx, params)
-}
-
 // RestoreHarvest mocks base method.
-func (m *MockHarvestUsecase) RestoreHarvest(ctx context.Context, id uuid.UUID) (*domain.Harvest, error) {
+func (m *MockHarvestUsecase) RestoreHarvest(ctx context.Context, userID uuid.UUID, role string, id uuid.UUID) (*domain.Harvest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RestoreHarvest", ctx, id)
+	ret := m.ctrl.Call(m, "RestoreHarvest", ctx, userID, role, id)
 	ret0, _ := ret[0].(*domain.Harvest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RestoreHarvest indicates an expected call of RestoreHarvest.
-func (mr *MockHarvestUsecaseMockRecorder) RestoreHarvest(ctx, id interface{}) *gomock.Call {
+func (mr *MockHarvestUsecaseMockRecorder) RestoreHarvest(ctx, userID, role, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreHarvest", reflect.TypeOf((*MockHarvestUsecase)(nil).RestoreHarvest), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreHarvest", reflect.TypeOf((*MockHarvestUsecase)(nil).RestoreHarvest), ctx, userID, role, id)
 }
 
 // UpdateHarvest mocks base method.
-func (m *MockHarvestUsecase) UpdateHarvest(ctx context.Context, id uuid.UUID, req *dto.HarvestUpdateDTO) (*domain.Harvest, error) {
+func (m *MockHarvestUsecase) UpdateHarvest(ctx context.Context, userID uuid.UUID, role string, id uuid.UUID, req *dto.HarvestUpdateDTO) (*domain.Harvest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateHarvest", ctx, id, req)
+	ret := m.ctrl.Call(m, "UpdateHarvest", ctx, userID, role, id, req)
 	ret0, _ := ret[0].(*domain.Harvest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateHarvest indicates an expected call of UpdateHarvest.
-func (mr *MockHarvestUsecaseMockRecorder) UpdateHarvest(ctx, id, req interface{}) *gomock.Call {
+func (mr *MockHarvestUsecaseMockRecorder) UpdateHarvest(ctx, userID, role, id, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHarvest", reflect.TypeOf((*MockHarvestUsecase)(nil).UpdateHarvest), ctx, id, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHarvest", reflect.TypeOf((*MockHarvestUsecase)(nil).UpdateHarvest), ctx, userID, role, id, req)
 }
@@ -38,32 +38,32 @@ func (m *MockLandCommodityUsecase) EXPECT() *MockLandCommodityUsecaseMockRecorde
 }
 
 // CreateLandCommodity mocks base method.
-func (m *MockLandCommodityUsecase) CreateLandCommodity(ctx context.Context, req *dto.LandCommodityCreateDTO) (*domain.LandCommodity, error) {
+func (m *MockLandCommodityUsecase) CreateLandCommodity(ctx context.Context, userID uuid.UUID, role string, req *dto.LandCommodityCreateDTO) (*domain.LandCommodity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateLandCommodity", ctx, req)
+	ret := m.ctrl.Call(m, "CreateLandCommodity", ctx, userID, role, req)
 	ret0, _ := ret[0].(*domain.LandCommodity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateLandCommodity indicates an expected call of CreateLandCommodity.
-func (mr *MockLandCommodityUsecaseMockRecorder) CreateLandCommodity(ctx, req interface{}) *gomock.Call {
+func (mr *MockLandCommodityUsecaseMockRecorder) CreateLandCommodity(ctx, userID, role, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLandCommodity", reflect.TypeOf((*MockLandCommodityUsecase)(nil).CreateLandCommodity), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLandCommodity", reflect.TypeOf((*MockLandCommodityUsecase)(nil).CreateLandCommodity), ctx, userID, role, req)
 }
 
 // DeleteLandCommodity mocks base method.
-func (m *MockLandCommodityUsecase) DeleteLandCommodity(ctx context.Context, id uuid.UUID) error {
+func (m *MockLandCommodityUsecase) DeleteLandCommodity(ctx context.Context, userID uuid.UUID, role string, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLandCommodity", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteLandCommodity", ctx, userID, role, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteLandCommodity indicates an expected call of DeleteLandCommodity.
-func (mr *MockLandCommodityUsecaseMockRecorder) DeleteLandCommodity(ctx, id interface{}) *gomock.Call {
+func (mr *MockLandCommodityUsecaseMockRecorder) DeleteLandCommodity(ctx, userID, role, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLandCommodity", reflect.TypeOf((*MockLandCommodityUsecase)(nil).DeleteLandCommodity), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLandCommodity", reflect.TypeOf((*MockLandCommodityUsecase)(nil).DeleteLandCommodity), ctx, userID, role, id)
 }
 
 // GetAllLandCommodity mocks base method.
@@ -142,31 +142,31 @@ func (mr *MockLandCommodityUsecaseMockRecorder) GetLandCommodityByLandID(ctx, id
 }
 
 // RestoreLandCommodity mocks base method.
-func (m *MockLandCommodityUsecase) RestoreLandCommodity(ctx context.Context, id uuid.UUID) (*domain.LandCommodity, error) {
+func (m *MockLandCommodityUsecase) RestoreLandCommodity(ctx context.Context, userID uuid.UUID, role string, id uuid.UUID) (*domain.LandCommodity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RestoreLandCommodity", ctx, id)
+	ret := m.ctrl.Call(m, "RestoreLandCommodity", ctx, userID, role, id)
 	ret0, _ := ret[0].(*domain.LandCommodity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RestoreLandCommodity indicates an expected call of RestoreLandCommodity.
-func (mr *MockLandCommodityUsecaseMockRecorder) RestoreLandCommodity(ctx, id interface{}) *gomock.Call {
+func (mr *MockLandCommodityUsecaseMockRecorder) RestoreLandCommodity(ctx, userID, role, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreLandCommodity", reflect.TypeOf((*MockLandCommodityUsecase)(nil).RestoreLandCommodity), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreLandCommodity", reflect.TypeOf((*MockLandCommodityUsecase)(nil).RestoreLandCommodity), ctx, userID, role, id)
 }
 
 // UpdateLandCommodity mocks base method.
-func (m *MockLandCommodityUsecase) UpdateLandCommodity(ctx context.Context, id uuid.UUID, req *dto.LandCommodityUpdateDTO) (*domain.LandCommodity, error) {
+func (m *MockLandCommodityUsecase) UpdateLandCommodity(ctx context.Context, userID uuid.UUID, role string, id uuid.UUID, req *dto.LandCommodityUpdateDTO) (*domain.LandCommodity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateLandCommodity", ctx, id, req)
+	ret := m.ctrl.Call(m, "UpdateLandCommodity", ctx, userID, role, id, req)
 	ret0, _ := ret[0].(*domain.LandCommodity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateLandCommodity indicates an expected call of UpdateLandCommodity.
-func (mr *MockLandCommodityUsecaseMockRecorder) UpdateLandCommodity(ctx, id, req interface{}) *gomock.Call {
+func (mr *MockLandCommodityUsecaseMockRecorder) UpdateLandCommodity(ctx, userID, role, id, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLandCommodity", reflect.TypeOf((*MockLandCommodityUsecase)(nil).UpdateLandCommodity), ctx, id, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLandCommodity", reflect.TypeOf((*MockLandCommodityUsecase)(nil).UpdateLandCommodity), ctx, userID, role, id, req)
 }
@@ -53,17 +53,17 @@ func (mr *MockLandUsecaseMockRecorder) CreateLand(ctx, userId, req interface{})
 }
 
 // DeleteLand mocks base method.
-func (m *MockLandUsecase) DeleteLand(ctx context.Context, id uuid.UUID) error {
+func (m *MockLandUsecase) DeleteLand(ctx context.Context, userId uuid.UUID, role string, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLand", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteLand", ctx, userId, role, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteLand indicates an expected call of DeleteLand.
-func (mr *MockLandUsecaseMockRecorder) DeleteLand(ctx, id interface{}) *gomock.Call {
+func (mr *MockLandUsecaseMockRecorder) DeleteLand(ctx, userId, role, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLand", reflect.TypeOf((*MockLandUsecase)(nil).DeleteLand), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLand", reflect.TypeOf((*MockLandUsecase)(nil).DeleteLand), ctx, userId, role, id)
 }
 
 // GetAllLands mocks base method.
@@ -112,31 +112,31 @@ func (mr *MockLandUsecaseMockRecorder) GetLandByUserID(ctx, userID interface{})
 }
 
 // RestoreLand mocks base method.
-func (m *MockLandUsecase) RestoreLand(ctx context.Context, id uuid.UUID) (*domain.Land, error) {
+func (m *MockLandUsecase) RestoreLand(ctx context.Context, userId uuid.UUID, role string, id uuid.UUID) (*domain.Land, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RestoreLand", ctx, id)
+	ret := m.ctrl.Call(m, "RestoreLand", ctx, userId, role, id)
 	ret0, _ := ret[0].(*domain.Land)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RestoreLand indicates an expected call of RestoreLand.
-func (mr *MockLandUsecaseMockRecorder) RestoreLand(ctx, id interface{}) *gomock.Call {
+func (mr *MockLandUsecaseMockRecorder) RestoreLand(ctx, userId, role, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreLand", reflect.TypeOf((*MockLandUsecase)(nil).RestoreLand), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreLand", reflect.TypeOf((*MockLandUsecase)(nil).RestoreLand), ctx, userId, role, id)
 }
 
 // UpdateLand mocks base method.
-func (m *MockLandUsecase) UpdateLand(ctx context.Context, userId, id uuid.UUID, req *dto.LandUpdateDTO) (*domain.Land, error) {
+func (m *MockLandUsecase) UpdateLand(ctx context.Context, userId uuid.UUID, role string, id uuid.UUID, req *dto.LandUpdateDTO) (*domain.Land, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateLand", ctx, userId, id, req)
+	ret := m.ctrl.Call(m, "UpdateLand", ctx, userId, role, id, req)
 	ret0, _ := ret[0].(*domain.Land)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateLand indicates an expected call of UpdateLand.
-func (mr *MockLandUsecaseMockRecorder) UpdateLand(ctx, userId, id, req interface{}) *gomock.Call {
+func (mr *MockLandUsecaseMockRecorder) UpdateLand(ctx, userId, role, id, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLand", reflect.TypeOf((*MockLandUsecase)(nil).UpdateLand), ctx, userId, id, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLand", reflect.TypeOf((*MockLandUsecase)(nil).UpdateLand), ctx, userId, role, id, req)
 }
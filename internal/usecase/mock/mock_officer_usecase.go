@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/interface/officer_usecase_interface.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	dto "github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+// MockOfficerUsecase is a mock of OfficerUsecase interface.
+type MockOfficerUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockOfficerUsecaseMockRecorder
+}
+
+// MockOfficerUsecaseMockRecorder is the mock recorder for MockOfficerUsecase.
+type MockOfficerUsecaseMockRecorder struct {
+	mock *MockOfficerUsecase
+}
+
+// NewMockOfficerUsecase creates a new mock instance.
+func NewMockOfficerUsecase(ctrl *gomock.Controller) *MockOfficerUsecase {
+	mock := &MockOfficerUsecase{ctrl: ctrl}
+	mock.recorder = &MockOfficerUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOfficerUsecase) EXPECT() *MockOfficerUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AssignProvince mocks base method.
+func (m *MockOfficerUsecase) AssignProvince(ctx context.Context, officerID uuid.UUID, req *dto.OfficerProvinceDTO) (*dto.UserResponseDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignProvince", ctx, officerID, req)
+	ret0, _ := ret[0].(*dto.UserResponseDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignProvince indicates an expected call of AssignProvince.
+func (mr *MockOfficerUsecaseMockRecorder) AssignProvince(ctx, officerID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignProvince", reflect.TypeOf((*MockOfficerUsecase)(nil).AssignProvince), ctx, officerID, req)
+}
+
+// GetDashboard mocks base method.
+func (m *MockOfficerUsecase) GetDashboard(ctx context.Context, officerID uuid.UUID) (*dto.OfficerDashboardDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDashboard", ctx, officerID)
+	ret0, _ := ret[0].(*dto.OfficerDashboardDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDashboard indicates an expected call of GetDashboard.
+func (mr *MockOfficerUsecaseMockRecorder) GetDashboard(ctx, officerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDashboard", reflect.TypeOf((*MockOfficerUsecase)(nil).GetDashboard), ctx, officerID)
+}
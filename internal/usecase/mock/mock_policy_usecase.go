@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/interface/policy_usecase_interface.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	dto "github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+// MockPolicyUsecase is a mock of PolicyUsecase interface.
+type MockPolicyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyUsecaseMockRecorder
+}
+
+// MockPolicyUsecaseMockRecorder is the mock recorder for MockPolicyUsecase.
+type MockPolicyUsecaseMockRecorder struct {
+	mock *MockPolicyUsecase
+}
+
+// NewMockPolicyUsecase creates a new mock instance.
+func NewMockPolicyUsecase(ctrl *gomock.Controller) *MockPolicyUsecase {
+	mock := &MockPolicyUsecase{ctrl: ctrl}
+	mock.recorder = &MockPolicyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyUsecase) EXPECT() *MockPolicyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreatePolicy mocks base method.
+func (m *MockPolicyUsecase) CreatePolicy(ctx context.Context, req *dto.PolicyDTO) (*dto.PolicyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePolicy", ctx, req)
+	ret0, _ := ret[0].(*dto.PolicyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePolicy indicates an expected call of CreatePolicy.
+func (mr *MockPolicyUsecaseMockRecorder) CreatePolicy(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicy", reflect.TypeOf((*MockPolicyUsecase)(nil).CreatePolicy), ctx, req)
+}
+
+// DeletePolicy mocks base method.
+func (m *MockPolicyUsecase) DeletePolicy(ctx context.Context, req *dto.PolicyDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePolicy", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePolicy indicates an expected call of DeletePolicy.
+func (mr *MockPolicyUsecaseMockRecorder) DeletePolicy(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicy", reflect.TypeOf((*MockPolicyUsecase)(nil).DeletePolicy), ctx, req)
+}
+
+// GetPolicies mocks base method.
+func (m *MockPolicyUsecase) GetPolicies(ctx context.Context, subject string) ([]*dto.PolicyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicies", ctx, subject)
+	ret0, _ := ret[0].([]*dto.PolicyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicies indicates an expected call of GetPolicies.
+func (mr *MockPolicyUsecaseMockRecorder) GetPolicies(ctx, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicies", reflect.TypeOf((*MockPolicyUsecase)(nil).GetPolicies), ctx, subject)
+}
+
+// UpdatePolicy mocks base method.
+func (m *MockPolicyUsecase) UpdatePolicy(ctx context.Context, req *dto.PolicyUpdateDTO) (*dto.PolicyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePolicy", ctx, req)
+	ret0, _ := ret[0].(*dto.PolicyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePolicy indicates an expected call of UpdatePolicy.
+func (mr *MockPolicyUsecaseMockRecorder) UpdatePolicy(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicy", reflect.TypeOf((*MockPolicyUsecase)(nil).UpdatePolicy), ctx, req)
+}
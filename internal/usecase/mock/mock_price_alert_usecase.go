@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/interface/price_alert_usecase_interface.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	dto "github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+// MockPriceAlertUsecase is a mock of PriceAlertUsecase interface.
+type MockPriceAlertUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceAlertUsecaseMockRecorder
+}
+
+// MockPriceAlertUsecaseMockRecorder is the mock recorder for MockPriceAlertUsecase.
+type MockPriceAlertUsecaseMockRecorder struct {
+	mock *MockPriceAlertUsecase
+}
+
+// NewMockPriceAlertUsecase creates a new mock instance.
+func NewMockPriceAlertUsecase(ctrl *gomock.Controller) *MockPriceAlertUsecase {
+	mock := &MockPriceAlertUsecase{ctrl: ctrl}
+	mock.recorder = &MockPriceAlertUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceAlertUsecase) EXPECT() *MockPriceAlertUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreatePriceAlert mocks base method.
+func (m *MockPriceAlertUsecase) CreatePriceAlert(ctx context.Context, userID uuid.UUID, req *dto.PriceAlertCreateDTO) (*dto.PriceAlertResponseDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePriceAlert", ctx, userID, req)
+	ret0, _ := ret[0].(*dto.PriceAlertResponseDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePriceAlert indicates an expected call of CreatePriceAlert.
+func (mr *MockPriceAlertUsecaseMockRecorder) CreatePriceAlert(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePriceAlert", reflect.TypeOf((*MockPriceAlertUsecase)(nil).CreatePriceAlert), ctx, userID, req)
+}
+
+// DeletePriceAlert mocks base method.
+func (m *MockPriceAlertUsecase) DeletePriceAlert(ctx context.Context, userID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePriceAlert", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePriceAlert indicates an expected call of DeletePriceAlert.
+func (mr *MockPriceAlertUsecaseMockRecorder) DeletePriceAlert(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePriceAlert", reflect.TypeOf((*MockPriceAlertUsecase)(nil).DeletePriceAlert), ctx, userID, id)
+}
+
+// GetPriceAlertByID mocks base method.
+func (m *MockPriceAlertUsecase) GetPriceAlertByID(ctx context.Context, userID, id uuid.UUID) (*dto.PriceAlertResponseDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceAlertByID", ctx, userID, id)
+	ret0, _ := ret[0].(*dto.PriceAlertResponseDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceAlertByID indicates an expected call of GetPriceAlertByID.
+func (mr *MockPriceAlertUsecaseMockRecorder) GetPriceAlertByID(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceAlertByID", reflect.TypeOf((*MockPriceAlertUsecase)(nil).GetPriceAlertByID), ctx, userID, id)
+}
+
+// GetPriceAlerts mocks base method.
+func (m *MockPriceAlertUsecase) GetPriceAlerts(ctx context.Context, userID uuid.UUID) ([]*dto.PriceAlertResponseDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceAlerts", ctx, userID)
+	ret0, _ := ret[0].([]*dto.PriceAlertResponseDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceAlerts indicates an expected call of GetPriceAlerts.
+func (mr *MockPriceAlertUsecaseMockRecorder) GetPriceAlerts(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceAlerts", reflect.TypeOf((*MockPriceAlertUsecase)(nil).GetPriceAlerts), ctx, userID)
+}
+
+// UpdatePriceAlert mocks base method.
+func (m *MockPriceAlertUsecase) UpdatePriceAlert(ctx context.Context, userID, id uuid.UUID, req *dto.PriceAlertUpdateDTO) (*dto.PriceAlertResponseDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePriceAlert", ctx, userID, id, req)
+	ret0, _ := ret[0].(*dto.PriceAlertResponseDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePriceAlert indicates an expected call of UpdatePriceAlert.
+func (mr *MockPriceAlertUsecaseMockRecorder) UpdatePriceAlert(ctx, userID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePriceAlert", reflect.TypeOf((*MockPriceAlertUsecase)(nil).UpdatePriceAlert), ctx, userID, id, req)
+}
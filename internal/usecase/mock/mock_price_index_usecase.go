@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/interface/price_index_usecase_interface.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	dto "github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+// MockPriceIndexUsecase is a mock of PriceIndexUsecase interface.
+type MockPriceIndexUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceIndexUsecaseMockRecorder
+}
+
+// MockPriceIndexUsecaseMockRecorder is the mock recorder for MockPriceIndexUsecase.
+type MockPriceIndexUsecaseMockRecorder struct {
+	mock *MockPriceIndexUsecase
+}
+
+// NewMockPriceIndexUsecase creates a new mock instance.
+func NewMockPriceIndexUsecase(ctrl *gomock.Controller) *MockPriceIndexUsecase {
+	mock := &MockPriceIndexUsecase{ctrl: ctrl}
+	mock.recorder = &MockPriceIndexUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceIndexUsecase) EXPECT() *MockPriceIndexUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetPriceIndex mocks base method.
+func (m *MockPriceIndexUsecase) GetPriceIndex(ctx context.Context, params *dto.PriceIndexParamsDTO) (*dto.PriceIndexDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceIndex", ctx, params)
+	ret0, _ := ret[0].(*dto.PriceIndexDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceIndex indicates an expected call of GetPriceIndex.
+func (mr *MockPriceIndexUsecaseMockRecorder) GetPriceIndex(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceIndex", reflect.TypeOf((*MockPriceIndexUsecase)(nil).GetPriceIndex), ctx, params)
+}
+
+// GetProvincePriceIndices mocks base method.
+func (m *MockPriceIndexUsecase) GetProvincePriceIndices(ctx context.Context, params *dto.PriceIndexParamsDTO) ([]*dto.PriceIndexDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProvincePriceIndices", ctx, params)
+	ret0, _ := ret[0].([]*dto.PriceIndexDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProvincePriceIndices indicates an expected call of GetProvincePriceIndices.
+func (mr *MockPriceIndexUsecaseMockRecorder) GetProvincePriceIndices(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProvincePriceIndices", reflect.TypeOf((*MockPriceIndexUsecase)(nil).GetProvincePriceIndices), ctx, params)
+}
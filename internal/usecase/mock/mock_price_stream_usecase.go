@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/interface/price_stream_usecase_interface.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	dto "github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+// MockPriceStreamUsecase is a mock of PriceStreamUsecase interface.
+type MockPriceStreamUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceStreamUsecaseMockRecorder
+}
+
+// MockPriceStreamUsecaseMockRecorder is the mock recorder for MockPriceStreamUsecase.
+type MockPriceStreamUsecaseMockRecorder struct {
+	mock *MockPriceStreamUsecase
+}
+
+// NewMockPriceStreamUsecase creates a new mock instance.
+func NewMockPriceStreamUsecase(ctrl *gomock.Controller) *MockPriceStreamUsecase {
+	mock := &MockPriceStreamUsecase{ctrl: ctrl}
+	mock.recorder = &MockPriceStreamUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceStreamUsecase) EXPECT() *MockPriceStreamUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockPriceStreamUsecase) Subscribe(ctx context.Context, params *dto.PriceStreamParamsDTO) ([]*dto.PriceEventDTO, <-chan *dto.PriceEventDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, params)
+	ret0, _ := ret[0].([]*dto.PriceEventDTO)
+	ret1, _ := ret[1].(<-chan *dto.PriceEventDTO)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockPriceStreamUsecaseMockRecorder) Subscribe(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockPriceStreamUsecase)(nil).Subscribe), ctx, params)
+}
@@ -37,6 +37,51 @@ func (m *MockPriceUsecase) EXPECT() *MockPriceUsecaseMockRecorder {
 	return m.recorder
 }
 
+// ApplyDuePriceSchedules mocks base method.
+func (m *MockPriceUsecase) ApplyDuePriceSchedules(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyDuePriceSchedules", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyDuePriceSchedules indicates an expected call of ApplyDuePriceSchedules.
+func (mr *MockPriceUsecaseMockRecorder) ApplyDuePriceSchedules(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyDuePriceSchedules", reflect.TypeOf((*MockPriceUsecase)(nil).ApplyDuePriceSchedules), ctx)
+}
+
+// ApprovePriceReview mocks base method.
+func (m *MockPriceUsecase) ApprovePriceReview(ctx context.Context, id, reviewerID uuid.UUID, req *dto.PriceReviewDecisionDTO) (*domain.PriceReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApprovePriceReview", ctx, id, reviewerID, req)
+	ret0, _ := ret[0].(*domain.PriceReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApprovePriceReview indicates an expected call of ApprovePriceReview.
+func (mr *MockPriceUsecaseMockRecorder) ApprovePriceReview(ctx, id, reviewerID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApprovePriceReview", reflect.TypeOf((*MockPriceUsecase)(nil).ApprovePriceReview), ctx, id, reviewerID, req)
+}
+
+// CancelPriceSchedule mocks base method.
+func (m *MockPriceUsecase) CancelPriceSchedule(ctx context.Context, id uuid.UUID) (*domain.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPriceSchedule", ctx, id)
+	ret0, _ := ret[0].(*domain.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelPriceSchedule indicates an expected call of CancelPriceSchedule.
+func (mr *MockPriceUsecaseMockRecorder) CancelPriceSchedule(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPriceSchedule", reflect.TypeOf((*MockPriceUsecase)(nil).CancelPriceSchedule), ctx, id)
+}
+
 // CreatePrice mocks base method.
 func (m *MockPriceUsecase) CreatePrice(ctx context.Context, req *dto.PriceCreateDTO) (*domain.Price, error) {
 	m.ctrl.T.Helper()
@@ -126,34 +171,94 @@ func (mr *MockPriceUsecaseMockRecorder) GetPriceByID(ctx, id interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceByID", reflect.TypeOf((*MockPriceUsecase)(nil).GetPriceByID), ctx, id)
 }
 
-// GetPriceExcelFile mocks base method.
-func (m *MockPriceUsecase) GetPriceExcelFile(ctx context.Context, params *dto.PriceParamsDTO) (*string, error) {
+// GetPriceHistoryByCommodityIDAndCityID mocks base method.
+func (m *MockPriceUsecase) GetPriceHistoryByCommodityIDAndCityID(ctx context.Context, commodityID uuid.UUID, cityID int64) ([]*domain.PriceHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPriceExcelFile", ctx, params)
-	ret0, _ := ret[0].(*string)
+	ret := m.ctrl.Call(m, "GetPriceHistoryByCommodityIDAndCityID", ctx, commodityID, cityID)
+	ret0, _ := ret[0].([]*domain.PriceHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPriceExcelFile indicates an expected call of GetPriceExcelFile.
-func (mr *MockPriceUsecaseMockRecorder) GetPriceExcelFile(ctx, params interface{}) *gomock.Call {
+// GetPriceHistoryByCommodityIDAndCityID indicates an expected call of GetPriceHistoryByCommodityIDAndCityID.
+func (mr *MockPriceUsecaseMockRecorder) GetPriceHistoryByCommodityIDAndCityID(ctx, commodityID, cityID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceExcelFile", reflect.TypeOf((*MockPriceUsecase)(nil).GetPriceExcelFile), ctx, params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceHistoryByCommodityIDAndCityID", reflect.TypeOf((*MockPriceUsecase)(nil).GetPriceHistoryByCommodityIDAndCityID), ctx, commodityID, cityID)
 }
 
-// GetPriceHistoryByCommodityIDAndCityID mocks base method.
-func (m *MockPriceUsecase) GetPriceHistoryByCommodityIDAndCityID(ctx context.Context, commodityID uuid.UUID, cityID int64) ([]*domain.PriceHistory, error) {
+// GetPriceHistorySeries mocks base method.
+func (m *MockPriceUsecase) GetPriceHistorySeries(ctx context.Context, params *dto.PriceHistorySeriesParamsDTO) (*dto.PriceHistorySeriesDTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPriceHistoryByCommodityIDAndCityID", ctx, commodityID, cityID)
-	ret0, _ := ret[0].([]*domain.PriceHistory)
+	ret := m.ctrl.Call(m, "GetPriceHistorySeries", ctx, params)
+	ret0, _ := ret[0].(*dto.PriceHistorySeriesDTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPriceHistoryByCommodityIDAndCityID indicates an expected call of GetPriceHistoryByCommodityIDAndCityID.
-func (mr *MockPriceUsecaseMockRecorder) GetPriceHistoryByCommodityIDAndCityID(ctx, commodityID, cityID interface{}) *gomock.Call {
+// GetPriceHistorySeries indicates an expected call of GetPriceHistorySeries.
+func (mr *MockPriceUsecaseMockRecorder) GetPriceHistorySeries(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceHistoryByCommodityIDAndCityID", reflect.TypeOf((*MockPriceUsecase)(nil).GetPriceHistoryByCommodityIDAndCityID), ctx, commodityID, cityID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceHistorySeries", reflect.TypeOf((*MockPriceUsecase)(nil).GetPriceHistorySeries), ctx, params)
+}
+
+// GetPriceReviewByID mocks base method.
+func (m *MockPriceUsecase) GetPriceReviewByID(ctx context.Context, id uuid.UUID) (*domain.PriceReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceReviewByID", ctx, id)
+	ret0, _ := ret[0].(*domain.PriceReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceReviewByID indicates an expected call of GetPriceReviewByID.
+func (mr *MockPriceUsecaseMockRecorder) GetPriceReviewByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceReviewByID", reflect.TypeOf((*MockPriceUsecase)(nil).GetPriceReviewByID), ctx, id)
+}
+
+// GetPriceReviews mocks base method.
+func (m *MockPriceUsecase) GetPriceReviews(ctx context.Context, status string) ([]*domain.PriceReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceReviews", ctx, status)
+	ret0, _ := ret[0].([]*domain.PriceReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceReviews indicates an expected call of GetPriceReviews.
+func (mr *MockPriceUsecaseMockRecorder) GetPriceReviews(ctx, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceReviews", reflect.TypeOf((*MockPriceUsecase)(nil).GetPriceReviews), ctx, status)
+}
+
+// GetPriceScheduleByID mocks base method.
+func (m *MockPriceUsecase) GetPriceScheduleByID(ctx context.Context, id uuid.UUID) (*domain.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceScheduleByID", ctx, id)
+	ret0, _ := ret[0].(*domain.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceScheduleByID indicates an expected call of GetPriceScheduleByID.
+func (mr *MockPriceUsecaseMockRecorder) GetPriceScheduleByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceScheduleByID", reflect.TypeOf((*MockPriceUsecase)(nil).GetPriceScheduleByID), ctx, id)
+}
+
+// GetPriceSchedules mocks base method.
+func (m *MockPriceUsecase) GetPriceSchedules(ctx context.Context, status string) ([]*domain.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceSchedules", ctx, status)
+	ret0, _ := ret[0].([]*domain.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceSchedules indicates an expected call of GetPriceSchedules.
+func (mr *MockPriceUsecaseMockRecorder) GetPriceSchedules(ctx, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceSchedules", reflect.TypeOf((*MockPriceUsecase)(nil).GetPriceSchedules), ctx, status)
 }
 
 // GetPricesByCityID mocks base method.
@@ -186,6 +291,36 @@ func (mr *MockPriceUsecaseMockRecorder) GetPricesByCommodityID(ctx, commodityID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPricesByCommodityID", reflect.TypeOf((*MockPriceUsecase)(nil).GetPricesByCommodityID), ctx, commodityID)
 }
 
+// ImportPrices mocks base method.
+func (m *MockPriceUsecase) ImportPrices(ctx context.Context, req *dto.PriceImportDTO) (*dto.PriceImportReportDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportPrices", ctx, req)
+	ret0, _ := ret[0].(*dto.PriceImportReportDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportPrices indicates an expected call of ImportPrices.
+func (mr *MockPriceUsecaseMockRecorder) ImportPrices(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportPrices", reflect.TypeOf((*MockPriceUsecase)(nil).ImportPrices), ctx, req)
+}
+
+// RejectPriceReview mocks base method.
+func (m *MockPriceUsecase) RejectPriceReview(ctx context.Context, id, reviewerID uuid.UUID, req *dto.PriceReviewDecisionDTO) (*domain.PriceReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectPriceReview", ctx, id, reviewerID, req)
+	ret0, _ := ret[0].(*domain.PriceReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectPriceReview indicates an expected call of RejectPriceReview.
+func (mr *MockPriceUsecaseMockRecorder) RejectPriceReview(ctx, id, reviewerID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectPriceReview", reflect.TypeOf((*MockPriceUsecase)(nil).RejectPriceReview), ctx, id, reviewerID, req)
+}
+
 // RestorePrice mocks base method.
 func (m *MockPriceUsecase) RestorePrice(ctx context.Context, id uuid.UUID) (*domain.Price, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +336,21 @@ func (mr *MockPriceUsecaseMockRecorder) RestorePrice(ctx, id interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePrice", reflect.TypeOf((*MockPriceUsecase)(nil).RestorePrice), ctx, id)
 }
 
+// SchedulePriceUpdate mocks base method.
+func (m *MockPriceUsecase) SchedulePriceUpdate(ctx context.Context, id, userID uuid.UUID, req *dto.PriceUpdateDTO) (*domain.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulePriceUpdate", ctx, id, userID, req)
+	ret0, _ := ret[0].(*domain.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulePriceUpdate indicates an expected call of SchedulePriceUpdate.
+func (mr *MockPriceUsecaseMockRecorder) SchedulePriceUpdate(ctx, id, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePriceUpdate", reflect.TypeOf((*MockPriceUsecase)(nil).SchedulePriceUpdate), ctx, id, userID, req)
+}
+
 // UpdatePrice mocks base method.
 func (m *MockPriceUsecase) UpdatePrice(ctx context.Context, id uuid.UUID, req *dto.PriceUpdateDTO) (*domain.Price, error) {
 	m.ctrl.T.Helper()
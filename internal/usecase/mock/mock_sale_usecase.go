@@ -38,18 +38,18 @@ func (m *MockSaleUsecase) EXPECT() *MockSaleUsecaseMockRecorder {
 }
 
 // CreateSale mocks base method.
-func (m *MockSaleUsecase) CreateSale(ctx context.Context, req *dto.SaleCreateDTO) (*domain.Sale, error) {
+func (m *MockSaleUsecase) CreateSale(ctx context.Context, userID uuid.UUID, role string, req *dto.SaleCreateDTO) (*domain.Sale, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSale", ctx, req)
+	ret := m.ctrl.Call(m, "CreateSale", ctx, userID, role, req)
 	ret0, _ := ret[0].(*domain.Sale)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSale indicates an expected call of CreateSale.
-func (mr *MockSaleUsecaseMockRecorder) CreateSale(ctx, req interface{}) *gomock.Call {
+func (mr *MockSaleUsecaseMockRecorder) CreateSale(ctx, userID, role, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSale", reflect.TypeOf((*MockSaleUsecase)(nil).CreateSale), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSale", reflect.TypeOf((*MockSaleUsecase)(nil).CreateSale), ctx, userID, role, req)
 }
 
 // DeleteSale mocks base method.
@@ -126,6 +126,21 @@ func (mr *MockSaleUsecaseMockRecorder) GetSaleByID(ctx, id interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSaleByID", reflect.TypeOf((*MockSaleUsecase)(nil).GetSaleByID), ctx, id)
 }
 
+// GetSalesByBuyerID mocks base method.
+func (m *MockSaleUsecase) GetSalesByBuyerID(ctx context.Context, pagination *dto.PaginationDTO, buyerID uuid.UUID) (*dto.PaginationResponseDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSalesByBuyerID", ctx, pagination, buyerID)
+	ret0, _ := ret[0].(*dto.PaginationResponseDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSalesByBuyerID indicates an expected call of GetSalesByBuyerID.
+func (mr *MockSaleUsecaseMockRecorder) GetSalesByBuyerID(ctx, pagination, buyerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSalesByBuyerID", reflect.TypeOf((*MockSaleUsecase)(nil).GetSalesByBuyerID), ctx, pagination, buyerID)
+}
+
 // GetSalesByCityID mocks base method.
 func (m *MockSaleUsecase) GetSalesByCityID(ctx context.Context, pagination *dto.PaginationDTO, id int64) (*dto.PaginationResponseDTO, error) {
 	m.ctrl.T.Helper()
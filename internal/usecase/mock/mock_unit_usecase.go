@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/interface/unit_usecase_interface.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/ryvasa/go-super-farmer/internal/model/domain"
+	dto "github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+// MockUnitUsecase is a mock of UnitUsecase interface.
+type MockUnitUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnitUsecaseMockRecorder
+}
+
+// MockUnitUsecaseMockRecorder is the mock recorder for MockUnitUsecase.
+type MockUnitUsecaseMockRecorder struct {
+	mock *MockUnitUsecase
+}
+
+// NewMockUnitUsecase creates a new mock instance.
+func NewMockUnitUsecase(ctrl *gomock.Controller) *MockUnitUsecase {
+	mock := &MockUnitUsecase{ctrl: ctrl}
+	mock.recorder = &MockUnitUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnitUsecase) EXPECT() *MockUnitUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateUnit mocks base method.
+func (m *MockUnitUsecase) CreateUnit(ctx context.Context, req *dto.UnitCreateDTO) (*domain.Unit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUnit", ctx, req)
+	ret0, _ := ret[0].(*domain.Unit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUnit indicates an expected call of CreateUnit.
+func (mr *MockUnitUsecaseMockRecorder) CreateUnit(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUnit", reflect.TypeOf((*MockUnitUsecase)(nil).CreateUnit), ctx, req)
+}
+
+// GetUnits mocks base method.
+func (m *MockUnitUsecase) GetUnits(ctx context.Context) ([]*domain.Unit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnits", ctx)
+	ret0, _ := ret[0].([]*domain.Unit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnits indicates an expected call of GetUnits.
+func (mr *MockUnitUsecaseMockRecorder) GetUnits(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnits", reflect.TypeOf((*MockUnitUsecase)(nil).GetUnits), ctx)
+}
+
+// UpdateUnit mocks base method.
+func (m *MockUnitUsecase) UpdateUnit(ctx context.Context, code string, req *dto.UnitUpdateDTO) (*domain.Unit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUnit", ctx, code, req)
+	ret0, _ := ret[0].(*domain.Unit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUnit indicates an expected call of UpdateUnit.
+func (mr *MockUnitUsecaseMockRecorder) UpdateUnit(ctx, code, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUnit", reflect.TypeOf((*MockUnitUsecase)(nil).UpdateUnit), ctx, code, req)
+}
@@ -36,6 +36,49 @@ func (m *MockUserUsecase) EXPECT() *MockUserUsecaseMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockUserUsecase) ChangePassword(ctx context.Context, id uuid.UUID, req *dto.UserChangePasswordDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockUserUsecaseMockRecorder) ChangePassword(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUserUsecase)(nil).ChangePassword), ctx, id, req)
+}
+
+// ConfirmEmailChange mocks base method.
+func (m *MockUserUsecase) ConfirmEmailChange(ctx context.Context, id uuid.UUID, req *dto.UserEmailConfirmDTO) (*dto.UserResponseDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmailChange", ctx, id, req)
+	ret0, _ := ret[0].(*dto.UserResponseDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEmailChange indicates an expected call of ConfirmEmailChange.
+func (mr *MockUserUsecaseMockRecorder) ConfirmEmailChange(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmailChange", reflect.TypeOf((*MockUserUsecase)(nil).ConfirmEmailChange), ctx, id, req)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockUserUsecase) DeleteAccount(ctx context.Context, id uuid.UUID, req *dto.UserDeleteAccountDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockUserUsecaseMockRecorder) DeleteAccount(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockUserUsecase)(nil).DeleteAccount), ctx, id, req)
+}
+
 // DeleteUser mocks base method.
 func (m *MockUserUsecase) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -95,6 +138,20 @@ func (mr *MockUserUsecaseMockRecorder) Register(ctx, req interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockUserUsecase)(nil).Register), ctx, req)
 }
 
+// RequestEmailChange mocks base method.
+func (m *MockUserUsecase) RequestEmailChange(ctx context.Context, id uuid.UUID, req *dto.UserEmailChangeDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestEmailChange", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestEmailChange indicates an expected call of RequestEmailChange.
+func (mr *MockUserUsecaseMockRecorder) RequestEmailChange(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestEmailChange", reflect.TypeOf((*MockUserUsecase)(nil).RequestEmailChange), ctx, id, req)
+}
+
 // RestoreUser mocks base method.
 func (m *MockUserUsecase) RestoreUser(ctx context.Context, id uuid.UUID) (*dto.UserResponseDTO, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +167,21 @@ func (mr *MockUserUsecaseMockRecorder) RestoreUser(ctx, id interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockUserUsecase)(nil).RestoreUser), ctx, id)
 }
 
+// UpdateProfile mocks base method.
+func (m *MockUserUsecase) UpdateProfile(ctx context.Context, id uuid.UUID, req *dto.UserProfileUpdateDTO) (*dto.UserResponseDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, id, req)
+	ret0, _ := ret[0].(*dto.UserResponseDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockUserUsecaseMockRecorder) UpdateProfile(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockUserUsecase)(nil).UpdateProfile), ctx, id, req)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserUsecase) UpdateUser(ctx context.Context, id uuid.UUID, role string, req *dto.UserUpdateDTO) (*dto.UserResponseDTO, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,176 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	mock_repo "github.com/ryvasa/go-super-farmer/internal/repository/mock"
+	usecase_implementation "github.com/ryvasa/go-super-farmer/internal/usecase/implementation"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/stretchr/testify/assert"
+)
+
+type AnalystRepoMock struct {
+	Commodity *mock_repo.MockCommodityRepository
+	Price     *mock_repo.MockPriceRepository
+	Supply    *mock_repo.MockSupplyRepository
+	Demand    *mock_repo.MockDemandRepository
+	Unit      *mock_repo.MockUnitRepository
+}
+
+func AnalystUsecaseUtils(t *testing.T) (*AnalystRepoMock, usecase_interface.AnalystUsecase, context.Context) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	commodityRepo := mock_repo.NewMockCommodityRepository(ctrl)
+	priceRepo := mock_repo.NewMockPriceRepository(ctrl)
+	supplyRepo := mock_repo.NewMockSupplyRepository(ctrl)
+	demandRepo := mock_repo.NewMockDemandRepository(ctrl)
+	unitRepo := mock_repo.NewMockUnitRepository(ctrl)
+	uc := usecase_implementation.NewAnalystUsecase(commodityRepo, priceRepo, supplyRepo, demandRepo, unitRepo)
+
+	repo := &AnalystRepoMock{Commodity: commodityRepo, Price: priceRepo, Supply: supplyRepo, Demand: demandRepo, Unit: unitRepo}
+
+	return repo, uc, context.TODO()
+}
+
+func TestAnalystUsecase_GetMarketOverview(t *testing.T) {
+	repo, uc, ctx := AnalystUsecaseUtils(t)
+	commodity := &domain.Commodity{ID: uuid.New(), Name: "Padi"}
+	city := &domain.City{ID: 1, Name: "Kota Banda Aceh", ProvinceID: 1}
+
+	t.Run("should combine price, supply and demand per city", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, commodity.ID).Return(commodity, nil).Times(1)
+		repo.Price.EXPECT().FindByCommodityID(ctx, commodity.ID).Return([]*domain.Price{
+			{CommodityID: commodity.ID, CityID: 1, City: city, Price: 12000, Unit: "idr"},
+			{CommodityID: commodity.ID, CityID: 2, Price: 10000, Unit: "idr"},
+		}, nil).Times(1)
+		repo.Supply.EXPECT().FindByCommodityID(ctx, commodity.ID).Return([]*domain.Supply{
+			{CommodityID: commodity.ID, CityID: 1, Quantity: 300},
+			{CommodityID: commodity.ID, CityID: 3, Quantity: 50},
+		}, nil).Times(1)
+		repo.Demand.EXPECT().FindByCommodityID(ctx, commodity.ID).Return([]*domain.Demand{
+			{CommodityID: commodity.ID, CityID: 1, Quantity: 500},
+		}, nil).Times(1)
+
+		resp, err := uc.GetMarketOverview(ctx, commodity.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, commodity, resp.Commodity)
+		assert.Equal(t, float64(11000), resp.AveragePrice)
+		assert.Equal(t, float64(350), resp.TotalSupply)
+		assert.Equal(t, float64(500), resp.TotalDemand)
+		assert.Len(t, resp.Cities, 3)
+		assert.Equal(t, int64(1), resp.Cities[0].CityID)
+		assert.Equal(t, city, resp.Cities[0].City)
+		assert.Equal(t, float64(12000), *resp.Cities[0].Price)
+		assert.Equal(t, float64(-200), resp.Cities[0].Balance)
+		assert.Nil(t, resp.Cities[2].Price)
+		assert.Equal(t, float64(50), resp.Cities[2].Balance)
+	})
+
+	t.Run("should return error when commodity not found", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, commodity.ID).Return(nil, errors.New("record not found")).Times(1)
+
+		resp, err := uc.GetMarketOverview(ctx, commodity.ID)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "commodity not found")
+	})
+
+	t.Run("should return error when find prices fails", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, commodity.ID).Return(commodity, nil).Times(1)
+		repo.Price.EXPECT().FindByCommodityID(ctx, commodity.ID).Return(nil, errors.New("internal error")).Times(1)
+
+		resp, err := uc.GetMarketOverview(ctx, commodity.ID)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "internal error")
+	})
+}
+
+func TestAnalystUsecase_GetPriceComparison(t *testing.T) {
+	repo, uc, ctx := AnalystUsecaseUtils(t)
+	commodity := &domain.Commodity{ID: uuid.New(), Name: "Padi"}
+	bogor := &domain.City{ID: 1, Name: "Kota Bogor", ProvinceID: 1}
+	bandung := &domain.City{ID: 2, Name: "Kota Bandung", ProvinceID: 1}
+	depok := &domain.City{ID: 3, Name: "Kota Depok", ProvinceID: 1}
+	semarang := &domain.City{ID: 4, Name: "Kota Semarang", ProvinceID: 2}
+	units := []*domain.Unit{
+		{Code: "kg", Dimension: domain.UnitDimensionMass, Factor: 1},
+		{Code: "ton", Dimension: domain.UnitDimensionMass, Factor: 1000},
+		{Code: "ha", Dimension: domain.UnitDimensionArea, Factor: 10000},
+	}
+
+	t.Run("should rank cities by price against the national median", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, commodity.ID).Return(commodity, nil).Times(1)
+		repo.Price.EXPECT().FindByCommodityID(ctx, commodity.ID).Return([]*domain.Price{
+			{CityID: 1, City: bogor, Price: 14000},
+			{CityID: 2, City: bandung, Price: 10000},
+			{CityID: 3, City: depok, Price: 12000},
+			{CityID: 4, City: semarang, Price: 9000},
+		}, nil).Times(1)
+		repo.Supply.EXPECT().FindByCommodityID(ctx, commodity.ID).Return([]*domain.Supply{
+			{CityID: 1, Quantity: 2, Unit: "ton"},
+			{CityID: 1, Quantity: 5, Unit: "ha"},
+			{CityID: 2, Quantity: 100, Unit: "kg"},
+		}, nil).Times(1)
+		repo.Demand.EXPECT().FindByCommodityID(ctx, commodity.ID).Return([]*domain.Demand{
+			{CityID: 1, Quantity: 1500, Unit: "kg"},
+			{CityID: 2, Quantity: 400, Unit: "kg"},
+		}, nil).Times(1)
+		repo.Unit.EXPECT().FindAll(ctx).Return(units, nil).Times(1)
+
+		resp, err := uc.GetPriceComparison(ctx, commodity.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(11000), resp.NationalMedian)
+		assert.Len(t, resp.Cities, 4)
+		assert.Equal(t, int64(4), resp.Cities[0].CityID)
+		assert.Equal(t, 1, resp.Cities[0].Rank)
+		assert.Equal(t, 0, resp.Cities[0].Neighbors)
+		assert.Nil(t, resp.Cities[0].CheapestNeighbor)
+
+		top := resp.Cities[3]
+		assert.Equal(t, int64(1), top.CityID)
+		assert.Equal(t, 4, top.Rank)
+		assert.Equal(t, float64(3000), top.Spread)
+		assert.Equal(t, 27.27, top.SpreadPercent)
+		assert.Equal(t, float64(12000), top.ProvinceMedian)
+		assert.Equal(t, 2, top.Neighbors)
+		assert.Equal(t, int64(2), top.CheapestNeighbor.CityID)
+		assert.Equal(t, float64(-4000), top.CheapestNeighbor.Spread)
+		assert.Equal(t, float64(2000), top.Supply)
+		assert.True(t, top.HighPriceSurplus)
+
+		// Bandung has less supply than demand, Depok has neither
+		assert.False(t, resp.Cities[1].Surplus)
+		assert.False(t, resp.Cities[2].HighPriceSurplus)
+	})
+
+	t.Run("should return no cities when commodity has no price", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, commodity.ID).Return(commodity, nil).Times(1)
+		repo.Price.EXPECT().FindByCommodityID(ctx, commodity.ID).Return([]*domain.Price{}, nil).Times(1)
+		repo.Supply.EXPECT().FindByCommodityID(ctx, commodity.ID).Return([]*domain.Supply{}, nil).Times(1)
+		repo.Demand.EXPECT().FindByCommodityID(ctx, commodity.ID).Return([]*domain.Demand{}, nil).Times(1)
+		repo.Unit.EXPECT().FindAll(ctx).Return(units, nil).Times(1)
+
+		resp, err := uc.GetPriceComparison(ctx, commodity.ID)
+
+		assert.NoError(t, err)
+		assert.Empty(t, resp.Cities)
+	})
+
+	t.Run("should return error when commodity not found", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, commodity.ID).Return(nil, errors.New("record not found")).Times(1)
+
+		resp, err := uc.GetPriceComparison(ctx, commodity.ID)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "commodity not found")
+	})
+}
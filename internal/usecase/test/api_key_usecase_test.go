@@ -0,0 +1,232 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	mock_repo "github.com/ryvasa/go-super-farmer/internal/repository/mock"
+	usecase_implementation "github.com/ryvasa/go-super-farmer/internal/usecase/implementation"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type APIKeyRepoMock struct {
+	APIKey *mock_repo.MockAPIKeyRepository
+	User   *mock_repo.MockUserRepository
+}
+
+type APIKeyIDs struct {
+	APIKeyID uuid.UUID
+	OwnerID  uuid.UUID
+}
+
+type APIKeyMocks struct {
+	Key    string
+	APIKey *domain.APIKey
+}
+
+type APIKeyDTOMock struct {
+	Create *dto.APIKeyCreateDTO
+}
+
+func APIKeyUsecaseUtils(t *testing.T) (*APIKeyIDs, *APIKeyMocks, *APIKeyDTOMock, *APIKeyRepoMock, usecase_interface.APIKeyUsecase, context.Context) {
+	apiKeyID := uuid.New()
+	ownerID := uuid.New()
+
+	ids := &APIKeyIDs{
+		APIKeyID: apiKeyID,
+		OwnerID:  ownerID,
+	}
+
+	key := "sf_abcd1234_secret_value"
+	sum := sha256.Sum256([]byte(key))
+	mocks := &APIKeyMocks{
+		Key: key,
+		APIKey: &domain.APIKey{
+			ID:         apiKeyID,
+			Name:       "price scraper",
+			Prefix:     "abcd1234",
+			SecretHash: hex.EncodeToString(sum[:]),
+			OwnerID:    ownerID,
+			Scopes:     "prices:read,prices:write",
+		},
+	}
+
+	dtos := &APIKeyDTOMock{
+		Create: &dto.APIKeyCreateDTO{
+			Name:   "price scraper",
+			Scopes: []string{"prices:read", "prices:write"},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	apiKeyRepo := mock_repo.NewMockAPIKeyRepository(ctrl)
+	userRepo := mock_repo.NewMockUserRepository(ctrl)
+	uc := usecase_implementation.NewAPIKeyUsecase(apiKeyRepo, userRepo)
+	ctx := context.TODO()
+
+	repo := &APIKeyRepoMock{APIKey: apiKeyRepo, User: userRepo}
+
+	return ids, mocks, dtos, repo, uc, ctx
+}
+
+func TestAPIKeyUsecase_CreateAPIKey(t *testing.T) {
+	ids, _, dtos, repo, uc, ctx := APIKeyUsecaseUtils(t)
+
+	t.Run("should create api key successfully", func(t *testing.T) {
+		var stored *domain.APIKey
+		repo.APIKey.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, apiKey *domain.APIKey) error {
+			stored = apiKey
+			return nil
+		}).Times(1)
+
+		resp, err := uc.CreateAPIKey(ctx, ids.OwnerID, dtos.Create)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.True(t, strings.HasPrefix(resp.Key, "sf_"+stored.Prefix+"_"))
+		assert.Equal(t, ids.OwnerID, resp.OwnerID)
+		assert.Equal(t, dtos.Create.Scopes, resp.Scopes)
+		assert.Equal(t, "prices:read,prices:write", stored.Scopes)
+		assert.NotContains(t, stored.SecretHash, resp.Key)
+	})
+
+	t.Run("should return error when scope is unknown", func(t *testing.T) {
+		resp, err := uc.CreateAPIKey(ctx, ids.OwnerID, &dto.APIKeyCreateDTO{Name: "scraper", Scopes: []string{"users:write"}})
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "Validation failed")
+	})
+
+	t.Run("should return error when expiry is in the past", func(t *testing.T) {
+		past := time.Now().Add(-time.Hour)
+		resp, err := uc.CreateAPIKey(ctx, ids.OwnerID, &dto.APIKeyCreateDTO{Name: "scraper", Scopes: []string{"prices:read"}, ExpiresAt: &past})
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "expires_at must be in the future")
+	})
+
+	t.Run("should return error when create fails", func(t *testing.T) {
+		repo.APIKey.EXPECT().Create(ctx, gomock.Any()).Return(utils.NewInternalError("internal error")).Times(1)
+
+		resp, err := uc.CreateAPIKey(ctx, ids.OwnerID, dtos.Create)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "internal error")
+	})
+}
+
+func TestAPIKeyUsecase_GetAPIKeys(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := APIKeyUsecaseUtils(t)
+
+	t.Run("should return api keys without secrets", func(t *testing.T) {
+		repo.APIKey.EXPECT().FindByOwnerID(ctx, ids.OwnerID).Return([]*domain.APIKey{mocks.APIKey}, nil).Times(1)
+
+		resp, err := uc.GetAPIKeys(ctx, ids.OwnerID)
+
+		assert.NoError(t, err)
+		assert.Len(t, resp, 1)
+		assert.Empty(t, resp[0].Key)
+		assert.Equal(t, []string{"prices:read", "prices:write"}, resp[0].Scopes)
+	})
+}
+
+func TestAPIKeyUsecase_RevokeAPIKey(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := APIKeyUsecaseUtils(t)
+
+	t.Run("should revoke api key successfully", func(t *testing.T) {
+		repo.APIKey.EXPECT().FindByID(ctx, ids.APIKeyID).Return(mocks.APIKey, nil).Times(1)
+		repo.APIKey.EXPECT().Delete(ctx, ids.APIKeyID).Return(nil).Times(1)
+
+		err := uc.RevokeAPIKey(ctx, ids.OwnerID, ids.APIKeyID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when api key belongs to another user", func(t *testing.T) {
+		repo.APIKey.EXPECT().FindByID(ctx, ids.APIKeyID).Return(mocks.APIKey, nil).Times(1)
+
+		err := uc.RevokeAPIKey(ctx, uuid.New(), ids.APIKeyID)
+		assert.EqualError(t, err, "api key not found")
+	})
+}
+
+func TestAPIKeyUsecase_Authenticate(t *testing.T) {
+	_, mocks, _, repo, uc, ctx := APIKeyUsecaseUtils(t)
+
+	t.Run("should authenticate and track last used", func(t *testing.T) {
+		apiKey := *mocks.APIKey
+		repo.APIKey.EXPECT().FindByPrefix(ctx, "abcd1234").Return(&apiKey, nil).Times(1)
+		repo.User.EXPECT().FindWithRoleByID(ctx, apiKey.OwnerID).Return(&domain.User{ID: apiKey.OwnerID}, nil).Times(1)
+		repo.APIKey.EXPECT().UpdateLastUsed(ctx, apiKey.ID, gomock.Any()).Return(nil).Times(1)
+
+		resp, err := uc.Authenticate(ctx, mocks.Key)
+
+		assert.NoError(t, err)
+		assert.Equal(t, apiKey.ID, resp.ID)
+		assert.NotNil(t, resp.LastUsedAt)
+		assert.Equal(t, apiKey.OwnerID, resp.Owner.ID)
+	})
+
+	t.Run("should not update last used within interval", func(t *testing.T) {
+		apiKey := *mocks.APIKey
+		recent := time.Now().Add(-10 * time.Second)
+		apiKey.LastUsedAt = &recent
+		repo.APIKey.EXPECT().FindByPrefix(ctx, "abcd1234").Return(&apiKey, nil).Times(1)
+		repo.User.EXPECT().FindWithRoleByID(ctx, apiKey.OwnerID).Return(&domain.User{ID: apiKey.OwnerID}, nil).Times(1)
+
+		resp, err := uc.Authenticate(ctx, mocks.Key)
+
+		assert.NoError(t, err)
+		assert.Equal(t, recent, *resp.LastUsedAt)
+	})
+
+	t.Run("should return error when secret does not match", func(t *testing.T) {
+		apiKey := *mocks.APIKey
+		repo.APIKey.EXPECT().FindByPrefix(ctx, "abcd1234").Return(&apiKey, nil).Times(1)
+
+		resp, err := uc.Authenticate(ctx, "sf_abcd1234_wrong")
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "invalid api key")
+	})
+
+	t.Run("should return error when api key has expired", func(t *testing.T) {
+		apiKey := *mocks.APIKey
+		expired := time.Now().Add(-time.Minute)
+		apiKey.ExpiresAt = &expired
+		repo.APIKey.EXPECT().FindByPrefix(ctx, "abcd1234").Return(&apiKey, nil).Times(1)
+
+		resp, err := uc.Authenticate(ctx, mocks.Key)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "api key has expired")
+	})
+
+	t.Run("should return error when owner is deleted", func(t *testing.T) {
+		apiKey := *mocks.APIKey
+		repo.APIKey.EXPECT().FindByPrefix(ctx, "abcd1234").Return(&apiKey, nil).Times(1)
+		repo.User.EXPECT().FindWithRoleByID(ctx, apiKey.OwnerID).Return(nil, utils.NewNotFoundError("user not found")).Times(1)
+
+		resp, err := uc.Authenticate(ctx, mocks.Key)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "api key owner not found")
+	})
+
+	t.Run("should return error when format is invalid", func(t *testing.T) {
+		resp, err := uc.Authenticate(ctx, "not-a-key")
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "invalid api key")
+	})
+}
@@ -2,10 +2,13 @@ package usecase_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/ryvasa/go-super-farmer/internal/model/domain"
@@ -13,6 +16,7 @@ import (
 	mock_repo "github.com/ryvasa/go-super-farmer/internal/repository/mock"
 	usecase_implementation "github.com/ryvasa/go-super-farmer/internal/usecase/implementation"
 	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/pkg/auth/token"
 	mockToken "github.com/ryvasa/go-super-farmer/pkg/auth/token/mock"
 	mock_pkg "github.com/ryvasa/go-super-farmer/pkg/mock"
 	"github.com/ryvasa/go-super-farmer/utils"
@@ -27,6 +31,7 @@ type AuthRepoMock struct {
 	RabbitMQ *mock_pkg.MockRabbitMQ
 	Cache    *mock_pkg.MockCache
 	OTP      *mock_utils.MockOTP
+	TOTP     *mock_utils.MockTOTP
 }
 
 type AuthIDs struct {
@@ -34,16 +39,18 @@ type AuthIDs struct {
 }
 
 type AuthMocks struct {
-	User  *domain.User
-	Auth  *dto.AuthResponseDTO
-	Token string
-	OTP   string
+	User         *domain.User
+	Auth         *dto.AuthResponseDTO
+	Token        string
+	RefreshToken string
+	OTP          string
 }
 
 type AuthDTOMock struct {
 	Login     *dto.AuthDTO
 	SendOTP   *dto.AuthSendDTO
 	VerifyOTP *dto.AuthVerifyDTO
+	Refresh   *dto.AuthRefreshDTO
 }
 
 func AuthUsecaseUtils(t *testing.T) (*AuthIDs, *AuthMocks, *AuthDTOMock, *AuthRepoMock, usecase_interface.AuthUsecase, context.Context) {
@@ -68,8 +75,9 @@ func AuthUsecaseUtils(t *testing.T) (*AuthIDs, *AuthMocks, *AuthDTOMock, *AuthRe
 			},
 			Token: "mocked.jwt.token",
 		},
-		Token: "generated token",
-		OTP:   "123456",
+		Token:        "generated token",
+		RefreshToken: "generated refresh token",
+		OTP:          "123456",
 	}
 
 	dto := &AuthDTOMock{
@@ -84,6 +92,9 @@ func AuthUsecaseUtils(t *testing.T) (*AuthIDs, *AuthMocks, *AuthDTOMock, *AuthRe
 			Email: "test@example.com",
 			OTP:   "123456",
 		},
+		Refresh: &dto.AuthRefreshDTO{
+			RefreshToken: "old refresh token",
+		},
 	}
 
 	ctrl := gomock.NewController(t)
@@ -94,10 +105,11 @@ func AuthUsecaseUtils(t *testing.T) (*AuthIDs, *AuthMocks, *AuthDTOMock, *AuthRe
 	rabbitMQ := mock_pkg.NewMockRabbitMQ(ctrl)
 	cache := mock_pkg.NewMockCache(ctrl)
 	otp := mock_utils.NewMockOTP(ctrl)
-	uc := usecase_implementation.NewAuthUsecase(userRepo, utilToken, hash, rabbitMQ, cache, otp)
+	totp := mock_utils.NewMockTOTP(ctrl)
+	uc := usecase_implementation.NewAuthUsecase(userRepo, utilToken, hash, rabbitMQ, cache, otp, totp)
 	ctx := context.TODO()
 
-	repo := &AuthRepoMock{User: userRepo, Token: utilToken, Hash: hash, RabbitMQ: rabbitMQ, Cache: cache, OTP: otp}
+	repo := &AuthRepoMock{User: userRepo, Token: utilToken, Hash: hash, RabbitMQ: rabbitMQ, Cache: cache, OTP: otp, TOTP: totp}
 
 	return ids, mocks, dto, repo, uc, ctx
 }
@@ -105,22 +117,53 @@ func AuthUsecaseUtils(t *testing.T) (*AuthIDs, *AuthMocks, *AuthDTOMock, *AuthRe
 func TestAuthUsecase_Login(t *testing.T) {
 	_, mocks, dtos, repo, uc, ctx := AuthUsecaseUtils(t)
 
+	attemptKey := fmt.Sprintf("login_attempts:email:%s", dtos.Login.Email)
+	lockKey := fmt.Sprintf("login_lock:email:%s", dtos.Login.Email)
+
 	t.Run("should login successfully", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
 		repo.User.EXPECT().FindByEmail(ctx, dtos.Login.Email).Return(mocks.User, nil).Times(1)
 		repo.Hash.EXPECT().ValidatePassword(dtos.Login.Password, mocks.User.Password).Return(true).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, attemptKey).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, lockKey).Return(nil).Times(1)
 
 		repo.Token.EXPECT().GenerateToken(mocks.User.ID, mocks.User.Role.Name).Return(mocks.Token, nil).Times(1)
+		repo.Token.EXPECT().GenerateRefreshToken().Return(mocks.RefreshToken, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.RefreshTokenKey(mocks.RefreshToken), gomock.Any(), token.RefreshTokenTTL).Return(nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, gomock.Any(), []byte(mocks.User.ID.String()), token.RefreshTokenTTL).Return(nil).Times(1)
 
 		resp, err := uc.Login(ctx, dtos.Login)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
 		assert.Equal(t, mocks.Token, resp.Token)
+		assert.Equal(t, mocks.RefreshToken, resp.RefreshToken)
 		assert.Equal(t, mocks.User.ID, resp.User.ID)
 		assert.Equal(t, mocks.User.Name, resp.User.Name)
 		assert.Equal(t, mocks.User.Email, resp.User.Email)
 	})
 
+	t.Run("should keep the ip counter after a successful login", func(t *testing.T) {
+		req := &dto.AuthDTO{Email: dtos.Login.Email, Password: dtos.Login.Password, IP: "10.0.0.1"}
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, "login_lock:ip:10.0.0.1").Return(nil, nil).Times(1)
+		repo.User.EXPECT().FindByEmail(ctx, req.Email).Return(mocks.User, nil).Times(1)
+		repo.Hash.EXPECT().ValidatePassword(req.Password, mocks.User.Password).Return(true).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, attemptKey).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, lockKey).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, "login_attempts:ip:10.0.0.1").Times(0)
+		repo.Cache.EXPECT().Delete(ctx, "login_lock:ip:10.0.0.1").Times(0)
+		repo.Token.EXPECT().GenerateToken(mocks.User.ID, mocks.User.Role.Name).Return(mocks.Token, nil).Times(1)
+		repo.Token.EXPECT().GenerateRefreshToken().Return(mocks.RefreshToken, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.RefreshTokenKey(mocks.RefreshToken), gomock.Any(), token.RefreshTokenTTL).Return(nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, gomock.Any(), []byte(mocks.User.ID.String()), token.RefreshTokenTTL).Return(nil).Times(1)
+
+		resp, err := uc.Login(ctx, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	t.Run("should return error validation error", func(t *testing.T) {
 		resp, err := uc.Login(ctx, &dto.AuthDTO{Email: "", Password: "123456"})
 
@@ -130,20 +173,26 @@ func TestAuthUsecase_Login(t *testing.T) {
 	})
 
 	t.Run("should return error get user by email", func(t *testing.T) {
-
-		repo.User.EXPECT().FindByEmail(ctx, dtos.Login.Email).Return(nil, utils.NewBadRequestError("invalid password or email")).Times(1)
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
+		repo.User.EXPECT().FindByEmail(ctx, dtos.Login.Email).Return(nil, utils.NewNotFoundError("user not found")).Times(1)
+		// The password is still compared against a dummy hash to keep the timing the same
+		repo.Hash.EXPECT().ValidatePassword(dtos.Login.Password, gomock.Not(gomock.Eq(""))).Return(false).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, attemptKey, gomock.Any()).Return(int64(1), nil).Times(1)
 
 		resp, err := uc.Login(ctx, dtos.Login)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
-		assert.EqualError(t, err, "invalid password or email")
+		assert.Equal(t, utils.NewAuthFailedError(), err)
 	})
 
 	t.Run("should return error generate token", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
 		repo.User.EXPECT().FindByEmail(ctx, mocks.User.Email).Return(mocks.User, nil).Times(1)
 
 		repo.Hash.EXPECT().ValidatePassword(dtos.Login.Password, mocks.User.Password).Return(true).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, attemptKey).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, lockKey).Return(nil).Times(1)
 
 		repo.Token.EXPECT().GenerateToken(mocks.User.ID, mocks.User.Role.Name).Return("", utils.NewInternalError("internal error")).Times(1)
 
@@ -156,14 +205,227 @@ func TestAuthUsecase_Login(t *testing.T) {
 	t.Run("should return error when password is not valid", func(t *testing.T) {
 		dtos.Login.Password = "123456"
 
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
 		repo.Hash.EXPECT().ValidatePassword(dtos.Login.Password, mocks.User.Password).Return(false).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, attemptKey, gomock.Any()).Return(int64(1), nil).Times(1)
+
+		repo.User.EXPECT().FindByEmail(ctx, mocks.User.Email).Return(mocks.User, nil).Times(1)
+		resp, err := uc.Login(ctx, dtos.Login)
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "invalid credentials")
+	})
 
+	t.Run("should lock account after too many failed attempts", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
 		repo.User.EXPECT().FindByEmail(ctx, mocks.User.Email).Return(mocks.User, nil).Times(1)
+		repo.Hash.EXPECT().ValidatePassword(dtos.Login.Password, mocks.User.Password).Return(false).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, attemptKey, gomock.Any()).Return(int64(6), nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, lockKey, gomock.Any(), 2*time.Minute).Return(nil).Times(1)
+
 		resp, err := uc.Login(ctx, dtos.Login)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
-		assert.EqualError(t, err, "invalid password or email")
+		assert.EqualError(t, err, "invalid credentials")
+	})
+
+	t.Run("should count attempts per ip", func(t *testing.T) {
+		ipKey := "login_attempts:ip:10.0.0.1"
+		req := &dto.AuthDTO{Email: dtos.Login.Email, Password: dtos.Login.Password, IP: "10.0.0.1"}
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, "login_lock:ip:10.0.0.1").Return(nil, nil).Times(1)
+		repo.User.EXPECT().FindByEmail(ctx, mocks.User.Email).Return(nil, utils.NewNotFoundError("user not found")).Times(1)
+		repo.Hash.EXPECT().ValidatePassword(req.Password, gomock.Any()).Return(false).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, attemptKey, gomock.Any()).Return(int64(1), nil).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, ipKey, gomock.Any()).Return(int64(1), nil).Times(1)
+
+		resp, err := uc.Login(ctx, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "invalid credentials")
+	})
+
+	t.Run("should return error when account is locked", func(t *testing.T) {
+		until := fmt.Sprintf("%d", time.Now().Add(time.Minute).Unix())
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return([]byte(until), nil).Times(1)
+
+		resp, err := uc.Login(ctx, dtos.Login)
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, http.StatusTooManyRequests, utils.GetStatusCode(err))
+	})
+}
+
+func TestAuthUsecase_RefreshToken(t *testing.T) {
+	ids, mocks, dtos, repo, uc, ctx := AuthUsecaseUtils(t)
+
+	family := "family-id"
+	session, _ := json.Marshal(map[string]interface{}{
+		"user_id":   ids.UserID,
+		"role":      "Farmer",
+		"family":    family,
+		"issued_at": time.Now().UnixMilli(),
+	})
+	oldKey := token.RefreshTokenKey(dtos.Refresh.RefreshToken)
+
+	t.Run("should rotate refresh token successfully", func(t *testing.T) {
+		user := *mocks.User
+		user.Role = domain.Role{Name: "Farmer"}
+		repo.Cache.EXPECT().GetDel(ctx, oldKey).Return(session, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.UsedRefreshTokenKey(dtos.Refresh.RefreshToken), []byte(family), token.RefreshTokenTTL).Return(nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, token.RefreshFamilyKey(family)).Return([]byte(ids.UserID.String()), nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, token.RevokedUserKey(ids.UserID.String())).Return(nil, nil).Times(1)
+		repo.User.EXPECT().FindWithRoleByID(ctx, ids.UserID).Return(&user, nil).Times(1)
+		repo.Token.EXPECT().GenerateToken(ids.UserID, "Farmer").Return(mocks.Token, nil).Times(1)
+		repo.Token.EXPECT().GenerateRefreshToken().Return(mocks.RefreshToken, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.RefreshTokenKey(mocks.RefreshToken), gomock.Any(), token.RefreshTokenTTL).Return(nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.RefreshFamilyKey(family), []byte(ids.UserID.String()), token.RefreshTokenTTL).Return(nil).Times(1)
+
+		resp, err := uc.RefreshToken(ctx, dtos.Refresh)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Equal(t, mocks.Token, resp.Token)
+		assert.Equal(t, mocks.RefreshToken, resp.RefreshToken)
+	})
+
+	t.Run("should issue the access token with the current role", func(t *testing.T) {
+		user := *mocks.User
+		user.Role = domain.Role{Name: "Officer"}
+		repo.Cache.EXPECT().GetDel(ctx, oldKey).Return(session, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.UsedRefreshTokenKey(dtos.Refresh.RefreshToken), []byte(family), token.RefreshTokenTTL).Return(nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, token.RefreshFamilyKey(family)).Return([]byte(ids.UserID.String()), nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, token.RevokedUserKey(ids.UserID.String())).Return(nil, nil).Times(1)
+		repo.User.EXPECT().FindWithRoleByID(ctx, ids.UserID).Return(&user, nil).Times(1)
+		repo.Token.EXPECT().GenerateToken(ids.UserID, "Officer").Return(mocks.Token, nil).Times(1)
+		repo.Token.EXPECT().GenerateRefreshToken().Return(mocks.RefreshToken, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.RefreshTokenKey(mocks.RefreshToken), gomock.Any(), token.RefreshTokenTTL).Return(nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.RefreshFamilyKey(family), []byte(ids.UserID.String()), token.RefreshTokenTTL).Return(nil).Times(1)
+
+		resp, err := uc.RefreshToken(ctx, dtos.Refresh)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("should return error validation error", func(t *testing.T) {
+		resp, err := uc.RefreshToken(ctx, &dto.AuthRefreshDTO{})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "Validation failed")
+	})
+
+	t.Run("should return error when refresh token is invalid", func(t *testing.T) {
+		repo.Cache.EXPECT().GetDel(ctx, oldKey).Return(nil, nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, token.UsedRefreshTokenKey(dtos.Refresh.RefreshToken)).Return(nil, nil).Times(1)
+
+		resp, err := uc.RefreshToken(ctx, dtos.Refresh)
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "invalid refresh token")
+	})
+
+	t.Run("should revoke family when refresh token is reused", func(t *testing.T) {
+		repo.Cache.EXPECT().GetDel(ctx, oldKey).Return(nil, nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, token.UsedRefreshTokenKey(dtos.Refresh.RefreshToken)).Return([]byte(family), nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, token.RefreshFamilyKey(family)).Return(nil).Times(1)
+
+		resp, err := uc.RefreshToken(ctx, dtos.Refresh)
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "refresh token reuse detected")
+	})
+
+	t.Run("should return error when family is revoked", func(t *testing.T) {
+		repo.Cache.EXPECT().GetDel(ctx, oldKey).Return(session, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.UsedRefreshTokenKey(dtos.Refresh.RefreshToken), []byte(family), token.RefreshTokenTTL).Return(nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, token.RefreshFamilyKey(family)).Return(nil, nil).Times(1)
+
+		resp, err := uc.RefreshToken(ctx, dtos.Refresh)
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "refresh token has been revoked")
+	})
+
+	t.Run("should return error when user sessions are revoked", func(t *testing.T) {
+		revokedAt := fmt.Sprintf("%d", time.Now().Add(time.Millisecond).UnixMilli())
+		repo.Cache.EXPECT().GetDel(ctx, oldKey).Return(session, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.UsedRefreshTokenKey(dtos.Refresh.RefreshToken), []byte(family), token.RefreshTokenTTL).Return(nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, token.RefreshFamilyKey(family)).Return([]byte(ids.UserID.String()), nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, token.RevokedUserKey(ids.UserID.String())).Return([]byte(revokedAt), nil).Times(1)
+
+		resp, err := uc.RefreshToken(ctx, dtos.Refresh)
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "refresh token has been revoked")
+	})
+}
+
+func TestAuthUsecase_Logout(t *testing.T) {
+	ids, _, _, repo, uc, ctx := AuthUsecaseUtils(t)
+
+	jti := "token-id"
+	claims := jwt.MapClaims{
+		"sub": ids.UserID.String(),
+		"jti": jti,
+		"exp": float64(time.Now().Add(time.Minute).Unix()),
+	}
+	family := "family-id"
+	session, _ := json.Marshal(map[string]interface{}{
+		"user_id": ids.UserID,
+		"family":  family,
+	})
+
+	t.Run("should revoke access token successfully", func(t *testing.T) {
+		repo.Cache.EXPECT().Set(ctx, token.RevokedTokenKey(jti), []byte("1"), gomock.Any()).Return(nil).Times(1)
+
+		err := uc.Logout(ctx, claims, &dto.AuthLogoutDTO{})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should revoke refresh token and all sessions successfully", func(t *testing.T) {
+		repo.Cache.EXPECT().Set(ctx, token.RevokedTokenKey(jti), []byte("1"), gomock.Any()).Return(nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, token.RefreshTokenKey("refresh")).Return(session, nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, token.RefreshFamilyKey(family)).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, token.RefreshTokenKey("refresh")).Return(nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.RevokedUserKey(ids.UserID.String()), gomock.Any(), token.RefreshTokenTTL).Return(nil).Times(1)
+
+		err := uc.Logout(ctx, claims, &dto.AuthLogoutDTO{RefreshToken: "refresh", All: true})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when refresh token belongs to another user", func(t *testing.T) {
+		other, _ := json.Marshal(map[string]interface{}{
+			"user_id": uuid.New(),
+			"family":  family,
+		})
+		repo.Cache.EXPECT().Set(ctx, token.RevokedTokenKey(jti), []byte("1"), gomock.Any()).Return(nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, token.RefreshTokenKey("refresh")).Return(other, nil).Times(1)
+
+		err := uc.Logout(ctx, claims, &dto.AuthLogoutDTO{RefreshToken: "refresh"})
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "refresh token does not belong to user")
+	})
+
+	t.Run("should return error when revoke token fails", func(t *testing.T) {
+		repo.Cache.EXPECT().Set(ctx, token.RevokedTokenKey(jti), []byte("1"), gomock.Any()).Return(utils.NewInternalError("cache error")).Times(1)
+
+		err := uc.Logout(ctx, claims, &dto.AuthLogoutDTO{})
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "Failed to revoke token")
 	})
 }
 
@@ -188,13 +450,12 @@ func TestAuthUsecase_SendOTP(t *testing.T) {
 		assert.EqualError(t, err, "Validation failed")
 	})
 
-	t.Run("should return error when user not found", func(t *testing.T) {
+	t.Run("should not reveal when user not found", func(t *testing.T) {
 		repo.User.EXPECT().FindByEmail(ctx, dtos.SendOTP.Email).
-			Return(nil, utils.NewBadRequestError("user not found"))
+			Return(nil, utils.NewNotFoundError("user not found"))
 
 		err := uc.SendOTP(ctx, dtos.SendOTP)
-		assert.Error(t, err)
-		assert.EqualError(t, err, "user not found")
+		assert.NoError(t, err)
 	})
 
 	t.Run("should return error when cache fails", func(t *testing.T) {
@@ -235,12 +496,18 @@ func TestAuthUsecase_SendOTP(t *testing.T) {
 func TestAuthUsecase_VerifyOTP(t *testing.T) {
 	_, mocks, dtos, repo, uc, ctx := AuthUsecaseUtils(t)
 
+	attemptKey := fmt.Sprintf("login_attempts:email:%s", dtos.VerifyOTP.Email)
+	lockKey := fmt.Sprintf("login_lock:email:%s", dtos.VerifyOTP.Email)
+
 	t.Run("should successfully verify OTP", func(t *testing.T) {
 		key := fmt.Sprintf("otp:%s", mocks.User.Email)
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil)
 		repo.Cache.EXPECT().Get(ctx, key).Return([]byte(mocks.OTP), nil)
 		repo.User.EXPECT().FindByEmail(ctx, dtos.VerifyOTP.Email).Return(mocks.User, nil)
 		repo.User.EXPECT().Update(ctx, mocks.User.ID, mocks.User).Return(nil)
 		repo.Cache.EXPECT().Delete(ctx, key).Return(nil)
+		repo.Cache.EXPECT().Delete(ctx, attemptKey).Return(nil)
+		repo.Cache.EXPECT().Delete(ctx, lockKey).Return(nil)
 
 		err := uc.VerifyOTP(ctx, dtos.VerifyOTP)
 		assert.NoError(t, err)
@@ -258,24 +525,29 @@ func TestAuthUsecase_VerifyOTP(t *testing.T) {
 
 	t.Run("should return error when OTP not found", func(t *testing.T) {
 		key := fmt.Sprintf("otp:%s", dtos.VerifyOTP.Email)
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil)
 		repo.Cache.EXPECT().Get(ctx, key).Return(nil, nil)
+		repo.Cache.EXPECT().Incr(ctx, attemptKey, gomock.Any()).Return(int64(1), nil)
 
 		err := uc.VerifyOTP(ctx, dtos.VerifyOTP)
 		assert.Error(t, err)
-		assert.EqualError(t, err, "OTP expired or not found")
+		assert.Equal(t, utils.NewAuthFailedError(), err)
 	})
 
 	t.Run("should return error when OTP is invalid", func(t *testing.T) {
 		key := fmt.Sprintf("otp:%s", dtos.VerifyOTP.Email)
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil)
 		repo.Cache.EXPECT().Get(ctx, key).Return([]byte("wrong-otp"), nil)
+		repo.Cache.EXPECT().Incr(ctx, attemptKey, gomock.Any()).Return(int64(1), nil)
 
 		err := uc.VerifyOTP(ctx, dtos.VerifyOTP)
 		assert.Error(t, err)
-		assert.EqualError(t, err, "Invalid OTP")
+		assert.Equal(t, utils.NewAuthFailedError(), err)
 	})
 
 	t.Run("should return error when cache delete fails", func(t *testing.T) {
 		key := fmt.Sprintf("otp:%s", dtos.VerifyOTP.Email)
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil)
 		repo.Cache.EXPECT().Get(ctx, key).Return([]byte(mocks.OTP), nil)
 		repo.User.EXPECT().FindByEmail(ctx, dtos.VerifyOTP.Email).Return(mocks.User, nil)
 		repo.User.EXPECT().Update(ctx, mocks.User.ID, mocks.User).Return(nil)
@@ -288,6 +560,7 @@ func TestAuthUsecase_VerifyOTP(t *testing.T) {
 
 	t.Run("should return error when cache get fails", func(t *testing.T) {
 		key := fmt.Sprintf("otp:%s", dtos.VerifyOTP.Email)
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil)
 		repo.Cache.EXPECT().Get(ctx, key).Return(nil, utils.NewInternalError("cache error"))
 
 		err := uc.VerifyOTP(ctx, dtos.VerifyOTP)
@@ -295,3 +568,414 @@ func TestAuthUsecase_VerifyOTP(t *testing.T) {
 		assert.Contains(t, err.Error(), "Failed to get OTP")
 	})
 }
+
+func TestAuthUsecase_UnlockAccount(t *testing.T) {
+	_, mocks, _, repo, uc, ctx := AuthUsecaseUtils(t)
+
+	t.Run("should unlock account successfully", func(t *testing.T) {
+		repo.Cache.EXPECT().Delete(ctx, fmt.Sprintf("login_attempts:email:%s", mocks.User.Email)).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, fmt.Sprintf("login_lock:email:%s", mocks.User.Email)).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, "login_attempts:ip:10.0.0.1").Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, "login_lock:ip:10.0.0.1").Return(nil).Times(1)
+
+		err := uc.UnlockAccount(ctx, &dto.AuthUnlockDTO{Email: mocks.User.Email, IP: "10.0.0.1"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when validation fails", func(t *testing.T) {
+		err := uc.UnlockAccount(ctx, &dto.AuthUnlockDTO{Email: "invalid"})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "Validation failed")
+	})
+
+	t.Run("should return error when cache delete fails", func(t *testing.T) {
+		repo.Cache.EXPECT().Delete(ctx, fmt.Sprintf("login_attempts:email:%s", mocks.User.Email)).Return(utils.NewInternalError("cache error")).Times(1)
+
+		err := uc.UnlockAccount(ctx, &dto.AuthUnlockDTO{Email: mocks.User.Email})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "Failed to unlock account")
+	})
+}
+
+func TestAuthUsecase_ForgotPassword(t *testing.T) {
+	_, mocks, _, repo, uc, ctx := AuthUsecaseUtils(t)
+
+	req := &dto.AuthForgotPasswordDTO{Email: mocks.User.Email}
+	key := fmt.Sprintf("password_reset:%s", mocks.User.Email)
+
+	t.Run("should send reset token successfully", func(t *testing.T) {
+		repo.User.EXPECT().FindByEmail(ctx, req.Email).Return(mocks.User, nil).Times(1)
+		repo.OTP.EXPECT().GenerateOTP(6).Return(mocks.OTP, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, key, []byte(mocks.OTP), 15*time.Minute).Return(nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "mail-exchange", "reset-password", gomock.Any()).Return(nil).Times(1)
+
+		err := uc.ForgotPassword(ctx, req)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when validation fails", func(t *testing.T) {
+		err := uc.ForgotPassword(ctx, &dto.AuthForgotPasswordDTO{})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "Validation failed")
+	})
+
+	t.Run("should not reveal when user not found", func(t *testing.T) {
+		repo.User.EXPECT().FindByEmail(ctx, req.Email).Return(nil, utils.NewNotFoundError("user not found")).Times(1)
+
+		err := uc.ForgotPassword(ctx, req)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when cache fails", func(t *testing.T) {
+		repo.User.EXPECT().FindByEmail(ctx, req.Email).Return(mocks.User, nil).Times(1)
+		repo.OTP.EXPECT().GenerateOTP(6).Return(mocks.OTP, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, key, []byte(mocks.OTP), 15*time.Minute).Return(utils.NewInternalError("cache error")).Times(1)
+
+		err := uc.ForgotPassword(ctx, req)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "Failed to store reset token")
+	})
+
+	t.Run("should return error when rabbitmq fails", func(t *testing.T) {
+		repo.User.EXPECT().FindByEmail(ctx, req.Email).Return(mocks.User, nil).Times(1)
+		repo.OTP.EXPECT().GenerateOTP(6).Return(mocks.OTP, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, key, []byte(mocks.OTP), 15*time.Minute).Return(nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "mail-exchange", "reset-password", gomock.Any()).Return(utils.NewInternalError("rabbitmq error")).Times(1)
+
+		err := uc.ForgotPassword(ctx, req)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "rabbitmq error")
+	})
+}
+
+func TestAuthUsecase_ResetPassword(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := AuthUsecaseUtils(t)
+
+	req := &dto.AuthResetPasswordDTO{Email: mocks.User.Email, OTP: mocks.OTP, Password: "new-password"}
+	key := fmt.Sprintf("password_reset:%s", mocks.User.Email)
+	attemptKey := fmt.Sprintf("login_attempts:email:%s", mocks.User.Email)
+	lockKey := fmt.Sprintf("login_lock:email:%s", mocks.User.Email)
+
+	t.Run("should reset password and revoke sessions successfully", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, key).Return([]byte(mocks.OTP), nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, key).Return(nil).Times(1)
+		repo.User.EXPECT().FindByEmail(ctx, req.Email).Return(mocks.User, nil).Times(1)
+		repo.Hash.EXPECT().HashPassword(req.Password).Return("hashed", nil).Times(1)
+		repo.User.EXPECT().Update(ctx, ids.UserID, &domain.User{Password: "hashed"}).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, attemptKey).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, lockKey).Return(nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.RevokedUserKey(ids.UserID.String()), gomock.Any(), token.RefreshTokenTTL).Return(nil).Times(1)
+
+		err := uc.ResetPassword(ctx, req)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when validation fails", func(t *testing.T) {
+		err := uc.ResetPassword(ctx, &dto.AuthResetPasswordDTO{Email: mocks.User.Email})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "Validation failed")
+	})
+
+	t.Run("should return error when reset token is used or expired", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, key).Return(nil, nil).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, attemptKey, gomock.Any()).Return(int64(1), nil).Times(1)
+
+		err := uc.ResetPassword(ctx, req)
+		assert.Error(t, err)
+		assert.Equal(t, utils.NewAuthFailedError(), err)
+	})
+
+	t.Run("should return error when reset token is invalid", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, key).Return([]byte("654321"), nil).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, attemptKey, gomock.Any()).Return(int64(1), nil).Times(1)
+
+		err := uc.ResetPassword(ctx, req)
+		assert.Error(t, err)
+		assert.Equal(t, utils.NewAuthFailedError(), err)
+	})
+
+	t.Run("should return error when update user fails", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, key).Return([]byte(mocks.OTP), nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, key).Return(nil).Times(1)
+		repo.User.EXPECT().FindByEmail(ctx, req.Email).Return(mocks.User, nil).Times(1)
+		repo.Hash.EXPECT().HashPassword(req.Password).Return("hashed", nil).Times(1)
+		repo.User.EXPECT().Update(ctx, ids.UserID, &domain.User{Password: "hashed"}).Return(utils.NewInternalError("db error")).Times(1)
+
+		err := uc.ResetPassword(ctx, req)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "Failed to update user")
+	})
+}
+
+func TestAuthUsecase_LoginTwoFactor(t *testing.T) {
+	_, mocks, dtos, repo, uc, ctx := AuthUsecaseUtils(t)
+
+	lockKey := fmt.Sprintf("login_lock:email:%s", dtos.Login.Email)
+	attemptKey := fmt.Sprintf("login_attempts:email:%s", dtos.Login.Email)
+	secret := "JBSWY3DPEHPK3PXP"
+
+	t.Run("should return mfa challenge when totp is enabled", func(t *testing.T) {
+		user := *mocks.User
+		user.TOTPEnabled = true
+		user.TOTPSecret = &secret
+
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
+		repo.User.EXPECT().FindByEmail(ctx, dtos.Login.Email).Return(&user, nil).Times(1)
+		repo.Hash.EXPECT().ValidatePassword(dtos.Login.Password, user.Password).Return(true).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, attemptKey).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, lockKey).Return(nil).Times(1)
+		repo.Token.EXPECT().GenerateRefreshToken().Return("challenge", nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, "mfa_challenge:challenge", []byte(user.Email), 5*time.Minute).Return(nil).Times(1)
+
+		resp, err := uc.Login(ctx, dtos.Login)
+
+		assert.NoError(t, err)
+		assert.True(t, resp.MFARequired)
+		assert.Equal(t, "challenge", resp.MFAToken)
+		assert.Empty(t, resp.Token)
+		assert.Empty(t, resp.RefreshToken)
+	})
+
+	t.Run("should return enrollment token when admin has no totp", func(t *testing.T) {
+		user := *mocks.User
+		user.Role = domain.Role{ID: 1, Name: "Admin"}
+
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
+		repo.User.EXPECT().FindByEmail(ctx, dtos.Login.Email).Return(&user, nil).Times(1)
+		repo.Hash.EXPECT().ValidatePassword(dtos.Login.Password, user.Password).Return(true).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, attemptKey).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, lockKey).Return(nil).Times(1)
+		repo.Token.EXPECT().GenerateToken(user.ID, token.MFAEnrollmentRole).Return(mocks.Token, nil).Times(1)
+
+		resp, err := uc.Login(ctx, dtos.Login)
+
+		assert.NoError(t, err)
+		assert.True(t, resp.MFAEnrollmentRequired)
+		assert.Equal(t, mocks.Token, resp.Token)
+		assert.Empty(t, resp.RefreshToken)
+	})
+}
+
+func TestAuthUsecase_VerifyMFA(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := AuthUsecaseUtils(t)
+
+	secret := "JBSWY3DPEHPK3PXP"
+	recovery := utils.HashRecoveryCode("aaaaa-bbbbb") + "," + utils.HashRecoveryCode("ccccc-ddddd")
+	user := *mocks.User
+	user.TOTPEnabled = true
+	user.TOTPSecret = &secret
+	user.RecoveryCodes = &recovery
+
+	challengeKey := "mfa_challenge:challenge"
+	lockKey := fmt.Sprintf("login_lock:email:%s", user.Email)
+	attemptKey := fmt.Sprintf("login_attempts:email:%s", user.Email)
+	usedKey := fmt.Sprintf("totp_used:%s:123456", ids.UserID)
+
+	expectTokens := func() {
+		repo.Cache.EXPECT().Delete(ctx, challengeKey).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, attemptKey).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, lockKey).Return(nil).Times(1)
+		repo.Token.EXPECT().GenerateToken(ids.UserID, user.Role.Name).Return(mocks.Token, nil).Times(1)
+		repo.Token.EXPECT().GenerateRefreshToken().Return(mocks.RefreshToken, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.RefreshTokenKey(mocks.RefreshToken), gomock.Any(), token.RefreshTokenTTL).Return(nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, gomock.Any(), []byte(ids.UserID.String()), token.RefreshTokenTTL).Return(nil).Times(1)
+	}
+
+	t.Run("should verify totp code successfully", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, challengeKey).Return([]byte(user.Email), nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
+		repo.User.EXPECT().FindByEmail(ctx, user.Email).Return(&user, nil).Times(1)
+		repo.TOTP.EXPECT().Validate(secret, "123456").Return(true).Times(1)
+		repo.Cache.EXPECT().Get(ctx, usedKey).Return(nil, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, usedKey, []byte("1"), 90*time.Second).Return(nil).Times(1)
+		expectTokens()
+
+		resp, err := uc.VerifyMFA(ctx, &dto.AuthMFAVerifyDTO{MFAToken: "challenge", Code: "123456"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, mocks.Token, resp.Token)
+		assert.Equal(t, mocks.RefreshToken, resp.RefreshToken)
+	})
+
+	t.Run("should consume recovery code successfully", func(t *testing.T) {
+		remaining := utils.HashRecoveryCode("ccccc-ddddd")
+		repo.Cache.EXPECT().Get(ctx, challengeKey).Return([]byte(user.Email), nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
+		repo.User.EXPECT().FindByEmail(ctx, user.Email).Return(&user, nil).Times(1)
+		repo.User.EXPECT().UpdateTwoFactor(ctx, ids.UserID, gomock.Any()).DoAndReturn(func(_ context.Context, _ uuid.UUID, u *domain.User) error {
+			assert.Equal(t, remaining, *u.RecoveryCodes)
+			assert.True(t, u.TOTPEnabled)
+			return nil
+		}).Times(1)
+		expectTokens()
+
+		resp, err := uc.VerifyMFA(ctx, &dto.AuthMFAVerifyDTO{MFAToken: "challenge", RecoveryCode: "AAAAA-BBBBB"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, mocks.Token, resp.Token)
+	})
+
+	t.Run("should return error when challenge is invalid", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, challengeKey).Return(nil, nil).Times(1)
+
+		resp, err := uc.VerifyMFA(ctx, &dto.AuthMFAVerifyDTO{MFAToken: "challenge", Code: "123456"})
+
+		assert.Nil(t, resp)
+		assert.Equal(t, utils.NewAuthFailedError(), err)
+	})
+
+	t.Run("should return error when code is invalid", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, challengeKey).Return([]byte(user.Email), nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
+		repo.User.EXPECT().FindByEmail(ctx, user.Email).Return(&user, nil).Times(1)
+		repo.TOTP.EXPECT().Validate(secret, "654321").Return(false).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, attemptKey, gomock.Any()).Return(int64(1), nil).Times(1)
+
+		resp, err := uc.VerifyMFA(ctx, &dto.AuthMFAVerifyDTO{MFAToken: "challenge", Code: "654321"})
+
+		assert.Nil(t, resp)
+		assert.Equal(t, utils.NewAuthFailedError(), err)
+	})
+
+	t.Run("should return error when code is replayed", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, challengeKey).Return([]byte(user.Email), nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, lockKey).Return(nil, nil).Times(1)
+		repo.User.EXPECT().FindByEmail(ctx, user.Email).Return(&user, nil).Times(1)
+		repo.TOTP.EXPECT().Validate(secret, "123456").Return(true).Times(1)
+		repo.Cache.EXPECT().Get(ctx, usedKey).Return([]byte("1"), nil).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, attemptKey, gomock.Any()).Return(int64(1), nil).Times(1)
+
+		resp, err := uc.VerifyMFA(ctx, &dto.AuthMFAVerifyDTO{MFAToken: "challenge", Code: "123456"})
+
+		assert.Nil(t, resp)
+		assert.Equal(t, utils.NewAuthFailedError(), err)
+	})
+
+	t.Run("should return error when validation fails", func(t *testing.T) {
+		resp, err := uc.VerifyMFA(ctx, &dto.AuthMFAVerifyDTO{MFAToken: "challenge"})
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "Validation failed")
+	})
+}
+
+func TestAuthUsecase_SetupTOTP(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := AuthUsecaseUtils(t)
+
+	t.Run("should return provisioning uri successfully", func(t *testing.T) {
+		repo.User.EXPECT().FindAuthByID(ctx, ids.UserID).Return(mocks.User, nil).Times(1)
+		repo.TOTP.EXPECT().GenerateSecret().Return("SECRET", nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, fmt.Sprintf("totp_setup:%s", ids.UserID), []byte("SECRET"), 10*time.Minute).Return(nil).Times(1)
+		repo.TOTP.EXPECT().ProvisioningURI("SECRET", "Super Farmer", mocks.User.Email).Return("otpauth://totp/uri").Times(1)
+
+		resp, err := uc.SetupTOTP(ctx, ids.UserID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SECRET", resp.Secret)
+		assert.Equal(t, "otpauth://totp/uri", resp.URI)
+	})
+
+	t.Run("should return error when already enabled", func(t *testing.T) {
+		user := *mocks.User
+		user.TOTPEnabled = true
+		repo.User.EXPECT().FindAuthByID(ctx, ids.UserID).Return(&user, nil).Times(1)
+
+		resp, err := uc.SetupTOTP(ctx, ids.UserID)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "two-factor authentication already enabled")
+	})
+}
+
+func TestAuthUsecase_EnableTOTP(t *testing.T) {
+	ids, _, _, repo, uc, ctx := AuthUsecaseUtils(t)
+
+	setupKey := fmt.Sprintf("totp_setup:%s", ids.UserID)
+	req := &dto.AuthTOTPCodeDTO{Code: "123456"}
+
+	t.Run("should enable totp and return recovery codes", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, setupKey).Return([]byte("SECRET"), nil).Times(1)
+		repo.TOTP.EXPECT().Validate("SECRET", req.Code).Return(true).Times(1)
+		repo.TOTP.EXPECT().GenerateRecoveryCodes(10).Return([]string{"aaaaa-bbbbb"}, nil).Times(1)
+		repo.User.EXPECT().UpdateTwoFactor(ctx, ids.UserID, gomock.Any()).DoAndReturn(func(_ context.Context, _ uuid.UUID, u *domain.User) error {
+			assert.Equal(t, "SECRET", *u.TOTPSecret)
+			assert.True(t, u.TOTPEnabled)
+			assert.Equal(t, utils.HashRecoveryCode("aaaaa-bbbbb"), *u.RecoveryCodes)
+			return nil
+		}).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, setupKey).Return(nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.RevokedUserKey(ids.UserID.String()), gomock.Any(), token.RefreshTokenTTL).Return(nil).Times(1)
+
+		resp, err := uc.EnableTOTP(ctx, ids.UserID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"aaaaa-bbbbb"}, resp.RecoveryCodes)
+	})
+
+	t.Run("should return error when setup expired", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, setupKey).Return(nil, nil).Times(1)
+
+		resp, err := uc.EnableTOTP(ctx, ids.UserID, req)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "two-factor setup expired or not found")
+	})
+
+	t.Run("should return error when code is invalid", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, setupKey).Return([]byte("SECRET"), nil).Times(1)
+		repo.TOTP.EXPECT().Validate("SECRET", req.Code).Return(false).Times(1)
+
+		resp, err := uc.EnableTOTP(ctx, ids.UserID, req)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "invalid code")
+	})
+}
+
+func TestAuthUsecase_DisableTOTP(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := AuthUsecaseUtils(t)
+
+	secret := "SECRET"
+	req := &dto.AuthTOTPCodeDTO{Code: "123456"}
+
+	t.Run("should disable totp successfully", func(t *testing.T) {
+		user := *mocks.User
+		user.TOTPEnabled = true
+		user.TOTPSecret = &secret
+		repo.User.EXPECT().FindAuthByID(ctx, ids.UserID).Return(&user, nil).Times(1)
+		repo.TOTP.EXPECT().Validate(secret, req.Code).Return(true).Times(1)
+		repo.User.EXPECT().UpdateTwoFactor(ctx, ids.UserID, &domain.User{}).Return(nil).Times(1)
+
+		err := uc.DisableTOTP(ctx, ids.UserID, "Farmer", req)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when role requires totp", func(t *testing.T) {
+		err := uc.DisableTOTP(ctx, ids.UserID, "Admin", req)
+		assert.EqualError(t, err, "two-factor authentication is mandatory for this role")
+	})
+}
+
+func TestTOTP_GenerateCode(t *testing.T) {
+	// RFC 6238 appendix B vectors, truncated to 6 digits
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for ts, expected := range vectors {
+		code, err := utils.GenerateTOTPCode(secret, time.Unix(ts, 0))
+		assert.NoError(t, err)
+		assert.Equal(t, expected, code)
+	}
+
+	totp := utils.NewTOTPWithClock(func() time.Time { return time.Unix(89, 0) })
+	assert.True(t, totp.Validate(secret, "287082"))
+	assert.False(t, totp.Validate(secret, "081804"))
+}
@@ -0,0 +1,203 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	mock_repo "github.com/ryvasa/go-super-farmer/internal/repository/mock"
+	usecase_implementation "github.com/ryvasa/go-super-farmer/internal/usecase/implementation"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type ConversionRepoMock struct {
+	Unit     *mock_repo.MockUnitRepository
+	Currency *mock_repo.MockCurrencyRepository
+}
+
+type ConversionMocks struct {
+	Units []*domain.Unit
+	Rates []*domain.ExchangeRate
+}
+
+func ConversionUsecaseUtils(t *testing.T) (*ConversionMocks, *ConversionRepoMock, usecase_interface.ConversionUsecase, context.Context) {
+	mocks := &ConversionMocks{
+		Units: []*domain.Unit{
+			{Code: "kg", Dimension: domain.UnitDimensionMass, Factor: 1},
+			{Code: "ton", Dimension: domain.UnitDimensionMass, Factor: 1000},
+			{Code: "ha", Dimension: domain.UnitDimensionArea, Factor: 10000},
+		},
+		Rates: []*domain.ExchangeRate{
+			{CurrencyCode: "usd", Rate: 16000, EffectiveDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+			{CurrencyCode: "usd", Rate: 15000, EffectiveDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	unitRepo := mock_repo.NewMockUnitRepository(ctrl)
+	currencyRepo := mock_repo.NewMockCurrencyRepository(ctrl)
+	uc := usecase_implementation.NewConversionUsecase(unitRepo, currencyRepo)
+	ctx := context.TODO()
+
+	repo := &ConversionRepoMock{Unit: unitRepo, Currency: currencyRepo}
+
+	return mocks, repo, uc, ctx
+}
+
+func TestConversionUsecase_ConvertPrices(t *testing.T) {
+	mocks, repo, uc, ctx := ConversionUsecaseUtils(t)
+
+	t.Run("should do nothing when no conversion is requested", func(t *testing.T) {
+		price := &domain.Price{Price: 12000, Unit: "idr"}
+
+		err := uc.ConvertPrices(ctx, &dto.ConversionParamsDTO{}, price)
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(12000), price.Price)
+		assert.Equal(t, "idr", price.Unit)
+	})
+
+	t.Run("should convert price per kg to price per ton in usd", func(t *testing.T) {
+		repo.Unit.EXPECT().FindAll(ctx).Return(mocks.Units, nil).Times(1)
+		repo.Currency.EXPECT().FindByCode(ctx, "usd").Return(&domain.Currency{Code: "usd"}, nil).Times(1)
+		repo.Currency.EXPECT().FindRatesByCurrencyCode(ctx, "usd").Return(mocks.Rates, nil).Times(1)
+		first := &domain.Price{Price: 16000, Unit: "idr"}
+		second := &domain.Price{Price: 8000, Unit: "idr"}
+
+		err := uc.ConvertPrices(ctx, &dto.ConversionParamsDTO{Unit: "TON", Currency: "USD"}, first, second)
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(1000), first.Price)
+		assert.Equal(t, "usd/ton", first.Unit)
+		assert.Equal(t, float64(500), second.Price)
+	})
+
+	t.Run("should return error when unit is not registered", func(t *testing.T) {
+		repo.Unit.EXPECT().FindAll(ctx).Return(mocks.Units, nil).Times(1)
+
+		err := uc.ConvertPrices(ctx, &dto.ConversionParamsDTO{Unit: "bushel"}, &domain.Price{Price: 12000, Unit: "idr"})
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unit bushel is not registered")
+	})
+
+	t.Run("should return error when units have different dimensions", func(t *testing.T) {
+		repo.Unit.EXPECT().FindAll(ctx).Return(mocks.Units, nil).Times(1)
+
+		err := uc.ConvertPrices(ctx, &dto.ConversionParamsDTO{Unit: "ha"}, &domain.Price{Price: 12000, Unit: "idr"})
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "cannot convert kg to ha")
+	})
+
+	t.Run("should return error when currency is not registered", func(t *testing.T) {
+		repo.Unit.EXPECT().FindAll(ctx).Return(mocks.Units, nil).Times(1)
+		repo.Currency.EXPECT().FindByCode(ctx, "eur").Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+
+		err := uc.ConvertPrices(ctx, &dto.ConversionParamsDTO{Currency: "eur"}, &domain.Price{Price: 12000, Unit: "idr"})
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "currency eur is not registered")
+	})
+}
+
+func TestConversionUsecase_ConvertPriceHistories(t *testing.T) {
+	mocks, repo, uc, ctx := ConversionUsecaseUtils(t)
+
+	t.Run("should convert every entry at the rate of its date", func(t *testing.T) {
+		repo.Unit.EXPECT().FindAll(ctx).Return(mocks.Units, nil).Times(1)
+		repo.Currency.EXPECT().FindByCode(ctx, "usd").Return(&domain.Currency{Code: "usd"}, nil).Times(1)
+		repo.Currency.EXPECT().FindRatesByCurrencyCode(ctx, "usd").Return(mocks.Rates, nil).Times(1)
+		march := &domain.PriceHistory{Price: 15000, Unit: "idr", UpdatedAt: time.Date(2024, 3, 10, 8, 0, 0, 0, time.UTC)}
+		july := &domain.PriceHistory{Price: 16000, Unit: "idr", UpdatedAt: time.Date(2024, 7, 10, 8, 0, 0, 0, time.UTC)}
+
+		err := uc.ConvertPriceHistories(ctx, &dto.ConversionParamsDTO{Currency: "usd"}, march, july)
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(1), march.Price)
+		assert.Equal(t, float64(1), july.Price)
+		assert.Equal(t, "usd/kg", july.Unit)
+	})
+
+	t.Run("should return error when there is no rate before the entry", func(t *testing.T) {
+		repo.Unit.EXPECT().FindAll(ctx).Return(mocks.Units, nil).Times(1)
+		repo.Currency.EXPECT().FindByCode(ctx, "usd").Return(&domain.Currency{Code: "usd"}, nil).Times(1)
+		repo.Currency.EXPECT().FindRatesByCurrencyCode(ctx, "usd").Return(mocks.Rates, nil).Times(1)
+		history := &domain.PriceHistory{Price: 15000, Unit: "idr", UpdatedAt: time.Date(2023, 12, 31, 8, 0, 0, 0, time.UTC)}
+
+		err := uc.ConvertPriceHistories(ctx, &dto.ConversionParamsDTO{Currency: "usd"}, history)
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "no usd exchange rate on or before 2023-12-31")
+	})
+}
+
+func TestConversionUsecase_ConvertSales(t *testing.T) {
+	mocks, repo, uc, ctx := ConversionUsecaseUtils(t)
+
+	t.Run("should convert quantity and amount at the rate of the sale date", func(t *testing.T) {
+		repo.Unit.EXPECT().FindAll(ctx).Return(mocks.Units, nil).Times(1)
+		repo.Currency.EXPECT().FindByCode(ctx, "usd").Return(&domain.Currency{Code: "usd"}, nil).Times(1)
+		repo.Currency.EXPECT().FindRatesByCurrencyCode(ctx, "usd").Return(mocks.Rates, nil).Times(1)
+		sale := &domain.Sale{Quantity: 2500, Unit: "kg", Price: 30000000, SaleDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+
+		err := uc.ConvertSales(ctx, &dto.ConversionParamsDTO{Unit: "ton", Currency: "usd"}, sale)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2.5, sale.Quantity)
+		assert.Equal(t, "ton", sale.Unit)
+		assert.Equal(t, float64(2000), sale.Price)
+	})
+
+	t.Run("should keep the total price when only the unit changes", func(t *testing.T) {
+		repo.Unit.EXPECT().FindAll(ctx).Return(mocks.Units, nil).Times(1)
+		sale := &domain.Sale{Quantity: 2500, Unit: "kg", Price: 30000000, SaleDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+
+		err := uc.ConvertSales(ctx, &dto.ConversionParamsDTO{Unit: "ton"}, sale)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2.5, sale.Quantity)
+		assert.Equal(t, float64(30000000), sale.Price)
+	})
+
+	t.Run("should use the registered size of a sack", func(t *testing.T) {
+		units := append([]*domain.Unit{{Code: "sack", Dimension: domain.UnitDimensionMass, Factor: 60}}, mocks.Units...)
+		repo.Unit.EXPECT().FindAll(ctx).Return(units, nil).Times(1)
+		sale := &domain.Sale{Quantity: 3, Unit: "sack", Price: 2160000, SaleDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+
+		err := uc.ConvertSales(ctx, &dto.ConversionParamsDTO{Unit: "kg"}, sale)
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(180), sale.Quantity)
+		assert.Equal(t, "kg", sale.Unit)
+		assert.Equal(t, float64(2160000), sale.Price)
+	})
+}
+
+func TestConversionUsecase_ConvertSupplies(t *testing.T) {
+	mocks, repo, uc, ctx := ConversionUsecaseUtils(t)
+
+	t.Run("should convert quantity to the unit", func(t *testing.T) {
+		repo.Unit.EXPECT().FindAll(ctx).Return(mocks.Units, nil).Times(1)
+		supply := &domain.Supply{Quantity: 1500, Unit: "kg"}
+
+		err := uc.ConvertSupplies(ctx, &dto.ConversionParamsDTO{Unit: "ton"}, supply)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1.5, supply.Quantity)
+		assert.Equal(t, "ton", supply.Unit)
+	})
+
+	t.Run("should return error when currency is requested", func(t *testing.T) {
+		err := uc.ConvertSupplies(ctx, &dto.ConversionParamsDTO{Currency: "usd"}, &domain.Supply{Quantity: 1500, Unit: "kg"})
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "currency only applies to prices and sales")
+	})
+}
@@ -0,0 +1,101 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	mock_repo "github.com/ryvasa/go-super-farmer/internal/repository/mock"
+	usecase_implementation "github.com/ryvasa/go-super-farmer/internal/usecase/implementation"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func CurrencyUsecaseUtils(t *testing.T) (*domain.Currency, *mock_repo.MockCurrencyRepository, usecase_interface.CurrencyUsecase, context.Context) {
+	currency := &domain.Currency{Code: "usd", Name: "US Dollar"}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	currencyRepo := mock_repo.NewMockCurrencyRepository(ctrl)
+	uc := usecase_implementation.NewCurrencyUsecase(currencyRepo)
+	ctx := context.TODO()
+
+	return currency, currencyRepo, uc, ctx
+}
+
+func TestCurrencyUsecase_CreateCurrency(t *testing.T) {
+	currency, repo, uc, ctx := CurrencyUsecaseUtils(t)
+
+	t.Run("should create currency", func(t *testing.T) {
+		repo.EXPECT().FindByCode(ctx, "eur").Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+		repo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(1)
+
+		resp, err := uc.CreateCurrency(ctx, &dto.CurrencyCreateDTO{Code: "EUR", Name: "Euro"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "eur", resp.Code)
+	})
+
+	t.Run("should return error validation error when code is invalid", func(t *testing.T) {
+		resp, err := uc.CreateCurrency(ctx, &dto.CurrencyCreateDTO{Code: "us1", Name: "US Dollar"})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "Validation failed")
+	})
+
+	t.Run("should return error when currency already exists", func(t *testing.T) {
+		repo.EXPECT().FindByCode(ctx, "usd").Return(currency, nil).Times(1)
+
+		resp, err := uc.CreateCurrency(ctx, &dto.CurrencyCreateDTO{Code: "usd", Name: "US Dollar"})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "currency already exists")
+	})
+}
+
+func TestCurrencyUsecase_CreateExchangeRate(t *testing.T) {
+	currency, repo, uc, ctx := CurrencyUsecaseUtils(t)
+
+	t.Run("should create exchange rate", func(t *testing.T) {
+		repo.EXPECT().FindByCode(ctx, "usd").Return(currency, nil).Times(1)
+		repo.EXPECT().UpsertRate(ctx, gomock.Any()).Return(nil).Times(1)
+
+		resp, err := uc.CreateExchangeRate(ctx, "USD", &dto.ExchangeRateCreateDTO{Rate: 16000, EffectiveDate: "2024-06-01"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "usd", resp.CurrencyCode)
+		assert.Equal(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), resp.EffectiveDate)
+	})
+
+	t.Run("should return error when effective date is invalid", func(t *testing.T) {
+		resp, err := uc.CreateExchangeRate(ctx, "usd", &dto.ExchangeRateCreateDTO{Rate: 16000, EffectiveDate: "01-06-2024"})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "effective_date format is invalid")
+	})
+
+	t.Run("should return error when currency is the base currency", func(t *testing.T) {
+		resp, err := uc.CreateExchangeRate(ctx, "idr", &dto.ExchangeRateCreateDTO{Rate: 1, EffectiveDate: "2024-06-01"})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "the base currency has no exchange rate")
+	})
+
+	t.Run("should return error when currency not found", func(t *testing.T) {
+		repo.EXPECT().FindByCode(ctx, "eur").Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+
+		resp, err := uc.CreateExchangeRate(ctx, "eur", &dto.ExchangeRateCreateDTO{Rate: 17000, EffectiveDate: "2024-06-01"})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "currency not found")
+	})
+}
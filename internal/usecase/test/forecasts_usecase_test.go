@@ -0,0 +1,648 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	mock_repo "github.com/ryvasa/go-super-farmer/internal/repository/mock"
+	usecase_implementation "github.com/ryvasa/go-super-farmer/internal/usecase/implementation"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/pkg/env"
+	mock_pkg "github.com/ryvasa/go-super-farmer/pkg/mock"
+	"github.com/ryvasa/go-super-farmer/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type ForecastsRepoMock struct {
+	LandCommodity *mock_repo.MockLandCommodityRepository
+	City          *mock_repo.MockCityRepository
+	Price         *mock_repo.MockPriceRepository
+	PriceHistory  *mock_repo.MockPriceHistoryRepository
+	Demand        *mock_repo.MockDemandRepository
+	Supply        *mock_repo.MockSupplyRepository
+	Sale          *mock_repo.MockSaleRepository
+	Harvest       *mock_repo.MockHarvestRepository
+	Commodity     *mock_repo.MockCommodityRepository
+	Province      *mock_repo.MockProvinceRepository
+	Unit          *mock_repo.MockUnitRepository
+	Forecast      *mock_repo.MockForecastRepository
+	RabbitMQ      *mock_pkg.MockRabbitMQ
+}
+
+type ForecastsMocks struct {
+	LandCommodity *domain.LandCommodity
+	Commodity     *domain.Commodity
+	City          *domain.City
+}
+
+func ForecastsUsecaseUtils(t *testing.T) (*ForecastsMocks, *ForecastsRepoMock, usecase_interface.ForecastsUsecase, context.Context) {
+	commodityID := uuid.New()
+	mocks := &ForecastsMocks{
+		LandCommodity: &domain.LandCommodity{ID: uuid.New(), CommodityID: commodityID, LandArea: 2, CreatedAt: time.Now()},
+		Commodity:     &domain.Commodity{ID: commodityID, Name: "Rice", Duration: "2400:00:00"},
+		City:          &domain.City{ID: 1, Name: "Bandung"},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo := &ForecastsRepoMock{
+		LandCommodity: mock_repo.NewMockLandCommodityRepository(ctrl),
+		City:          mock_repo.NewMockCityRepository(ctrl),
+		Price:         mock_repo.NewMockPriceRepository(ctrl),
+		PriceHistory:  mock_repo.NewMockPriceHistoryRepository(ctrl),
+		Demand:        mock_repo.NewMockDemandRepository(ctrl),
+		Supply:        mock_repo.NewMockSupplyRepository(ctrl),
+		Sale:          mock_repo.NewMockSaleRepository(ctrl),
+		Harvest:       mock_repo.NewMockHarvestRepository(ctrl),
+		Commodity:     mock_repo.NewMockCommodityRepository(ctrl),
+		Province:      mock_repo.NewMockProvinceRepository(ctrl),
+		Unit:          mock_repo.NewMockUnitRepository(ctrl),
+		Forecast:      mock_repo.NewMockForecastRepository(ctrl),
+		RabbitMQ:      mock_pkg.NewMockRabbitMQ(ctrl),
+	}
+	uc := newForecastsUsecase(repo, "")
+
+	return mocks, repo, uc, context.TODO()
+}
+
+func newForecastsUsecase(repo *ForecastsRepoMock, engine string) usecase_interface.ForecastsUsecase {
+	env := &env.Env{}
+	env.Forecast.Timeout = "2s"
+	env.Forecast.Engine = engine
+	return usecase_implementation.NewForecastsUsecase(repo.LandCommodity, repo.City, repo.Price, repo.PriceHistory, repo.Demand, nil, repo.Supply, nil, repo.Sale, repo.Harvest, repo.Commodity, repo.Province, repo.Unit, repo.Forecast, repo.RabbitMQ, env)
+}
+
+// forecastUnits are the mass units sales are converted to kg with
+var forecastUnits = []*domain.Unit{
+	{Code: "kg", Dimension: domain.UnitDimensionMass, Factor: 1},
+	{Code: "ton", Dimension: domain.UnitDimensionMass, Factor: 1000},
+}
+
+// weeksAgo returns the start of the week the given number of weeks before the current one
+func weeksAgo(weeks int) time.Time {
+	now := time.Now()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return day.AddDate(0, 0, -((int(day.Weekday())+6)%7)-7*weeks)
+}
+
+// expectForecastInputs expects the lookups of the inputs of a forecast of the land commodity in city 1
+func expectForecastInputs(ctx context.Context, mocks *ForecastsMocks, repo *ForecastsRepoMock) {
+	landCommodityID := mocks.LandCommodity.ID
+	commodityID := mocks.Commodity.ID
+	repo.LandCommodity.EXPECT().FindByID(ctx, landCommodityID).Return(mocks.LandCommodity, nil).Times(1)
+	repo.Commodity.EXPECT().FindByID(ctx, commodityID).Return(mocks.Commodity, nil).Times(1)
+	repo.City.EXPECT().FindByID(ctx, int64(1)).Return(mocks.City, nil).Times(1)
+	repo.Demand.EXPECT().FindByCommodityIDAndCityID(ctx, commodityID, int64(1)).Return(&domain.Demand{Quantity: 500}, nil).Times(1)
+	repo.Supply.EXPECT().FindByCommodityIDAndCityID(ctx, commodityID, int64(1)).Return(&domain.Supply{Quantity: 400}, nil).Times(1)
+	repo.Sale.EXPECT().FindByCommodityIDAndCityID(ctx, commodityID, int64(1)).Return([]*domain.Sale{{Quantity: 10}, {Quantity: 30}}, nil).Times(1)
+	repo.Harvest.EXPECT().FindByLandCommodityID(ctx, landCommodityID).Return([]*domain.Harvest{{Quantity: 100}}, nil).Times(1)
+	repo.Price.EXPECT().FindByCommodityIDAndCityID(ctx, commodityID, int64(1)).Return(&domain.Price{Price: 12000}, nil).Times(1)
+}
+
+func TestForecastsUsecase_GetForecastsByCommodityIDAndCityID(t *testing.T) {
+	mocks, repo, uc, ctx := ForecastsUsecaseUtils(t)
+	landCommodityID := mocks.LandCommodity.ID
+	expectInputs := func() { expectForecastInputs(ctx, mocks, repo) }
+
+	t.Run("should return the predicted price replied to the request", func(t *testing.T) {
+		expectInputs()
+		repo.RabbitMQ.EXPECT().RequestJSON(gomock.Any(), "prediction-exchange", "prediction-input", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, exchange, key string, data interface{}, reply interface{}) error {
+				deadline, ok := ctx.Deadline()
+				assert.True(t, ok)
+				assert.WithinDuration(t, time.Now().Add(2*time.Second), deadline, time.Second)
+				req := data.(usecase_implementation.FrecastsMessageReq)
+				assert.Equal(t, float64(20), req.Sale)
+				assert.Equal(t, float64(12000), req.Price)
+				res := reply.(*usecase_implementation.FrecastsMessageRes)
+				res.PredictedPrice = 13500
+				res.ModelVersion = "rf-2"
+				return nil
+			}).Times(1)
+		repo.Forecast.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, forecast *domain.Forecast) error {
+			assert.Equal(t, domain.ForecastCompleted, forecast.Status)
+			assert.Equal(t, landCommodityID, forecast.LandCommodityID)
+			assert.Equal(t, float64(13500), *forecast.PredictedPrice)
+			assert.Equal(t, "rf-2", forecast.ModelVersion)
+			return nil
+		}).Times(1)
+
+		resp, err := uc.GetForecastsByCommodityIDAndCityID(ctx, landCommodityID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ForecastEngineService, resp.Engine)
+		assert.Equal(t, "rf-2", resp.ModelVersion)
+		assert.Equal(t, float64(13500), resp.HarvestPrice)
+		assert.Equal(t, float64(12000), resp.CurrentPrice)
+		assert.Equal(t, mocks.City, resp.City)
+	})
+
+	t.Run("should send a zero yield and sale without harvests and sales", func(t *testing.T) {
+		commodityID := mocks.Commodity.ID
+		repo.LandCommodity.EXPECT().FindByID(ctx, landCommodityID).Return(mocks.LandCommodity, nil).Times(1)
+		repo.Commodity.EXPECT().FindByID(ctx, commodityID).Return(mocks.Commodity, nil).Times(1)
+		repo.City.EXPECT().FindByID(ctx, int64(1)).Return(mocks.City, nil).Times(1)
+		repo.Demand.EXPECT().FindByCommodityIDAndCityID(ctx, commodityID, int64(1)).Return(&domain.Demand{Quantity: 500}, nil).Times(1)
+		repo.Supply.EXPECT().FindByCommodityIDAndCityID(ctx, commodityID, int64(1)).Return(&domain.Supply{Quantity: 400}, nil).Times(1)
+		repo.Sale.EXPECT().FindByCommodityIDAndCityID(ctx, commodityID, int64(1)).Return([]*domain.Sale{}, nil).Times(1)
+		repo.Harvest.EXPECT().FindByLandCommodityID(ctx, landCommodityID).Return([]*domain.Harvest{}, nil).Times(1)
+		repo.Price.EXPECT().FindByCommodityIDAndCityID(ctx, commodityID, int64(1)).Return(&domain.Price{Price: 12000}, nil).Times(1)
+		repo.RabbitMQ.EXPECT().RequestJSON(gomock.Any(), "prediction-exchange", "prediction-input", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, exchange, key string, data interface{}, reply interface{}) error {
+				req := data.(usecase_implementation.FrecastsMessageReq)
+				assert.Equal(t, float64(0), req.HarvestYield)
+				assert.Equal(t, float64(0), req.Sale)
+				reply.(*usecase_implementation.FrecastsMessageRes).PredictedPrice = 13000
+				return nil
+			}).Times(1)
+		repo.Forecast.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, forecast *domain.Forecast) error {
+			assert.Equal(t, float64(0), forecast.HarvestYield)
+			assert.Equal(t, float64(0), forecast.Sale)
+			return nil
+		}).Times(1)
+
+		resp, err := uc.GetForecastsByCommodityIDAndCityID(ctx, landCommodityID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(13000), resp.HarvestPrice)
+	})
+
+	t.Run("should fall back to the baseline when the prediction service does not reply in time", func(t *testing.T) {
+		expectInputs()
+		repo.RabbitMQ.EXPECT().RequestJSON(gomock.Any(), "prediction-exchange", "prediction-input", gomock.Any(), gomock.Any()).
+			Return(context.DeadlineExceeded).Times(1)
+		repo.PriceHistory.EXPECT().FindBuckets(ctx, mocks.Commodity.ID, int64(1), "week", gomock.Any(), gomock.Any()).Return([]*dto.PriceBucketDTO{}, nil).Times(1)
+		repo.Sale.EXPECT().FindByCommodityIDAndCityIDBetween(ctx, mocks.Commodity.ID, int64(1), gomock.Any(), gomock.Any()).Return([]*domain.Sale{}, nil).Times(1)
+		repo.Unit.EXPECT().FindAll(ctx).Return(forecastUnits, nil).Times(1)
+		repo.Forecast.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, forecast *domain.Forecast) error {
+			assert.Equal(t, domain.ForecastCompleted, forecast.Status)
+			assert.Equal(t, domain.ForecastEngineBaseline, forecast.Engine)
+			return nil
+		}).Times(1)
+
+		resp, err := uc.GetForecastsByCommodityIDAndCityID(ctx, landCommodityID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ForecastEngineBaseline, resp.Engine)
+		assert.Equal(t, "naive", resp.ModelVersion)
+		assert.Equal(t, float64(12000), resp.HarvestPrice)
+	})
+
+	t.Run("should return error when the prediction service and the baseline fail", func(t *testing.T) {
+		expectInputs()
+		repo.RabbitMQ.EXPECT().RequestJSON(gomock.Any(), "prediction-exchange", "prediction-input", gomock.Any(), gomock.Any()).
+			Return(context.DeadlineExceeded).Times(1)
+		repo.PriceHistory.EXPECT().FindBuckets(ctx, mocks.Commodity.ID, int64(1), "week", gomock.Any(), gomock.Any()).Return(nil, errors.New("database error")).Times(1)
+		repo.Forecast.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, forecast *domain.Forecast) error {
+			assert.Equal(t, domain.ForecastFailed, forecast.Status)
+			assert.Equal(t, "no response from prediction service", forecast.Error)
+			return nil
+		}).Times(1)
+
+		resp, err := uc.GetForecastsByCommodityIDAndCityID(ctx, landCommodityID, 1)
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "no response from prediction service")
+	})
+}
+
+func TestForecastsUsecase_CreateForecastJob(t *testing.T) {
+	mocks, repo, uc, ctx := ForecastsUsecaseUtils(t)
+	userID := uuid.New()
+	req := &dto.ForecastJobCreateDTO{LandCommodityID: mocks.LandCommodity.ID, CityID: 1}
+
+	t.Run("should return the pending job and finish it in the background", func(t *testing.T) {
+		expectForecastInputs(ctx, mocks, repo)
+		repo.Forecast.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, forecast *domain.Forecast) error {
+			assert.Equal(t, domain.ForecastPending, forecast.Status)
+			assert.Equal(t, userID, *forecast.RequestedBy)
+			return nil
+		}).Times(1)
+		repo.RabbitMQ.EXPECT().RequestJSON(gomock.Any(), "prediction-exchange", "prediction-input", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, exchange, key string, data interface{}, reply interface{}) error {
+				reply.(*usecase_implementation.FrecastsMessageRes).PredictedPrice = 13500
+				return nil
+			}).Times(1)
+		done := make(chan *domain.Forecast, 1)
+		repo.Forecast.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, forecast *domain.Forecast) (bool, error) {
+			done <- forecast
+			return true, nil
+		}).Times(1)
+
+		resp, err := uc.CreateForecastJob(ctx, userID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ForecastPending, resp.Status)
+		select {
+		case forecast := <-done:
+			assert.Equal(t, resp.ID, forecast.ID)
+			assert.Equal(t, domain.ForecastCompleted, forecast.Status)
+			assert.Equal(t, float64(13500), *forecast.PredictedPrice)
+		case <-time.After(time.Second):
+			t.Fatal("forecast job was not finished")
+		}
+	})
+
+	t.Run("should return error when land commodity not found", func(t *testing.T) {
+		repo.LandCommodity.EXPECT().FindByID(ctx, mocks.LandCommodity.ID).Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+
+		resp, err := uc.CreateForecastJob(ctx, userID, req)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "land commodity not found")
+	})
+}
+
+func TestForecastsUsecase_GetForecastJob(t *testing.T) {
+	_, repo, uc, ctx := ForecastsUsecaseUtils(t)
+	id := uuid.New()
+
+	t.Run("should return a pending job", func(t *testing.T) {
+		repo.Forecast.EXPECT().FindByID(ctx, id).Return(&domain.Forecast{ID: id, Status: domain.ForecastPending, CreatedAt: time.Now()}, nil).Times(1)
+
+		resp, err := uc.GetForecastJob(ctx, id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ForecastPending, resp.Status)
+	})
+
+	t.Run("should fail a job pending long after its timeout", func(t *testing.T) {
+		repo.Forecast.EXPECT().FindByID(ctx, id).Return(&domain.Forecast{ID: id, Status: domain.ForecastPending, CreatedAt: time.Now().Add(-time.Minute)}, nil).Times(1)
+		repo.Forecast.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, forecast *domain.Forecast) (bool, error) {
+			assert.Equal(t, domain.ForecastFailed, forecast.Status)
+			return true, nil
+		}).Times(1)
+
+		resp, err := uc.GetForecastJob(ctx, id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ForecastFailed, resp.Status)
+		assert.Equal(t, "forecast job was interrupted", resp.Error)
+	})
+
+	t.Run("should return error when job not found", func(t *testing.T) {
+		repo.Forecast.EXPECT().FindByID(ctx, id).Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+
+		resp, err := uc.GetForecastJob(ctx, id)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "forecast job not found")
+	})
+}
+
+func TestForecastsUsecase_GetForecastHistory(t *testing.T) {
+	mocks, repo, uc, ctx := ForecastsUsecaseUtils(t)
+	landCommodityID := mocks.LandCommodity.ID
+
+	t.Run("should return the forecasts of the land commodity", func(t *testing.T) {
+		repo.LandCommodity.EXPECT().FindByID(ctx, landCommodityID).Return(mocks.LandCommodity, nil).Times(1)
+		repo.Forecast.EXPECT().FindByLandCommodityID(ctx, landCommodityID).Return([]*domain.Forecast{{ID: uuid.New()}, {ID: uuid.New()}}, nil).Times(1)
+
+		resp, err := uc.GetForecastHistory(ctx, landCommodityID)
+
+		assert.NoError(t, err)
+		assert.Len(t, resp, 2)
+	})
+}
+
+func TestForecastsUsecase_BaselineEngine(t *testing.T) {
+	mocks, repo, _, ctx := ForecastsUsecaseUtils(t)
+	uc := newForecastsUsecase(repo, domain.ForecastEngineBaseline)
+	landCommodityID := mocks.LandCommodity.ID
+	// the harvest is 100 days after the land commodity was created, 14 weeks from now
+	forecastWith := func(buckets []*dto.PriceBucketDTO, sales []*domain.Sale) *dto.ForecastsResponseDTO {
+		expectForecastInputs(ctx, mocks, repo)
+		repo.PriceHistory.EXPECT().FindBuckets(ctx, mocks.Commodity.ID, int64(1), "week", weeksAgo(103), weeksAgo(-1)).Return(buckets, nil).Times(1)
+		repo.Sale.EXPECT().FindByCommodityIDAndCityIDBetween(ctx, mocks.Commodity.ID, int64(1), weeksAgo(103), weeksAgo(-1)).Return(sales, nil).Times(1)
+		repo.Unit.EXPECT().FindAll(ctx).Return(forecastUnits, nil).Times(1)
+		repo.Forecast.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(1)
+
+		resp, err := uc.GetForecastsByCommodityIDAndCityID(ctx, landCommodityID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ForecastEngineBaseline, resp.Engine)
+		return resp
+	}
+
+	t.Run("should scale last year's harvest week price by the change since then", func(t *testing.T) {
+		resp := forecastWith([]*dto.PriceBucketDTO{
+			{Period: weeksAgo(52), Close: 8000},
+			{Period: weeksAgo(38), Close: 10000},
+			{Period: weeksAgo(30), Close: 9000},
+		}, nil)
+
+		assert.Equal(t, "seasonal-naive", resp.ModelVersion)
+		assert.Equal(t, float64(15000), resp.HarvestPrice)
+	})
+
+	t.Run("should follow the damped trend of a short history", func(t *testing.T) {
+		resp := forecastWith([]*dto.PriceBucketDTO{
+			{Period: weeksAgo(4), Close: 10000},
+			{Period: weeksAgo(3), Close: 10500},
+			{Period: weeksAgo(2), Close: 11000},
+			{Period: weeksAgo(1), Close: 11500},
+		}, nil)
+
+		assert.Equal(t, "holt-damped", resp.ModelVersion)
+		assert.Greater(t, resp.HarvestPrice, float64(12000))
+		assert.Less(t, resp.HarvestPrice, float64(12000+14*500))
+	})
+
+	t.Run("should keep the current price without history", func(t *testing.T) {
+		resp := forecastWith([]*dto.PriceBucketDTO{}, nil)
+
+		assert.Equal(t, "naive", resp.ModelVersion)
+		assert.Equal(t, float64(12000), resp.HarvestPrice)
+	})
+
+	t.Run("should raise the price when sales are above the level that raised it before", func(t *testing.T) {
+		// The price rose after every week of 200 kg sold and fell back after every week of 50 kg,
+		// the last weeks sold mostly 200 kg and demand is above supply
+		buckets := []*dto.PriceBucketDTO{}
+		sales := []*domain.Sale{}
+		for weeks := 20; weeks >= 1; weeks-- {
+			price, quantity := float64(10000), float64(200)
+			if weeks%2 == 1 {
+				price = 10200
+				if weeks > 1 {
+					quantity = 50
+				}
+			}
+			buckets = append(buckets, &dto.PriceBucketDTO{Period: weeksAgo(weeks), Close: price})
+			sales = append(sales, &domain.Sale{Quantity: quantity, Unit: "kg", SaleDate: weeksAgo(weeks).AddDate(0, 0, 2)})
+		}
+		sales = append(sales, &domain.Sale{Quantity: 0.2, Unit: "ton", SaleDate: weeksAgo(0)})
+
+		priceOnly := forecastWith(buckets, nil)
+		resp := forecastWith(buckets, sales)
+
+		assert.Equal(t, "holt-damped", priceOnly.ModelVersion)
+		assert.Equal(t, "holt-damped+sales", resp.ModelVersion)
+		assert.Greater(t, resp.HarvestPrice, priceOnly.HarvestPrice)
+		assert.LessOrEqual(t, resp.HarvestPrice, math.Round(priceOnly.HarvestPrice*math.Exp(0.25)*100)/100)
+	})
+
+	t.Run("should keep the price-only forecast with sales in too few weeks", func(t *testing.T) {
+		resp := forecastWith([]*dto.PriceBucketDTO{
+			{Period: weeksAgo(4), Close: 10000},
+			{Period: weeksAgo(3), Close: 10500},
+			{Period: weeksAgo(2), Close: 11000},
+			{Period: weeksAgo(1), Close: 11500},
+		}, []*domain.Sale{
+			{Quantity: 100, Unit: "kg", SaleDate: weeksAgo(2)},
+			{Quantity: 300, Unit: "kg", SaleDate: weeksAgo(1)},
+		})
+
+		assert.Equal(t, "holt-damped", resp.ModelVersion)
+	})
+}
+
+func TestForecastsUsecase_EvaluateDueForecasts(t *testing.T) {
+	mocks, repo, uc, ctx := ForecastsUsecaseUtils(t)
+	harvestDate := time.Now().AddDate(0, 0, -10)
+	newForecast := func() *domain.Forecast {
+		return &domain.Forecast{ID: uuid.New(), CommodityID: mocks.Commodity.ID, CityID: 1, Status: domain.ForecastCompleted, HarvestDate: harvestDate}
+	}
+
+	t.Run("should record the price in effect on the harvest date", func(t *testing.T) {
+		unchanged, changed, unpriced := newForecast(), newForecast(), newForecast()
+		repo.Forecast.EXPECT().FindUnevaluated(ctx, gomock.Any(), 100).Return([]*domain.Forecast{unchanged, changed, unpriced}, nil).Times(1)
+		gomock.InOrder(
+			repo.Price.EXPECT().FindByCommodityIDAndCityID(ctx, mocks.Commodity.ID, int64(1)).Return(&domain.Price{Price: 12000, UpdatedAt: harvestDate.AddDate(0, 0, -1)}, nil),
+			repo.Price.EXPECT().FindByCommodityIDAndCityID(ctx, mocks.Commodity.ID, int64(1)).Return(&domain.Price{Price: 14000, UpdatedAt: harvestDate.AddDate(0, 0, 1)}, nil),
+			repo.Price.EXPECT().FindByCommodityIDAndCityID(ctx, mocks.Commodity.ID, int64(1)).Return(nil, utils.NewNotFoundError("record not found")),
+		)
+		gomock.InOrder(
+			repo.PriceHistory.EXPECT().FindLatestBefore(ctx, mocks.Commodity.ID, int64(1), harvestDate).Return(&domain.PriceHistory{Price: 11000}, nil),
+			repo.PriceHistory.EXPECT().FindLatestBefore(ctx, mocks.Commodity.ID, int64(1), harvestDate).Return(nil, errors.New("record not found")),
+		)
+		repo.Forecast.EXPECT().Evaluate(ctx, gomock.Any()).Return(nil).Times(3)
+
+		evaluated, err := uc.EvaluateDueForecasts(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, evaluated)
+		assert.Equal(t, float64(12000), *unchanged.ActualPrice)
+		assert.Equal(t, float64(11000), *changed.ActualPrice)
+		assert.Nil(t, unpriced.ActualPrice)
+		assert.NotNil(t, unpriced.EvaluatedAt)
+	})
+
+	t.Run("should return error when forecasts cannot be loaded", func(t *testing.T) {
+		repo.Forecast.EXPECT().FindUnevaluated(ctx, gomock.Any(), 100).Return(nil, errors.New("database error")).Times(1)
+
+		evaluated, err := uc.EvaluateDueForecasts(ctx)
+
+		assert.Equal(t, 0, evaluated)
+		assert.EqualError(t, err, "database error")
+	})
+}
+
+func TestForecastsUsecase_GetForecastAccuracy(t *testing.T) {
+	_, repo, uc, ctx := ForecastsUsecaseUtils(t)
+
+	t.Run("should return the rounded errors per model", func(t *testing.T) {
+		params := &dto.ForecastAccuracyParamsDTO{CityID: 1}
+		mape := 4.16666
+		repo.Forecast.EXPECT().FindAccuracy(ctx, params).Return([]*dto.ForecastAccuracyDTO{
+			{CityID: 1, Engine: domain.ForecastEngineBaseline, ModelVersion: "naive", Count: 3, MAE: 500.004, MAPE: &mape},
+		}, nil).Times(1)
+
+		resp, err := uc.GetForecastAccuracy(ctx, params)
+
+		assert.NoError(t, err)
+		assert.Len(t, resp, 1)
+		assert.Equal(t, 500.0, resp[0].MAE)
+		assert.Equal(t, 4.17, *resp[0].MAPE)
+	})
+
+	t.Run("should return error when end date is before start date", func(t *testing.T) {
+		params := &dto.ForecastAccuracyParamsDTO{StartDate: time.Now(), EndDate: time.Now().AddDate(0, 0, -1)}
+
+		resp, err := uc.GetForecastAccuracy(ctx, params)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "end_date is before start_date")
+	})
+}
+
+func TestForecastsUsecase_BacktestForecasts(t *testing.T) {
+	mocks, repo, uc, ctx := ForecastsUsecaseUtils(t)
+	commodityID := mocks.Commodity.ID
+
+	t.Run("should compare every replayed forecast with the price horizon weeks later", func(t *testing.T) {
+		params := &dto.ForecastBacktestParamsDTO{CommodityID: commodityID, CityID: 1, StartDate: weeksAgo(6), EndDate: weeksAgo(4), HorizonWeeks: 2}
+		repo.PriceHistory.EXPECT().FindBuckets(ctx, commodityID, int64(1), "week", weeksAgo(109), weeksAgo(1)).Return([]*dto.PriceBucketDTO{
+			{Period: weeksAgo(8), Close: 10000},
+			{Period: weeksAgo(3), Close: 11000},
+		}, nil).Times(1)
+
+		resp, err := uc.BacktestForecasts(ctx, params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ForecastEngineBaseline, resp.Engine)
+		assert.Equal(t, 3, resp.Count)
+		// the price stays at 10000 until it moves to 11000 three weeks ago, which no replayed forecast saw coming
+		assert.Equal(t, weeksAgo(6), resp.Points[0].ForecastedAt)
+		assert.Equal(t, weeksAgo(4), resp.Points[0].TargetDate)
+		assert.Equal(t, float64(10000), resp.Points[0].PredictedPrice)
+		assert.Equal(t, float64(10000), resp.Points[0].ActualPrice)
+		assert.Equal(t, "naive", resp.Points[0].ModelVersion)
+		assert.Equal(t, "holt-damped", resp.Points[1].ModelVersion)
+		assert.Equal(t, float64(10000), resp.Points[2].PredictedPrice)
+		assert.Equal(t, float64(11000), resp.Points[2].ActualPrice)
+		assert.Equal(t, 666.67, *resp.MAE)
+		assert.Equal(t, 6.06, *resp.MAPE)
+	})
+
+	t.Run("should return error when commodity is missing", func(t *testing.T) {
+		resp, err := uc.BacktestForecasts(ctx, &dto.ForecastBacktestParamsDTO{CityID: 1})
+
+		assert.Nil(t, resp)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when the window is too long", func(t *testing.T) {
+		params := &dto.ForecastBacktestParamsDTO{CommodityID: commodityID, CityID: 1, StartDate: weeksAgo(300), EndDate: weeksAgo(1)}
+
+		resp, err := uc.BacktestForecasts(ctx, params)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "backtest window is longer than 156 weeks")
+	})
+
+	t.Run("should return error when the window starts in the future", func(t *testing.T) {
+		params := &dto.ForecastBacktestParamsDTO{CommodityID: commodityID, CityID: 1, StartDate: weeksAgo(-4), EndDate: weeksAgo(-2)}
+
+		resp, err := uc.BacktestForecasts(ctx, params)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "start_date must be at least horizon_weeks before the current week")
+	})
+
+	t.Run("should return error when the window starts within the horizon", func(t *testing.T) {
+		params := &dto.ForecastBacktestParamsDTO{CommodityID: commodityID, CityID: 1, StartDate: weeksAgo(1), HorizonWeeks: 2}
+
+		resp, err := uc.BacktestForecasts(ctx, params)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "start_date must be at least horizon_weeks before the current week")
+	})
+
+	t.Run("should return error when end_date is before start_date", func(t *testing.T) {
+		params := &dto.ForecastBacktestParamsDTO{CommodityID: commodityID, CityID: 1, StartDate: weeksAgo(20), EndDate: weeksAgo(30)}
+
+		resp, err := uc.BacktestForecasts(ctx, params)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "end_date is before start_date")
+	})
+
+	t.Run("should cap a future end_date at the last closed week", func(t *testing.T) {
+		params := &dto.ForecastBacktestParamsDTO{CommodityID: commodityID, CityID: 1, StartDate: weeksAgo(3), EndDate: weeksAgo(-10), HorizonWeeks: 2}
+		repo.PriceHistory.EXPECT().FindBuckets(ctx, commodityID, int64(1), "week", weeksAgo(106), weeksAgo(-1)).Return([]*dto.PriceBucketDTO{
+			{Period: weeksAgo(8), Close: 10000},
+		}, nil).Times(1)
+
+		resp, err := uc.BacktestForecasts(ctx, params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, resp.Count)
+		assert.Equal(t, weeksAgo(2), resp.Points[1].ForecastedAt)
+		assert.Equal(t, weeksAgo(0), resp.Points[1].TargetDate)
+	})
+}
+
+func TestForecastsUsecase_GetForecastsByArea(t *testing.T) {
+	mocks, repo, uc, ctx := ForecastsUsecaseUtils(t)
+	commodityID := mocks.Commodity.ID
+	units := []*domain.Unit{
+		{Code: "kg", Dimension: domain.UnitDimensionMass, Factor: 1},
+		{Code: "ton", Dimension: domain.UnitDimensionMass, Factor: 1000},
+		{Code: "m2", Dimension: domain.UnitDimensionArea, Factor: 1},
+		{Code: "ha", Dimension: domain.UnitDimensionArea, Factor: 10000},
+	}
+
+	t.Run("should add up the land commodities of a city", func(t *testing.T) {
+		params := &dto.AreaForecastParamsDTO{CommodityID: commodityID, CityID: 1}
+		planted := &domain.LandCommodity{ID: uuid.New(), LandArea: 2, Unit: "ha", CreatedAt: time.Now(), Land: &domain.Land{CityID: 1}}
+		plantedEarlier := &domain.LandCommodity{ID: uuid.New(), LandArea: 5000, Unit: "m2", CreatedAt: time.Now().AddDate(0, 0, -7), Land: &domain.Land{CityID: 1}}
+		unknownUnit := &domain.LandCommodity{ID: uuid.New(), LandArea: 1, Unit: "acre", CreatedAt: time.Now(), Land: &domain.Land{CityID: 1}}
+		repo.Commodity.EXPECT().FindByID(ctx, commodityID).Return(mocks.Commodity, nil).Times(1)
+		repo.City.EXPECT().FindByID(ctx, int64(1)).Return(mocks.City, nil).Times(1)
+		repo.LandCommodity.EXPECT().FindActiveByArea(ctx, commodityID, int64(1), int64(0)).Return([]*domain.LandCommodity{planted, plantedEarlier, unknownUnit}, nil).Times(1)
+		repo.Unit.EXPECT().FindAll(ctx).Return(units, nil).Times(1)
+		repo.Harvest.EXPECT().FindByCommodityIDAndArea(ctx, commodityID, int64(1), int64(0)).Return([]*domain.Harvest{
+			{Quantity: 3, Unit: "ton", LandCommodity: &domain.LandCommodity{LandArea: 1, Unit: "ha"}},
+			{Quantity: 1000, Unit: "kg", LandCommodity: &domain.LandCommodity{LandArea: 10000, Unit: "m2"}},
+		}, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindBuckets(ctx, commodityID, int64(1), "week", weeksAgo(103), weeksAgo(-1)).
+			Return([]*dto.PriceBucketDTO{{Period: weeksAgo(0), Close: 12000}}, nil).Times(1)
+
+		resp, err := uc.GetForecastsByArea(ctx, params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, mocks.City, resp.City)
+		assert.Equal(t, domain.ForecastEngineBaseline, resp.Engine)
+		assert.Equal(t, 2, resp.LandCommodities)
+		assert.Equal(t, 1, resp.SkippedLandCommodities)
+		assert.Equal(t, 2.5, resp.LandArea)
+		assert.Equal(t, float64(2000), resp.YieldPerHectare)
+		assert.Equal(t, dto.AreaYieldSourceArea, resp.YieldSource)
+		assert.Equal(t, float64(5000), resp.ExpectedYield)
+		assert.Equal(t, float64(12000), *resp.ExpectedPrice)
+		assert.Len(t, resp.Harvests, 2)
+		assert.Equal(t, float64(1000), resp.Harvests[0].ExpectedYield)
+		assert.Equal(t, float64(4000), resp.Harvests[1].ExpectedYield)
+		assert.True(t, resp.Harvests[0].Week.Before(resp.Harvests[1].Week))
+	})
+
+	t.Run("should use the national yield when the province has no harvest yet", func(t *testing.T) {
+		params := &dto.AreaForecastParamsDTO{CommodityID: commodityID, ProvinceID: 3}
+		province := &domain.Province{ID: 3, Name: "Jawa Barat"}
+		repo.Commodity.EXPECT().FindByID(ctx, commodityID).Return(mocks.Commodity, nil).Times(1)
+		repo.Province.EXPECT().FindByID(ctx, int64(3)).Return(province, nil).Times(1)
+		repo.LandCommodity.EXPECT().FindActiveByArea(ctx, commodityID, int64(0), int64(3)).Return([]*domain.LandCommodity{
+			{ID: uuid.New(), LandArea: 1, Unit: "ha", CreatedAt: time.Now(), Land: &domain.Land{CityID: 5}},
+		}, nil).Times(1)
+		repo.Unit.EXPECT().FindAll(ctx).Return(units, nil).Times(1)
+		repo.Harvest.EXPECT().FindByCommodityIDAndArea(ctx, commodityID, int64(0), int64(3)).Return([]*domain.Harvest{}, nil).Times(1)
+		repo.Harvest.EXPECT().FindByCommodityIDAndArea(ctx, commodityID, int64(0), int64(0)).Return([]*domain.Harvest{
+			{Quantity: 1500, Unit: "kg", LandCommodity: &domain.LandCommodity{LandArea: 1, Unit: "ha"}},
+		}, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindBuckets(ctx, commodityID, int64(5), "week", gomock.Any(), gomock.Any()).Return([]*dto.PriceBucketDTO{}, nil).Times(1)
+
+		resp, err := uc.GetForecastsByArea(ctx, params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, province, resp.Province)
+		assert.Equal(t, dto.AreaYieldSourceNational, resp.YieldSource)
+		assert.Equal(t, float64(1500), resp.ExpectedYield)
+		assert.Nil(t, resp.ExpectedPrice)
+		assert.Nil(t, resp.Harvests[0].ExpectedPrice)
+	})
+
+	t.Run("should return error when both city and province are given", func(t *testing.T) {
+		resp, err := uc.GetForecastsByArea(ctx, &dto.AreaForecastParamsDTO{CommodityID: commodityID, CityID: 1, ProvinceID: 3})
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "either city_id or province_id is required")
+	})
+
+	t.Run("should return error when commodity not found", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, commodityID).Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+
+		resp, err := uc.GetForecastsByArea(ctx, &dto.AreaForecastParamsDTO{CommodityID: commodityID, CityID: 1})
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "commodity not found")
+	})
+}
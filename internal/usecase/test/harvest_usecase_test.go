@@ -37,6 +37,7 @@ type HarvestIDs struct {
 	CityID          int64
 	LandID          uuid.UUID
 	CommodityID     uuid.UUID
+	UserID          uuid.UUID
 }
 
 type HarvestDomainMock struct {
@@ -68,6 +69,7 @@ func HarvestUsecaseSetup(t *testing.T) (*HarvestIDs, *HarvestDomainMock, *Harves
 		CityID:          cityID,
 		LandID:          landID,
 		CommodityID:     commodityID,
+		UserID:          uuid.New(),
 	}
 	date, _ := time.Parse("2006-01-02", "2022-01-01")
 	startDate, _ := time.Parse("2006-01-02", "2020-01-01")
@@ -178,7 +180,7 @@ func TestHarvestRepository_CreateHarvest(t *testing.T) {
 
 		repo.Cache.EXPECT().DeleteByPattern(ctx, "harvest").Return(nil).Times(1)
 
-		resp, err := uc.CreateHarvest(ctx, dtos.Create)
+		resp, err := uc.CreateHarvest(ctx, ids.UserID, "Admin", dtos.Create)
 
 		assert.NoError(t, err)
 		assert.Equal(t, ids.HarvestID, resp.ID)
@@ -191,7 +193,7 @@ func TestHarvestRepository_CreateHarvest(t *testing.T) {
 	})
 
 	t.Run("should return error validation error", func(t *testing.T) {
-		resp, err := uc.CreateHarvest(ctx, &dto.HarvestCreateDTO{
+		resp, err := uc.CreateHarvest(ctx, ids.UserID, "Admin", &dto.HarvestCreateDTO{
 			LandCommodityID: ids.LandCommodityID,
 			Quantity:        -10,
 		})
@@ -210,7 +212,7 @@ func TestHarvestRepository_CreateHarvest(t *testing.T) {
 
 		repo.LandCommodity.EXPECT().FindByID(ctx, ids.LandCommodityID).Return(nil, utils.NewNotFoundError("land commodity not found")).Times(1)
 
-		resp, err := uc.CreateHarvest(ctx, dtos.Create)
+		resp, err := uc.CreateHarvest(ctx, ids.UserID, "Admin", dtos.Create)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -230,7 +232,7 @@ func TestHarvestRepository_CreateHarvest(t *testing.T) {
 
 		repo.Harvest.EXPECT().Create(ctx, gomock.Any()).Return(utils.NewInternalError("internal error")).Times(1)
 
-		resp, err := uc.CreateHarvest(ctx, dtos.Create)
+		resp, err := uc.CreateHarvest(ctx, ids.UserID, "Admin", dtos.Create)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -257,7 +259,7 @@ func TestHarvestRepository_CreateHarvest(t *testing.T) {
 
 		repo.Harvest.EXPECT().FindByID(ctx, ids.HarvestID).Return(nil, utils.NewInternalError("internal error")).Times(1)
 
-		resp, err := uc.CreateHarvest(ctx, dtos.Create)
+		resp, err := uc.CreateHarvest(ctx, ids.UserID, "Admin", dtos.Create)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -273,7 +275,7 @@ func TestHarvestRepository_CreateHarvest(t *testing.T) {
 		repo.City.EXPECT().FindByID(ctx, ids.CityID).Return(domains.City, nil).Times(1)
 		repo.LandCommodity.EXPECT().FindByID(ctx, ids.LandCommodityID).Return(domains.LandCommodity, nil).Times(1)
 
-		resp, err := uc.CreateHarvest(ctx, &dto.HarvestCreateDTO{
+		resp, err := uc.CreateHarvest(ctx, ids.UserID, "Admin", &dto.HarvestCreateDTO{
 			LandCommodityID: ids.LandCommodityID,
 			HarvestDate:     "string",
 			Quantity:        float64(10),
@@ -305,7 +307,7 @@ func TestHarvestRepository_CreateHarvest(t *testing.T) {
 
 		repo.Cache.EXPECT().DeleteByPattern(ctx, "harvest").Return(utils.NewInternalError("internal error")).Times(1)
 
-		resp, err := uc.CreateHarvest(ctx, dtos.Create)
+		resp, err := uc.CreateHarvest(ctx, ids.UserID, "Admin", dtos.Create)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -527,7 +529,7 @@ func TestHarvestUsecase_UpdateHarvest(t *testing.T) {
 
 		repo.Cache.EXPECT().DeleteByPattern(ctx, "harvest").Return(nil).Times(1)
 
-		resp, err := uc.UpdateHarvest(ctx, ids.HarvestID, dtos.Update)
+		resp, err := uc.UpdateHarvest(ctx, ids.UserID, "Admin", ids.HarvestID, dtos.Update)
 
 		assert.NoError(t, err)
 		assert.Equal(t, ids.HarvestID, resp.ID)
@@ -540,7 +542,7 @@ func TestHarvestUsecase_UpdateHarvest(t *testing.T) {
 
 		repo.Harvest.EXPECT().FindByID(ctx, ids.HarvestID).Return(nil, utils.NewNotFoundError("harvest not found")).Times(1)
 
-		resp, err := uc.UpdateHarvest(ctx, ids.HarvestID, dtos.Update)
+		resp, err := uc.UpdateHarvest(ctx, ids.UserID, "Admin", ids.HarvestID, dtos.Update)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -548,7 +550,7 @@ func TestHarvestUsecase_UpdateHarvest(t *testing.T) {
 	})
 
 	t.Run("should return error validation error", func(t *testing.T) {
-		resp, err := uc.UpdateHarvest(ctx, ids.HarvestID, &dto.HarvestUpdateDTO{
+		resp, err := uc.UpdateHarvest(ctx, ids.UserID, "Admin", ids.HarvestID, &dto.HarvestUpdateDTO{
 			Quantity: -10,
 		})
 
@@ -564,7 +566,7 @@ func TestHarvestUsecase_UpdateHarvest(t *testing.T) {
 
 		repo.Harvest.EXPECT().Update(ctx, ids.HarvestID, gomock.Any()).Return(utils.NewInternalError("internal error")).Times(1)
 
-		resp, err := uc.UpdateHarvest(ctx, ids.HarvestID, dtos.Update)
+		resp, err := uc.UpdateHarvest(ctx, ids.UserID, "Admin", ids.HarvestID, dtos.Update)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -583,7 +585,7 @@ func TestHarvestUsecase_UpdateHarvest(t *testing.T) {
 
 		repo.Harvest.EXPECT().FindByID(ctx, ids.HarvestID).Return(nil, utils.NewInternalError("internal error")).Times(1)
 
-		resp, err := uc.UpdateHarvest(ctx, ids.HarvestID, dtos.Update)
+		resp, err := uc.UpdateHarvest(ctx, ids.UserID, "Admin", ids.HarvestID, dtos.Update)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -602,7 +604,7 @@ func TestHarvestUsecase_UpdateHarvest(t *testing.T) {
 
 		repo.Harvest.EXPECT().FindByID(ctx, ids.HarvestID).Return(domains.UpdatedHarvest, nil).Times(1)
 
-		resp, err := uc.UpdateHarvest(ctx, ids.HarvestID, &dto.HarvestUpdateDTO{
+		resp, err := uc.UpdateHarvest(ctx, ids.UserID, "Admin", ids.HarvestID, &dto.HarvestUpdateDTO{
 			Quantity:    99,
 			HarvestDate: "string",
 		})
@@ -626,7 +628,7 @@ func TestHarvestUsecase_UpdateHarvest(t *testing.T) {
 
 		repo.Cache.EXPECT().DeleteByPattern(ctx, "harvest").Return(utils.NewInternalError("internal error")).Times(1)
 
-		resp, err := uc.UpdateHarvest(ctx, ids.HarvestID, dtos.Update)
+		resp, err := uc.UpdateHarvest(ctx, ids.UserID, "Admin", ids.HarvestID, dtos.Update)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -646,7 +648,7 @@ func TestHarvestUsecase_DeleteHarvest(t *testing.T) {
 
 		repo.Cache.EXPECT().DeleteByPattern(ctx, "harvest").Return(nil).Times(1)
 
-		err := uc.DeleteHarvest(ctx, ids.HarvestID)
+		err := uc.DeleteHarvest(ctx, ids.UserID, "Admin", ids.HarvestID)
 
 		assert.NoError(t, err)
 	})
@@ -656,7 +658,7 @@ func TestHarvestUsecase_DeleteHarvest(t *testing.T) {
 
 		repo.Harvest.EXPECT().FindByID(ctx, ids.HarvestID).Return(nil, utils.NewNotFoundError("harvest not found")).Times(1)
 
-		err := uc.DeleteHarvest(ctx, ids.HarvestID)
+		err := uc.DeleteHarvest(ctx, ids.UserID, "Admin", ids.HarvestID)
 
 		assert.Error(t, err)
 		assert.EqualError(t, err, "harvest not found")
@@ -669,7 +671,7 @@ func TestHarvestUsecase_DeleteHarvest(t *testing.T) {
 
 		repo.Harvest.EXPECT().Delete(ctx, ids.HarvestID).Return(utils.NewInternalError("internal error")).Times(1)
 
-		err := uc.DeleteHarvest(ctx, ids.HarvestID)
+		err := uc.DeleteHarvest(ctx, ids.UserID, "Admin", ids.HarvestID)
 
 		assert.Error(t, err)
 		assert.EqualError(t, err, "internal error")
@@ -684,13 +686,63 @@ func TestHarvestUsecase_DeleteHarvest(t *testing.T) {
 
 		repo.Cache.EXPECT().DeleteByPattern(ctx, "harvest").Return(utils.NewInternalError("internal error")).Times(1)
 
-		err := uc.DeleteHarvest(ctx, ids.HarvestID)
+		err := uc.DeleteHarvest(ctx, ids.UserID, "Admin", ids.HarvestID)
 
 		assert.Error(t, err)
 		assert.EqualError(t, err, "internal error")
 	})
 }
 
+func TestHarvestUsecase_Ownership(t *testing.T) {
+	ids, domains, dtos, repo, uc, ctx := HarvestUsecaseSetup(t)
+
+	ownedLandCommodity := &domain.LandCommodity{
+		ID:     ids.LandCommodityID,
+		LandID: ids.LandID,
+		Land:   &domain.Land{ID: ids.LandID, UserID: ids.UserID},
+	}
+
+	t.Run("should delete harvest as land owner", func(t *testing.T) {
+		repo.Harvest.EXPECT().FindByID(ctx, ids.HarvestID).Return(domains.Harvest, nil).Times(1)
+		repo.LandCommodity.EXPECT().FindByID(ctx, ids.LandCommodityID).Return(ownedLandCommodity, nil).Times(1)
+		repo.Harvest.EXPECT().Delete(ctx, ids.HarvestID).Return(nil).Times(1)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "harvest").Return(nil).Times(1)
+
+		err := uc.DeleteHarvest(ctx, ids.UserID, "Farmer", ids.HarvestID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when updating harvest of another farmer", func(t *testing.T) {
+		repo.Harvest.EXPECT().FindByID(ctx, ids.HarvestID).Return(domains.Harvest, nil).Times(1)
+		repo.LandCommodity.EXPECT().FindByID(ctx, ids.LandCommodityID).Return(ownedLandCommodity, nil).Times(1)
+
+		resp, err := uc.UpdateHarvest(ctx, uuid.New(), "Farmer", ids.HarvestID, dtos.Update)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "you do not own this land")
+	})
+
+	t.Run("should return error when creating harvest on land of another farmer", func(t *testing.T) {
+		repo.LandCommodity.EXPECT().FindByID(ctx, ids.LandCommodityID).Return(ownedLandCommodity, nil).Times(1)
+
+		resp, err := uc.CreateHarvest(ctx, uuid.New(), "Farmer", dtos.Create)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "you do not own this land")
+	})
+
+	t.Run("should return error when land of harvest is missing", func(t *testing.T) {
+		repo.Harvest.EXPECT().FindDeletedByID(ctx, ids.HarvestID).Return(domains.Harvest, nil).Times(1)
+		repo.LandCommodity.EXPECT().FindByID(ctx, ids.LandCommodityID).Return(domains.LandCommodity, nil).Times(1)
+
+		resp, err := uc.RestoreHarvest(ctx, ids.UserID, "Farmer", ids.HarvestID)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "land not found")
+	})
+}
+
 func TestHarvestUsecase_RestoreHarvest(t *testing.T) {
 	ids, domains, _, repo, uc, ctx := HarvestUsecaseSetup(t)
 
@@ -705,7 +757,7 @@ func TestHarvestUsecase_RestoreHarvest(t *testing.T) {
 
 		repo.Cache.EXPECT().DeleteByPattern(ctx, "harvest").Return(nil).Times(1)
 
-		resp, err := uc.RestoreHarvest(ctx, ids.HarvestID)
+		resp, err := uc.RestoreHarvest(ctx, ids.UserID, "Admin", ids.HarvestID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, ids.HarvestID, resp.ID)
@@ -716,7 +768,7 @@ func TestHarvestUsecase_RestoreHarvest(t *testing.T) {
 
 		repo.Harvest.EXPECT().FindDeletedByID(ctx, ids.HarvestID).Return(nil, utils.NewNotFoundError("deleted harvest not found")).Times(1)
 
-		resp, err := uc.RestoreHarvest(ctx, ids.HarvestID)
+		resp, err := uc.RestoreHarvest(ctx, ids.UserID, "Admin", ids.HarvestID)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -730,7 +782,7 @@ func TestHarvestUsecase_RestoreHarvest(t *testing.T) {
 
 		repo.Harvest.EXPECT().Restore(ctx, ids.HarvestID).Return(utils.NewInternalError("internal error")).Times(1)
 
-		resp, err := uc.RestoreHarvest(ctx, ids.HarvestID)
+		resp, err := uc.RestoreHarvest(ctx, ids.UserID, "Admin", ids.HarvestID)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -744,7 +796,7 @@ func TestHarvestUsecase_RestoreHarvest(t *testing.T) {
 
 		repo.Harvest.EXPECT().Restore(ctx, ids.HarvestID).Return(utils.NewInternalError("internal error")).Times(1)
 
-		resp, err := uc.RestoreHarvest(ctx, ids.HarvestID)
+		resp, err := uc.RestoreHarvest(ctx, ids.UserID, "Admin", ids.HarvestID)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -762,7 +814,7 @@ func TestHarvestUsecase_RestoreHarvest(t *testing.T) {
 
 		repo.Cache.EXPECT().DeleteByPattern(ctx, "harvest").Return(utils.NewInternalError("internal error")).Times(1)
 
-		resp, err := uc.RestoreHarvest(ctx, ids.HarvestID)
+		resp, err := uc.RestoreHarvest(ctx, ids.UserID, "Admin", ids.HarvestID)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -778,7 +830,7 @@ func TestHarvestUsecase_RestoreHarvest(t *testing.T) {
 
 		repo.Harvest.EXPECT().FindByID(ctx, ids.HarvestID).Return(nil, utils.NewInternalError("internal error")).Times(1)
 
-		resp, err := uc.RestoreHarvest(ctx, ids.HarvestID)
+		resp, err := uc.RestoreHarvest(ctx, ids.UserID, "Admin", ids.HarvestID)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
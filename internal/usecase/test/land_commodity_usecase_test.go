@@ -30,6 +30,7 @@ type LandCommodityIDs struct {
 	CommodityID     uuid.UUID
 	LandID          uuid.UUID
 	LandCommodityID uuid.UUID
+	UserID          uuid.UUID
 }
 
 type LandCommodityMocks struct {
@@ -49,11 +50,13 @@ func LandCommodityUtils(t *testing.T) (*LandCommodityIDs, *LandCommodityMocks, *
 	landID := uuid.New()
 	commodityID := uuid.New()
 	landCommodityID := uuid.New()
+	userID := uuid.New()
 
 	ids := &LandCommodityIDs{
 		CommodityID:     commodityID,
 		LandID:          landID,
 		LandCommodityID: landCommodityID,
+		UserID:          userID,
 	}
 
 	mocks := &LandCommodityMocks{
@@ -88,6 +91,7 @@ func LandCommodityUtils(t *testing.T) (*LandCommodityIDs, *LandCommodityMocks, *
 		},
 		Land: &domain.Land{
 			ID:       landID,
+			UserID:   userID,
 			LandArea: float64(1000),
 		},
 		Commodity: &domain.Commodity{
@@ -144,7 +148,7 @@ func TestLandCommodityUsecase_CreateLandCommodity(t *testing.T) {
 
 		repo.LandCommodity.EXPECT().FindByID(ctx, ids.LandCommodityID).Return(mocks.LandCommodity, nil).Times(1)
 
-		resp, err := uc.CreateLandCommodity(ctx, dtos.Create)
+		resp, err := uc.CreateLandCommodity(ctx, ids.UserID, "Admin", dtos.Create)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
@@ -160,7 +164,7 @@ func TestLandCommodityUsecase_CreateLandCommodity(t *testing.T) {
 		repo.LandCommodity.EXPECT().SumNotHarvestedLandAreaByLandID(ctx, ids.LandID).Return(float64(1000), nil).Times(1)
 
 		req := &dto.LandCommodityCreateDTO{LandID: ids.LandID, CommodityID: ids.CommodityID, LandArea: float64(100)}
-		resp, err := uc.CreateLandCommodity(ctx, req)
+		resp, err := uc.CreateLandCommodity(ctx, ids.UserID, "Admin", req)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -168,7 +172,7 @@ func TestLandCommodityUsecase_CreateLandCommodity(t *testing.T) {
 	})
 
 	t.Run("should return error validation error", func(t *testing.T) {
-		resp, err := uc.CreateLandCommodity(ctx, &dto.LandCommodityCreateDTO{LandID: ids.LandID, CommodityID: ids.CommodityID, LandArea: 0})
+		resp, err := uc.CreateLandCommodity(ctx, ids.UserID, "Admin", &dto.LandCommodityCreateDTO{LandID: ids.LandID, CommodityID: ids.CommodityID, LandArea: 0})
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -178,7 +182,7 @@ func TestLandCommodityUsecase_CreateLandCommodity(t *testing.T) {
 	t.Run("should return error when commodity not found", func(t *testing.T) {
 		repo.Commodity.EXPECT().FindByID(ctx, ids.CommodityID).Return(nil, utils.NewNotFoundError("commodity not found")).Times(1)
 
-		resp, err := uc.CreateLandCommodity(ctx, dtos.Create)
+		resp, err := uc.CreateLandCommodity(ctx, ids.UserID, "Admin", dtos.Create)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -190,12 +194,22 @@ func TestLandCommodityUsecase_CreateLandCommodity(t *testing.T) {
 
 		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(nil, utils.NewNotFoundError("land not found")).Times(1)
 
-		resp, err := uc.CreateLandCommodity(ctx, dtos.Create)
+		resp, err := uc.CreateLandCommodity(ctx, ids.UserID, "Admin", dtos.Create)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
 		assert.EqualError(t, err, "land not found")
 	})
+
+	t.Run("should return error when creating on land of another farmer", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, ids.CommodityID).Return(mocks.Commodity, nil).Times(1)
+		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(mocks.Land, nil).Times(1)
+
+		resp, err := uc.CreateLandCommodity(ctx, uuid.New(), "Farmer", dtos.Create)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "you do not own this land")
+	})
 }
 
 func TestLandCommodityUsecase_GetLandCommodityByID(t *testing.T) {
@@ -343,7 +357,7 @@ func TestLandCommodityUsecase_UpdateLandCommodity(t *testing.T) {
 	ids, mocks, dtos, repo, uc, ctx := LandCommodityUtils(t)
 
 	t.Run("should return error when validation error", func(t *testing.T) {
-		resp, err := uc.UpdateLandCommodity(ctx, ids.LandCommodityID, &dto.LandCommodityUpdateDTO{LandArea: 0})
+		resp, err := uc.UpdateLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID, &dto.LandCommodityUpdateDTO{LandArea: 0})
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -352,7 +366,7 @@ func TestLandCommodityUsecase_UpdateLandCommodity(t *testing.T) {
 	t.Run("should return error when land commodity not found", func(t *testing.T) {
 		repo.LandCommodity.EXPECT().FindByID(ctx, ids.LandCommodityID).Return(nil, utils.NewNotFoundError("land commodity not found")).Times(1)
 
-		resp, err := uc.UpdateLandCommodity(ctx, ids.LandCommodityID, dtos.Update)
+		resp, err := uc.UpdateLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID, dtos.Update)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -363,7 +377,7 @@ func TestLandCommodityUsecase_UpdateLandCommodity(t *testing.T) {
 		mocks.LandCommodity.Harvested = true
 		repo.LandCommodity.EXPECT().FindByID(ctx, ids.LandCommodityID).Return(mocks.LandCommodity, nil).Times(1)
 
-		resp, err := uc.UpdateLandCommodity(ctx, ids.LandCommodityID, dtos.Update)
+		resp, err := uc.UpdateLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID, dtos.Update)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -376,7 +390,7 @@ func TestLandCommodityUsecase_UpdateLandCommodity(t *testing.T) {
 
 		repo.Commodity.EXPECT().FindByID(ctx, ids.CommodityID).Return(nil, utils.NewNotFoundError("commodity not found")).Times(1)
 
-		resp, err := uc.UpdateLandCommodity(ctx, ids.LandCommodityID, dtos.Update)
+		resp, err := uc.UpdateLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID, dtos.Update)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -390,7 +404,7 @@ func TestLandCommodityUsecase_UpdateLandCommodity(t *testing.T) {
 
 		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(nil, utils.NewNotFoundError("land not found")).Times(1)
 
-		resp, err := uc.UpdateLandCommodity(ctx, ids.LandCommodityID, dtos.Update)
+		resp, err := uc.UpdateLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID, dtos.Update)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -406,7 +420,7 @@ func TestLandCommodityUsecase_UpdateLandCommodity(t *testing.T) {
 
 		repo.LandCommodity.EXPECT().SumNotHarvestedLandAreaByLandID(ctx, ids.LandID).Return(float64(1000), nil).Times(1)
 
-		resp, err := uc.UpdateLandCommodity(ctx, ids.LandCommodityID, dtos.Update)
+		resp, err := uc.UpdateLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID, dtos.Update)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -424,7 +438,7 @@ func TestLandCommodityUsecase_UpdateLandCommodity(t *testing.T) {
 
 		repo.LandCommodity.EXPECT().Update(ctx, ids.LandCommodityID, mocks.LandCommodity).Return(utils.NewInternalError("internal error")).Times(1)
 
-		resp, err := uc.UpdateLandCommodity(ctx, ids.LandCommodityID, dtos.Update)
+		resp, err := uc.UpdateLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID, dtos.Update)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -445,7 +459,7 @@ func TestLandCommodityUsecase_UpdateLandCommodity(t *testing.T) {
 
 		repo.LandCommodity.EXPECT().FindByID(ctx, ids.LandCommodityID).Return(mocks.UpdatedLandCommodity, nil).Times(1)
 
-		resp, err := uc.UpdateLandCommodity(ctx, ids.LandCommodityID, dtos.Update)
+		resp, err := uc.UpdateLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID, dtos.Update)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
@@ -463,7 +477,7 @@ func TestLandCommodityUsecase_DeleteLandCommodity(t *testing.T) {
 
 		repo.LandCommodity.EXPECT().Delete(ctx, ids.LandCommodityID).Return(nil).Times(1)
 
-		err := uc.DeleteLandCommodity(ctx, ids.LandCommodityID)
+		err := uc.DeleteLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID)
 
 		assert.NoError(t, err)
 	})
@@ -471,7 +485,7 @@ func TestLandCommodityUsecase_DeleteLandCommodity(t *testing.T) {
 	t.Run("should return error when land commodity not found", func(t *testing.T) {
 		repo.LandCommodity.EXPECT().FindByID(ctx, ids.LandCommodityID).Return(nil, utils.NewNotFoundError("land commodity not found")).Times(1)
 
-		err := uc.DeleteLandCommodity(ctx, ids.LandCommodityID)
+		err := uc.DeleteLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID)
 
 		assert.Error(t, err)
 		assert.EqualError(t, err, "land commodity not found")
@@ -482,11 +496,32 @@ func TestLandCommodityUsecase_DeleteLandCommodity(t *testing.T) {
 
 		repo.LandCommodity.EXPECT().Delete(ctx, ids.LandCommodityID).Return(utils.NewInternalError("database error")).Times(1)
 
-		err := uc.DeleteLandCommodity(ctx, ids.LandCommodityID)
+		err := uc.DeleteLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID)
 
 		assert.Error(t, err)
 		assert.EqualError(t, err, "database error")
 	})
+
+	t.Run("should delete land commodity as land owner", func(t *testing.T) {
+		repo.LandCommodity.EXPECT().FindByID(ctx, ids.LandCommodityID).Return(mocks.LandCommodity, nil).Times(1)
+		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(mocks.Land, nil).Times(1)
+		repo.LandCommodity.EXPECT().Delete(ctx, ids.LandCommodityID).Return(nil).Times(1)
+
+		err := uc.DeleteLandCommodity(ctx, ids.UserID, "Farmer", ids.LandCommodityID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when land belongs to another farmer", func(t *testing.T) {
+		landCommodity := *mocks.LandCommodity
+		landCommodity.Land = mocks.Land
+		repo.LandCommodity.EXPECT().FindByID(ctx, ids.LandCommodityID).Return(&landCommodity, nil).Times(1)
+
+		err := uc.DeleteLandCommodity(ctx, uuid.New(), "Farmer", ids.LandCommodityID)
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "you do not own this land")
+	})
 }
 
 func TestLandCommodityUsecase_RestoreLandCommodity(t *testing.T) {
@@ -501,7 +536,7 @@ func TestLandCommodityUsecase_RestoreLandCommodity(t *testing.T) {
 
 		repo.LandCommodity.EXPECT().FindByID(ctx, ids.LandCommodityID).Return(mocks.LandCommodity, nil).Times(1)
 
-		resp, err := uc.RestoreLandCommodity(ctx, ids.LandCommodityID)
+		resp, err := uc.RestoreLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
@@ -511,7 +546,7 @@ func TestLandCommodityUsecase_RestoreLandCommodity(t *testing.T) {
 	t.Run("should return error when deleted land commodity not found", func(t *testing.T) {
 		repo.LandCommodity.EXPECT().FindDeletedByID(ctx, ids.LandCommodityID).Return(nil, utils.NewNotFoundError("deleted land commodity not found")).Times(1)
 
-		resp, err := uc.RestoreLandCommodity(ctx, ids.LandCommodityID)
+		resp, err := uc.RestoreLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -526,7 +561,7 @@ func TestLandCommodityUsecase_RestoreLandCommodity(t *testing.T) {
 
 		repo.LandCommodity.EXPECT().Restore(ctx, ids.LandCommodityID).Return(utils.NewInternalError("internal error")).Times(1)
 
-		resp, err := uc.RestoreLandCommodity(ctx, ids.LandCommodityID)
+		resp, err := uc.RestoreLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -540,7 +575,7 @@ func TestLandCommodityUsecase_RestoreLandCommodity(t *testing.T) {
 
 		repo.LandCommodity.EXPECT().SumLandAreaByLandID(ctx, ids.LandID).Return(float64(1000), nil).Times(1)
 
-		resp, err := uc.RestoreLandCommodity(ctx, ids.LandCommodityID)
+		resp, err := uc.RestoreLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -558,7 +593,7 @@ func TestLandCommodityUsecase_RestoreLandCommodity(t *testing.T) {
 
 		repo.LandCommodity.EXPECT().FindByID(ctx, ids.LandCommodityID).Return(nil, utils.NewNotFoundError("restored land not found")).Times(1)
 
-		resp, err := uc.RestoreLandCommodity(ctx, ids.LandCommodityID)
+		resp, err := uc.RestoreLandCommodity(ctx, ids.UserID, "Admin", ids.LandCommodityID)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -19,6 +19,7 @@ import (
 type LandRepoMock struct {
 	Land *mock_repo.MockLandRepository
 	User *mock_repo.MockUserRepository
+	City *mock_repo.MockCityRepository
 }
 
 type LandIDs struct {
@@ -90,10 +91,11 @@ func LandUsecaseUtils(t *testing.T) (*LandIDs, *LandMocks, *LandDTOMock, *LandRe
 
 	landRepo := mock_repo.NewMockLandRepository(ctrl)
 	userRepo := mock_repo.NewMockUserRepository(ctrl)
-	uc := usecase_implementation.NewLandUsecase(landRepo, userRepo)
+	cityRepo := mock_repo.NewMockCityRepository(ctrl)
+	uc := usecase_implementation.NewLandUsecase(landRepo, userRepo, cityRepo)
 	ctx := context.TODO()
 
-	repo := &LandRepoMock{Land: landRepo, User: userRepo}
+	repo := &LandRepoMock{Land: landRepo, User: userRepo, City: cityRepo}
 
 	return ids, mocks, dto, repo, uc, ctx
 }
@@ -257,7 +259,7 @@ func TestLandUsecase_UpdateLand(t *testing.T) {
 
 		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(mocks.UpdatedLand, nil).Times(1)
 
-		resp, err := uc.UpdateLand(ctx, ids.UserID, ids.LandID, dtos.Update)
+		resp, err := uc.UpdateLand(ctx, ids.UserID, "Farmer", ids.LandID, dtos.Update)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
@@ -265,10 +267,19 @@ func TestLandUsecase_UpdateLand(t *testing.T) {
 		assert.Equal(t, dtos.Update.Certificate, resp.Certificate)
 	})
 
+	t.Run("should return error when land belongs to another farmer", func(t *testing.T) {
+		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(mocks.Land, nil).Times(1)
+
+		resp, err := uc.UpdateLand(ctx, uuid.New(), "Farmer", ids.LandID, dtos.Update)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "you do not own this land")
+	})
+
 	t.Run("should return error when land not found", func(t *testing.T) {
 		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(nil, utils.NewNotFoundError("land not found")).Times(1)
 
-		resp, err := uc.UpdateLand(ctx, ids.UserID, ids.LandID, dtos.Update)
+		resp, err := uc.UpdateLand(ctx, ids.UserID, "Farmer", ids.LandID, dtos.Update)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -278,7 +289,7 @@ func TestLandUsecase_UpdateLand(t *testing.T) {
 	t.Run("should return error validation error", func(t *testing.T) {
 		updateReq := &dto.LandUpdateDTO{LandArea: -1, Certificate: ""}
 
-		resp, err := uc.UpdateLand(ctx, ids.UserID, ids.LandID, updateReq)
+		resp, err := uc.UpdateLand(ctx, ids.UserID, "Farmer", ids.LandID, updateReq)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -290,7 +301,7 @@ func TestLandUsecase_UpdateLand(t *testing.T) {
 
 		repo.Land.EXPECT().Update(ctx, ids.LandID, mocks.Land).Return(utils.NewInternalError("internal error")).Times(1)
 
-		resp, err := uc.UpdateLand(ctx, ids.UserID, ids.LandID, dtos.Update)
+		resp, err := uc.UpdateLand(ctx, ids.UserID, "Farmer", ids.LandID, dtos.Update)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -309,7 +320,7 @@ func TestLandUsecase_UpdateLand(t *testing.T) {
 		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(nil, utils.NewInternalError("internal error")).Times(1)
 
 		// Eksekusi fungsi
-		resp, err := uc.UpdateLand(ctx, ids.UserID, ids.LandID, dtos.Update)
+		resp, err := uc.UpdateLand(ctx, ids.UserID, "Farmer", ids.LandID, dtos.Update)
 
 		// Validasi hasil
 		assert.Error(t, err)
@@ -319,6 +330,59 @@ func TestLandUsecase_UpdateLand(t *testing.T) {
 
 }
 
+func TestLandUsecase_UpdateLandAsFieldOfficer(t *testing.T) {
+	ids, mocks, dtos, repo, uc, ctx := LandUsecaseUtils(t)
+	officerID := uuid.New()
+	provinceID := int64(1)
+	mocks.Land.CityID = 1
+
+	t.Run("should update land in the supervised province", func(t *testing.T) {
+		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(mocks.Land, nil).Times(1)
+		repo.User.EXPECT().FindWithRoleByID(ctx, officerID).Return(&domain.User{ID: officerID, ProvinceID: &provinceID}, nil).Times(1)
+		repo.City.EXPECT().FindByID(ctx, int64(1)).Return(&domain.City{ID: 1, ProvinceID: provinceID}, nil).Times(1)
+		repo.Land.EXPECT().Update(ctx, ids.LandID, gomock.Any()).Return(nil).Times(1)
+		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(mocks.UpdatedLand, nil).Times(1)
+
+		resp, err := uc.UpdateLand(ctx, officerID, domain.RoleFieldOfficer, ids.LandID, dtos.Update)
+
+		assert.NoError(t, err)
+		assert.Equal(t, mocks.UpdatedLand, resp)
+	})
+
+	t.Run("should return error when land is outside the supervised province", func(t *testing.T) {
+		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(mocks.Land, nil).Times(1)
+		repo.User.EXPECT().FindWithRoleByID(ctx, officerID).Return(&domain.User{ID: officerID, ProvinceID: &provinceID}, nil).Times(1)
+		repo.City.EXPECT().FindByID(ctx, int64(1)).Return(&domain.City{ID: 1, ProvinceID: 2}, nil).Times(1)
+
+		resp, err := uc.UpdateLand(ctx, officerID, domain.RoleFieldOfficer, ids.LandID, dtos.Update)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "land is outside the province you supervise")
+	})
+
+	t.Run("should return error when officer has no province", func(t *testing.T) {
+		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(mocks.Land, nil).Times(1)
+		repo.User.EXPECT().FindWithRoleByID(ctx, officerID).Return(&domain.User{ID: officerID}, nil).Times(1)
+		repo.City.EXPECT().FindByID(ctx, int64(1)).Return(&domain.City{ID: 1, ProvinceID: provinceID}, nil).Times(1)
+
+		resp, err := uc.UpdateLand(ctx, officerID, domain.RoleFieldOfficer, ids.LandID, dtos.Update)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "land is outside the province you supervise")
+	})
+
+	t.Run("should return error when city not found", func(t *testing.T) {
+		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(mocks.Land, nil).Times(1)
+		repo.User.EXPECT().FindWithRoleByID(ctx, officerID).Return(&domain.User{ID: officerID, ProvinceID: &provinceID}, nil).Times(1)
+		repo.City.EXPECT().FindByID(ctx, int64(1)).Return(nil, errors.New("record not found")).Times(1)
+
+		resp, err := uc.UpdateLand(ctx, officerID, domain.RoleFieldOfficer, ids.LandID, dtos.Update)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "city not found")
+	})
+}
+
 func TestLandUsecase_DeleteLand(t *testing.T) {
 	ids, mocks, _, repo, uc, ctx := LandUsecaseUtils(t)
 
@@ -327,14 +391,14 @@ func TestLandUsecase_DeleteLand(t *testing.T) {
 		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(mocks.Land, nil).Times(1)
 		repo.Land.EXPECT().Delete(ctx, ids.LandID).Return(nil).Times(1)
 
-		err := uc.DeleteLand(ctx, ids.LandID)
+		err := uc.DeleteLand(ctx, ids.UserID, "Farmer", ids.LandID)
 
 		assert.NoError(t, err)
 	})
 
 	t.Run("should return error when land not found", func(t *testing.T) {
 		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(nil, utils.NewNotFoundError("land not found")).Times(1)
-		err := uc.DeleteLand(ctx, ids.LandID)
+		err := uc.DeleteLand(ctx, ids.UserID, "Farmer", ids.LandID)
 
 		assert.Error(t, err)
 		assert.EqualError(t, err, "land not found")
@@ -344,11 +408,29 @@ func TestLandUsecase_DeleteLand(t *testing.T) {
 		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(mocks.Land, nil).Times(1)
 		repo.Land.EXPECT().Delete(ctx, ids.LandID).Return(utils.NewInternalError("internal error")).Times(1)
 
-		err := uc.DeleteLand(ctx, ids.LandID)
+		err := uc.DeleteLand(ctx, ids.UserID, "Farmer", ids.LandID)
 
 		assert.Error(t, err)
 		assert.EqualError(t, err, "internal error")
 	})
+
+	t.Run("should return error when land belongs to another farmer", func(t *testing.T) {
+		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(mocks.Land, nil).Times(1)
+
+		err := uc.DeleteLand(ctx, uuid.New(), "Farmer", ids.LandID)
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "you do not own this land")
+	})
+
+	t.Run("should delete land of another user as admin", func(t *testing.T) {
+		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(mocks.Land, nil).Times(1)
+		repo.Land.EXPECT().Delete(ctx, ids.LandID).Return(nil).Times(1)
+
+		err := uc.DeleteLand(ctx, uuid.New(), "Admin", ids.LandID)
+
+		assert.NoError(t, err)
+	})
 }
 
 func TestLandUsecase_RestoreLand(t *testing.T) {
@@ -362,7 +444,7 @@ func TestLandUsecase_RestoreLand(t *testing.T) {
 
 		repo.Land.EXPECT().FindByID(ctx, ids.LandID).Return(mocks.Land, nil).Times(1)
 
-		resp, err := uc.RestoreLand(ctx, ids.LandID)
+		resp, err := uc.RestoreLand(ctx, ids.UserID, "Farmer", ids.LandID)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
@@ -372,7 +454,7 @@ func TestLandUsecase_RestoreLand(t *testing.T) {
 	t.Run("should return error when land not found", func(t *testing.T) {
 		repo.Land.EXPECT().FindDeletedByID(ctx, ids.LandID).Return(nil, utils.NewNotFoundError("land not found")).Times(1)
 
-		resp, err := uc.RestoreLand(ctx, ids.LandID)
+		resp, err := uc.RestoreLand(ctx, ids.UserID, "Farmer", ids.LandID)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -385,7 +467,7 @@ func TestLandUsecase_RestoreLand(t *testing.T) {
 
 		repo.Land.EXPECT().Restore(ctx, ids.LandID).Return(errors.New("internal error")).Times(1)
 
-		resp, err := uc.RestoreLand(ctx, ids.LandID)
+		resp, err := uc.RestoreLand(ctx, ids.UserID, "Farmer", ids.LandID)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
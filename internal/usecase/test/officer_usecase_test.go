@@ -0,0 +1,201 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	mock_repo "github.com/ryvasa/go-super-farmer/internal/repository/mock"
+	usecase_implementation "github.com/ryvasa/go-super-farmer/internal/usecase/implementation"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/stretchr/testify/assert"
+)
+
+type OfficerRepoMock struct {
+	User     *mock_repo.MockUserRepository
+	Province *mock_repo.MockProvinceRepository
+	Land     *mock_repo.MockLandRepository
+}
+
+type OfficerMocks struct {
+	Officer  *domain.User
+	Farmer   *domain.User
+	Province *domain.Province
+	Lands    []*domain.Land
+}
+
+func OfficerUsecaseUtils(t *testing.T) (*OfficerMocks, *OfficerRepoMock, usecase_interface.OfficerUsecase, context.Context) {
+	provinceID := int64(1)
+	farmerID := uuid.New()
+	phone := "08123456789"
+
+	mocks := &OfficerMocks{
+		Officer: &domain.User{
+			ID:         uuid.New(),
+			Name:       "officer",
+			RoleID:     4,
+			Role:       domain.Role{ID: 4, Name: domain.RoleFieldOfficer},
+			ProvinceID: &provinceID,
+		},
+		Farmer: &domain.User{
+			ID:     farmerID,
+			Name:   "farmer",
+			RoleID: 2,
+			Role:   domain.Role{ID: 2, Name: domain.RoleFarmer},
+			Phone:  &phone,
+		},
+		Province: &domain.Province{ID: provinceID, Name: "Aceh"},
+		Lands: []*domain.Land{
+			{
+				ID:       uuid.New(),
+				UserID:   farmerID,
+				User:     &domain.User{ID: farmerID, Name: "farmer", Phone: &phone},
+				CityID:   1,
+				City:     &domain.City{ID: 1, Name: "Kota Banda Aceh", ProvinceID: provinceID},
+				LandArea: 100,
+				Unit:     "ha",
+			},
+			{
+				ID:       uuid.New(),
+				UserID:   farmerID,
+				User:     &domain.User{ID: farmerID, Name: "farmer", Phone: &phone},
+				CityID:   2,
+				City:     &domain.City{ID: 2, Name: "Kota Sabang", ProvinceID: provinceID},
+				LandArea: 50,
+				Unit:     "ha",
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mock_repo.NewMockUserRepository(ctrl)
+	provinceRepo := mock_repo.NewMockProvinceRepository(ctrl)
+	landRepo := mock_repo.NewMockLandRepository(ctrl)
+	uc := usecase_implementation.NewOfficerUsecase(userRepo, provinceRepo, landRepo)
+	ctx := context.TODO()
+
+	repo := &OfficerRepoMock{User: userRepo, Province: provinceRepo, Land: landRepo}
+
+	return mocks, repo, uc, ctx
+}
+
+func TestOfficerUsecase_AssignProvince(t *testing.T) {
+	mocks, repo, uc, ctx := OfficerUsecaseUtils(t)
+	req := &dto.OfficerProvinceDTO{ProvinceID: mocks.Province.ID}
+
+	t.Run("should assign province successfully", func(t *testing.T) {
+		repo.User.EXPECT().FindWithRoleByID(ctx, mocks.Officer.ID).Return(mocks.Officer, nil).Times(1)
+		repo.Province.EXPECT().FindByID(ctx, mocks.Province.ID).Return(mocks.Province, nil).Times(1)
+		repo.User.EXPECT().Update(ctx, mocks.Officer.ID, &domain.User{ProvinceID: &req.ProvinceID}).Return(nil).Times(1)
+		repo.User.EXPECT().FindWithRoleByID(ctx, mocks.Officer.ID).Return(mocks.Officer, nil).Times(1)
+
+		resp, err := uc.AssignProvince(ctx, mocks.Officer.ID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, mocks.Officer.ID, resp.ID)
+		assert.Equal(t, mocks.Officer.ProvinceID, resp.ProvinceID)
+	})
+
+	t.Run("should return error when user is not a field officer", func(t *testing.T) {
+		repo.User.EXPECT().FindWithRoleByID(ctx, mocks.Farmer.ID).Return(mocks.Farmer, nil).Times(1)
+
+		resp, err := uc.AssignProvince(ctx, mocks.Farmer.ID, req)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "user is not a field officer")
+	})
+
+	t.Run("should return error when user not found", func(t *testing.T) {
+		repo.User.EXPECT().FindWithRoleByID(ctx, mocks.Officer.ID).Return(nil, errors.New("record not found")).Times(1)
+
+		resp, err := uc.AssignProvince(ctx, mocks.Officer.ID, req)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "user not found")
+	})
+
+	t.Run("should return error when province not found", func(t *testing.T) {
+		repo.User.EXPECT().FindWithRoleByID(ctx, mocks.Officer.ID).Return(mocks.Officer, nil).Times(1)
+		repo.Province.EXPECT().FindByID(ctx, mocks.Province.ID).Return(nil, errors.New("record not found")).Times(1)
+
+		resp, err := uc.AssignProvince(ctx, mocks.Officer.ID, req)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "province not found")
+	})
+
+	t.Run("should return error validation error", func(t *testing.T) {
+		resp, err := uc.AssignProvince(ctx, mocks.Officer.ID, &dto.OfficerProvinceDTO{})
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "Validation failed")
+	})
+
+	t.Run("should return error when update fails", func(t *testing.T) {
+		repo.User.EXPECT().FindWithRoleByID(ctx, mocks.Officer.ID).Return(mocks.Officer, nil).Times(1)
+		repo.Province.EXPECT().FindByID(ctx, mocks.Province.ID).Return(mocks.Province, nil).Times(1)
+		repo.User.EXPECT().Update(ctx, mocks.Officer.ID, gomock.Any()).Return(errors.New("internal error")).Times(1)
+
+		resp, err := uc.AssignProvince(ctx, mocks.Officer.ID, req)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "internal error")
+	})
+}
+
+func TestOfficerUsecase_GetDashboard(t *testing.T) {
+	mocks, repo, uc, ctx := OfficerUsecaseUtils(t)
+
+	t.Run("should return the farms in the supervised province", func(t *testing.T) {
+		repo.User.EXPECT().FindWithRoleByID(ctx, mocks.Officer.ID).Return(mocks.Officer, nil).Times(1)
+		repo.Province.EXPECT().FindByID(ctx, mocks.Province.ID).Return(mocks.Province, nil).Times(1)
+		repo.Land.EXPECT().FindByProvinceID(ctx, mocks.Province.ID).Return(mocks.Lands, nil).Times(1)
+
+		resp, err := uc.GetDashboard(ctx, mocks.Officer.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, mocks.Province, resp.Province)
+		assert.Equal(t, 2, resp.TotalFarms)
+		assert.Equal(t, 1, resp.TotalFarmers)
+		assert.Equal(t, float64(150), resp.TotalLandArea)
+		assert.Len(t, resp.Farms, 2)
+		assert.Equal(t, mocks.Lands[0].ID, resp.Farms[0].LandID)
+		assert.Equal(t, "farmer", resp.Farms[0].FarmerName)
+		assert.Equal(t, mocks.Lands[0].City, resp.Farms[0].City)
+	})
+
+	t.Run("should return error when officer has no province", func(t *testing.T) {
+		repo.User.EXPECT().FindWithRoleByID(ctx, mocks.Farmer.ID).Return(mocks.Farmer, nil).Times(1)
+
+		resp, err := uc.GetDashboard(ctx, mocks.Farmer.ID)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "no province assigned to this officer")
+	})
+
+	t.Run("should return error when officer not found", func(t *testing.T) {
+		repo.User.EXPECT().FindWithRoleByID(ctx, mocks.Officer.ID).Return(nil, errors.New("record not found")).Times(1)
+
+		resp, err := uc.GetDashboard(ctx, mocks.Officer.ID)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "user not found")
+	})
+
+	t.Run("should return error when find lands fails", func(t *testing.T) {
+		repo.User.EXPECT().FindWithRoleByID(ctx, mocks.Officer.ID).Return(mocks.Officer, nil).Times(1)
+		repo.Province.EXPECT().FindByID(ctx, mocks.Province.ID).Return(mocks.Province, nil).Times(1)
+		repo.Land.EXPECT().FindByProvinceID(ctx, mocks.Province.ID).Return(nil, errors.New("internal error")).Times(1)
+
+		resp, err := uc.GetDashboard(ctx, mocks.Officer.ID)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "internal error")
+	})
+}
@@ -0,0 +1,159 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	usecase_implementation "github.com/ryvasa/go-super-farmer/internal/usecase/implementation"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	mockCasbin "github.com/ryvasa/go-super-farmer/pkg/auth/casbin/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type PolicyDTOMock struct {
+	Policy *dto.PolicyDTO
+	Update *dto.PolicyUpdateDTO
+}
+
+func PolicyUsecaseUtils(t *testing.T) (*PolicyDTOMock, *mockCasbin.MockCasbin, usecase_interface.PolicyUsecase, context.Context) {
+	dtos := &PolicyDTOMock{
+		Policy: &dto.PolicyDTO{
+			Subject: "Farmer",
+			Object:  "/api/prices*",
+			Action:  "GET",
+		},
+		Update: &dto.PolicyUpdateDTO{
+			Old: dto.PolicyDTO{Subject: "Farmer", Object: "/api/prices*", Action: "GET"},
+			New: dto.PolicyDTO{Subject: "Farmer", Object: "/api/prices*", Action: "*"},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	enforcer := mockCasbin.NewMockCasbin(ctrl)
+	uc := usecase_implementation.NewPolicyUsecase(enforcer)
+	ctx := context.TODO()
+
+	return dtos, enforcer, uc, ctx
+}
+
+func TestPolicyUsecase_GetPolicies(t *testing.T) {
+	_, enforcer, uc, ctx := PolicyUsecaseUtils(t)
+
+	t.Run("should return policies of subject", func(t *testing.T) {
+		enforcer.EXPECT().GetPolicies("Farmer").Return([][]string{{"Farmer", "/api/prices*", "GET"}}, nil).Times(1)
+
+		resp, err := uc.GetPolicies(ctx, "Farmer")
+
+		assert.NoError(t, err)
+		assert.Len(t, resp, 1)
+		assert.Equal(t, "/api/prices*", resp[0].Object)
+	})
+
+	t.Run("should return error when get policies fails", func(t *testing.T) {
+		enforcer.EXPECT().GetPolicies("").Return(nil, errors.New("internal error")).Times(1)
+
+		resp, err := uc.GetPolicies(ctx, "")
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "internal error")
+	})
+}
+
+func TestPolicyUsecase_CreatePolicy(t *testing.T) {
+	dtos, enforcer, uc, ctx := PolicyUsecaseUtils(t)
+
+	t.Run("should create policy successfully", func(t *testing.T) {
+		enforcer.EXPECT().AddPolicy("Farmer", "/api/prices*", "GET").Return(true, nil).Times(1)
+
+		resp, err := uc.CreatePolicy(ctx, dtos.Policy)
+
+		assert.NoError(t, err)
+		assert.Equal(t, dtos.Policy, resp)
+	})
+
+	t.Run("should return error when policy already exists", func(t *testing.T) {
+		enforcer.EXPECT().AddPolicy("Farmer", "/api/prices*", "GET").Return(false, nil).Times(1)
+
+		resp, err := uc.CreatePolicy(ctx, dtos.Policy)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "policy already exists")
+	})
+
+	t.Run("should return error when validation fails", func(t *testing.T) {
+		resp, err := uc.CreatePolicy(ctx, &dto.PolicyDTO{Subject: "Farmer", Object: "prices", Action: "FETCH"})
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "Validation failed")
+	})
+}
+
+func TestPolicyUsecase_UpdatePolicy(t *testing.T) {
+	dtos, enforcer, uc, ctx := PolicyUsecaseUtils(t)
+
+	t.Run("should update policy successfully", func(t *testing.T) {
+		enforcer.EXPECT().HasPolicy("Farmer", "/api/prices*", "*").Return(false, nil).Times(1)
+		enforcer.EXPECT().UpdatePolicy([]string{"Farmer", "/api/prices*", "GET"}, []string{"Farmer", "/api/prices*", "*"}).Return(true, nil).Times(1)
+
+		resp, err := uc.UpdatePolicy(ctx, dtos.Update)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "*", resp.Action)
+	})
+
+	t.Run("should return error when policy not found", func(t *testing.T) {
+		enforcer.EXPECT().HasPolicy("Farmer", "/api/prices*", "*").Return(false, nil).Times(1)
+		enforcer.EXPECT().UpdatePolicy(gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
+
+		resp, err := uc.UpdatePolicy(ctx, dtos.Update)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "policy not found")
+	})
+
+	t.Run("should return error when new policy already exists", func(t *testing.T) {
+		enforcer.EXPECT().HasPolicy("Farmer", "/api/prices*", "*").Return(true, nil).Times(1)
+
+		resp, err := uc.UpdatePolicy(ctx, dtos.Update)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "policy already exists")
+	})
+
+	t.Run("should return error when changing policy administration rule", func(t *testing.T) {
+		resp, err := uc.UpdatePolicy(ctx, &dto.PolicyUpdateDTO{
+			Old: dto.PolicyDTO{Subject: "Admin", Object: "/api/policies*", Action: "*"},
+			New: dto.PolicyDTO{Subject: "Admin", Object: "/api/policies*", Action: "GET"},
+		})
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "policy administration rule can not be changed")
+	})
+}
+
+func TestPolicyUsecase_DeletePolicy(t *testing.T) {
+	dtos, enforcer, uc, ctx := PolicyUsecaseUtils(t)
+
+	t.Run("should delete policy successfully", func(t *testing.T) {
+		enforcer.EXPECT().RemovePolicy("Farmer", "/api/prices*", "GET").Return(true, nil).Times(1)
+
+		err := uc.DeletePolicy(ctx, dtos.Policy)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when policy not found", func(t *testing.T) {
+		enforcer.EXPECT().RemovePolicy("Farmer", "/api/prices*", "GET").Return(false, nil).Times(1)
+
+		err := uc.DeletePolicy(ctx, dtos.Policy)
+		assert.EqualError(t, err, "policy not found")
+	})
+
+	t.Run("should return error when deleting policy administration rule", func(t *testing.T) {
+		err := uc.DeletePolicy(ctx, &dto.PolicyDTO{Subject: "Admin", Object: "/api/policies*", Action: "*"})
+		assert.EqualError(t, err, "policy administration rule can not be deleted")
+	})
+}
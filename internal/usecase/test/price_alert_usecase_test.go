@@ -0,0 +1,255 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	mock_repo "github.com/ryvasa/go-super-farmer/internal/repository/mock"
+	usecase_implementation "github.com/ryvasa/go-super-farmer/internal/usecase/implementation"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type PriceAlertRepoMock struct {
+	PriceAlert *mock_repo.MockPriceAlertRepository
+	Commodity  *mock_repo.MockCommodityRepository
+	City       *mock_repo.MockCityRepository
+}
+
+type PriceAlertIDs struct {
+	PriceAlertID uuid.UUID
+	UserID       uuid.UUID
+	CommodityID  uuid.UUID
+	CityID       int64
+}
+
+type PriceAlertMocks struct {
+	PriceAlert *domain.PriceAlert
+}
+
+type PriceAlertDTOMock struct {
+	Create *dto.PriceAlertCreateDTO
+}
+
+func PriceAlertUsecaseUtils(t *testing.T) (*PriceAlertIDs, *PriceAlertMocks, *PriceAlertDTOMock, *PriceAlertRepoMock, usecase_interface.PriceAlertUsecase, context.Context) {
+	ids := &PriceAlertIDs{
+		PriceAlertID: uuid.New(),
+		UserID:       uuid.New(),
+		CommodityID:  uuid.New(),
+		CityID:       1,
+	}
+
+	target := float64(12000)
+	mocks := &PriceAlertMocks{
+		PriceAlert: &domain.PriceAlert{
+			ID:              ids.PriceAlertID,
+			UserID:          ids.UserID,
+			CommodityID:     ids.CommodityID,
+			CityID:          ids.CityID,
+			Condition:       domain.PriceAlertAbove,
+			TargetPrice:     &target,
+			WindowDays:      7,
+			CooldownMinutes: 1440,
+			Active:          true,
+		},
+	}
+
+	dtos := &PriceAlertDTOMock{
+		Create: &dto.PriceAlertCreateDTO{
+			CommodityID: ids.CommodityID,
+			CityID:      ids.CityID,
+			Condition:   domain.PriceAlertAbove,
+			TargetPrice: &target,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	priceAlertRepo := mock_repo.NewMockPriceAlertRepository(ctrl)
+	commodityRepo := mock_repo.NewMockCommodityRepository(ctrl)
+	cityRepo := mock_repo.NewMockCityRepository(ctrl)
+	uc := usecase_implementation.NewPriceAlertUsecase(priceAlertRepo, commodityRepo, cityRepo)
+	ctx := context.TODO()
+
+	repo := &PriceAlertRepoMock{PriceAlert: priceAlertRepo, Commodity: commodityRepo, City: cityRepo}
+
+	return ids, mocks, dtos, repo, uc, ctx
+}
+
+func TestPriceAlertUsecase_CreatePriceAlert(t *testing.T) {
+	ids, _, dtos, repo, uc, ctx := PriceAlertUsecaseUtils(t)
+
+	t.Run("should create price alert with defaults", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, ids.CommodityID).Return(&domain.Commodity{ID: ids.CommodityID}, nil).Times(1)
+		repo.City.EXPECT().FindByID(ctx, ids.CityID).Return(&domain.City{ID: ids.CityID}, nil).Times(1)
+		repo.PriceAlert.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, alert *domain.PriceAlert) error {
+			assert.Equal(t, ids.UserID, alert.UserID)
+			assert.True(t, alert.Active)
+			assert.Nil(t, alert.ChangePercent)
+			return nil
+		}).Times(1)
+
+		resp, err := uc.CreatePriceAlert(ctx, ids.UserID, dtos.Create)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PriceAlertAbove, resp.Condition)
+		assert.Equal(t, 7, resp.WindowDays)
+		assert.Equal(t, 1440, resp.CooldownMinutes)
+	})
+
+	t.Run("should return error validation error when change percent is missing", func(t *testing.T) {
+		resp, err := uc.CreatePriceAlert(ctx, ids.UserID, &dto.PriceAlertCreateDTO{
+			CommodityID: ids.CommodityID,
+			CityID:      ids.CityID,
+			Condition:   domain.PriceAlertChange,
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "Validation failed")
+	})
+
+	t.Run("should return error validation error when target price is missing", func(t *testing.T) {
+		resp, err := uc.CreatePriceAlert(ctx, ids.UserID, &dto.PriceAlertCreateDTO{
+			CommodityID: ids.CommodityID,
+			CityID:      ids.CityID,
+			Condition:   domain.PriceAlertBelow,
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "Validation failed")
+	})
+
+	t.Run("should return error when commodity not found", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, ids.CommodityID).Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+
+		resp, err := uc.CreatePriceAlert(ctx, ids.UserID, dtos.Create)
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "commodity not found")
+	})
+
+	t.Run("should return error when city not found", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, ids.CommodityID).Return(&domain.Commodity{ID: ids.CommodityID}, nil).Times(1)
+		repo.City.EXPECT().FindByID(ctx, ids.CityID).Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+
+		resp, err := uc.CreatePriceAlert(ctx, ids.UserID, dtos.Create)
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "city not found")
+	})
+}
+
+func TestPriceAlertUsecase_GetPriceAlerts(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := PriceAlertUsecaseUtils(t)
+
+	t.Run("should return alerts of the user", func(t *testing.T) {
+		repo.PriceAlert.EXPECT().FindByUserID(ctx, ids.UserID).Return([]*domain.PriceAlert{mocks.PriceAlert}, nil).Times(1)
+
+		resp, err := uc.GetPriceAlerts(ctx, ids.UserID)
+
+		assert.NoError(t, err)
+		assert.Len(t, resp, 1)
+		assert.Equal(t, ids.PriceAlertID, resp[0].ID)
+	})
+
+	t.Run("should return error internal error", func(t *testing.T) {
+		repo.PriceAlert.EXPECT().FindByUserID(ctx, ids.UserID).Return(nil, utils.NewInternalError("database error")).Times(1)
+
+		resp, err := uc.GetPriceAlerts(ctx, ids.UserID)
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+	})
+}
+
+func TestPriceAlertUsecase_GetPriceAlertByID(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := PriceAlertUsecaseUtils(t)
+
+	t.Run("should return alert of the user", func(t *testing.T) {
+		repo.PriceAlert.EXPECT().FindByID(ctx, ids.PriceAlertID).Return(mocks.PriceAlert, nil).Times(1)
+
+		resp, err := uc.GetPriceAlertByID(ctx, ids.UserID, ids.PriceAlertID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, ids.PriceAlertID, resp.ID)
+	})
+
+	t.Run("should return error not found when alert belongs to another user", func(t *testing.T) {
+		repo.PriceAlert.EXPECT().FindByID(ctx, ids.PriceAlertID).Return(mocks.PriceAlert, nil).Times(1)
+
+		resp, err := uc.GetPriceAlertByID(ctx, uuid.New(), ids.PriceAlertID)
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "price alert not found")
+	})
+}
+
+func TestPriceAlertUsecase_UpdatePriceAlert(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := PriceAlertUsecaseUtils(t)
+
+	t.Run("should pause alert and change target", func(t *testing.T) {
+		target := float64(15000)
+		active := false
+		repo.PriceAlert.EXPECT().FindByID(ctx, ids.PriceAlertID).Return(mocks.PriceAlert, nil).Times(1)
+		repo.PriceAlert.EXPECT().Update(ctx, gomock.Any()).Return(nil).Times(1)
+
+		resp, err := uc.UpdatePriceAlert(ctx, ids.UserID, ids.PriceAlertID, &dto.PriceAlertUpdateDTO{TargetPrice: &target, Active: &active})
+
+		assert.NoError(t, err)
+		assert.False(t, resp.Active)
+		assert.Equal(t, target, *resp.TargetPrice)
+	})
+
+	t.Run("should return error when change percent is set on a threshold alert", func(t *testing.T) {
+		percent := float64(10)
+		repo.PriceAlert.EXPECT().FindByID(ctx, ids.PriceAlertID).Return(mocks.PriceAlert, nil).Times(1)
+
+		resp, err := uc.UpdatePriceAlert(ctx, ids.UserID, ids.PriceAlertID, &dto.PriceAlertUpdateDTO{ChangePercent: &percent})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "change_percent only applies to change alerts")
+	})
+
+	t.Run("should return error not found when alert belongs to another user", func(t *testing.T) {
+		repo.PriceAlert.EXPECT().FindByID(ctx, ids.PriceAlertID).Return(mocks.PriceAlert, nil).Times(1)
+
+		resp, err := uc.UpdatePriceAlert(ctx, uuid.New(), ids.PriceAlertID, &dto.PriceAlertUpdateDTO{})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "price alert not found")
+	})
+}
+
+func TestPriceAlertUsecase_DeletePriceAlert(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := PriceAlertUsecaseUtils(t)
+
+	t.Run("should delete alert successfully", func(t *testing.T) {
+		repo.PriceAlert.EXPECT().FindByID(ctx, ids.PriceAlertID).Return(mocks.PriceAlert, nil).Times(1)
+		repo.PriceAlert.EXPECT().Delete(ctx, ids.PriceAlertID).Return(nil).Times(1)
+
+		err := uc.DeletePriceAlert(ctx, ids.UserID, ids.PriceAlertID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error not found when alert does not exist", func(t *testing.T) {
+		repo.PriceAlert.EXPECT().FindByID(ctx, ids.PriceAlertID).Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+
+		err := uc.DeletePriceAlert(ctx, ids.UserID, ids.PriceAlertID)
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "price alert not found")
+	})
+}
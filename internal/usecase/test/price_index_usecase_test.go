@@ -0,0 +1,232 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	mock_repo "github.com/ryvasa/go-super-farmer/internal/repository/mock"
+	usecase_implementation "github.com/ryvasa/go-super-farmer/internal/usecase/implementation"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/pkg/env"
+	mock_pkg "github.com/ryvasa/go-super-farmer/pkg/mock"
+	"github.com/ryvasa/go-super-farmer/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type PriceIndexRepoMock struct {
+	PriceHistory *mock_repo.MockPriceHistoryRepository
+	Supply       *mock_repo.MockSupplyRepository
+	Commodity    *mock_repo.MockCommodityRepository
+	Province     *mock_repo.MockProvinceRepository
+	Unit         *mock_repo.MockUnitRepository
+	Cache        *mock_pkg.MockCache
+}
+
+type PriceIndexMocks struct {
+	Supplies []*domain.Supply
+	Units    []*domain.Unit
+	Closes   []*dto.CityPriceCloseDTO
+	Params   *dto.PriceIndexParamsDTO
+}
+
+func PriceIndexUsecaseUtils(t *testing.T) (*PriceIndexMocks, *PriceIndexRepoMock, usecase_interface.PriceIndexUsecase, *env.Env, context.Context) {
+	commodityID := uuid.New()
+
+	// Bogor and Bandung weigh 1 and 3 ton, Depok has no supply and is left out
+	mocks := &PriceIndexMocks{
+		Supplies: []*domain.Supply{
+			{CommodityID: commodityID, CityID: 1, Quantity: 1, Unit: "ton"},
+			{CommodityID: commodityID, CityID: 2, Quantity: 3000, Unit: "kg"},
+		},
+		Units: []*domain.Unit{
+			{Code: "kg", Dimension: domain.UnitDimensionMass, Factor: 1},
+			{Code: "ton", Dimension: domain.UnitDimensionMass, Factor: 1000},
+		},
+		Closes: []*dto.CityPriceCloseDTO{
+			{CityID: 1, ProvinceID: 1, Period: "2023-12-01", Close: 10000},
+			{CityID: 2, ProvinceID: 2, Period: "2024-01-01", Close: 20000},
+			{CityID: 3, ProvinceID: 2, Period: "2024-01-01", Close: 50000},
+			{CityID: 2, ProvinceID: 2, Period: "2024-02-01", Close: 22000},
+			{CityID: 1, ProvinceID: 1, Period: "2024-03-01", Close: 12000},
+		},
+		Params: &dto.PriceIndexParamsDTO{
+			CommodityID: commodityID,
+			StartDate:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:     time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	priceHistoryRepo := mock_repo.NewMockPriceHistoryRepository(ctrl)
+	supplyRepo := mock_repo.NewMockSupplyRepository(ctrl)
+	commodityRepo := mock_repo.NewMockCommodityRepository(ctrl)
+	provinceRepo := mock_repo.NewMockProvinceRepository(ctrl)
+	unitRepo := mock_repo.NewMockUnitRepository(ctrl)
+	cache := mock_pkg.NewMockCache(ctrl)
+	env := &env.Env{}
+	uc := usecase_implementation.NewPriceIndexUsecase(priceHistoryRepo, supplyRepo, commodityRepo, provinceRepo, unitRepo, cache, env)
+	ctx := context.TODO()
+
+	repo := &PriceIndexRepoMock{
+		PriceHistory: priceHistoryRepo,
+		Supply:       supplyRepo,
+		Commodity:    commodityRepo,
+		Province:     provinceRepo,
+		Unit:         unitRepo,
+		Cache:        cache,
+	}
+
+	return mocks, repo, uc, env, ctx
+}
+
+func TestPriceIndexUsecase_GetPriceIndex(t *testing.T) {
+	mocks, repo, uc, env, ctx := PriceIndexUsecaseUtils(t)
+	commodityID := mocks.Params.CommodityID
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should return the nationwide index weighted by supply", func(t *testing.T) {
+		cacheKey := fmt.Sprintf("price_index_%s_0_month_2024-01-01_2024-03-31_2024-01-01_2024-01-01", commodityID)
+		repo.Commodity.EXPECT().FindByID(ctx, commodityID).Return(&domain.Commodity{ID: commodityID}, nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, cacheKey).Return(nil, errors.New("cache miss")).Times(1)
+		repo.Supply.EXPECT().FindByCommodityID(ctx, commodityID).Return(mocks.Supplies, nil).Times(1)
+		repo.Unit.EXPECT().FindAll(ctx).Return(mocks.Units, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindCityCloses(ctx, commodityID, int64(0), "month", start, end).Return(mocks.Closes, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, cacheKey, gomock.Any(), 4*time.Minute).Return(nil).Times(1)
+
+		resp, err := uc.GetPriceIndex(ctx, mocks.Params)
+
+		assert.NoError(t, err)
+		assert.Nil(t, resp.ProvinceID)
+		assert.Equal(t, float64(17500), resp.BasePrice)
+		assert.Len(t, resp.Points, 3)
+		assert.Equal(t, 100.0, *resp.Points[0].Index)
+		assert.Equal(t, 108.57, *resp.Points[1].Index)
+		assert.Equal(t, float64(19000), *resp.Points[1].Price)
+		assert.Equal(t, 111.43, *resp.Points[2].Index)
+		assert.Equal(t, 2, resp.Points[2].Cities)
+	})
+
+	t.Run("should use the configured base period", func(t *testing.T) {
+		env.PriceIndex.BaseStart = "2024-02-01"
+		env.PriceIndex.BaseEnd = "2024-02-29"
+		defer func() {
+			env.PriceIndex.BaseStart = ""
+			env.PriceIndex.BaseEnd = ""
+		}()
+
+		repo.Commodity.EXPECT().FindByID(ctx, commodityID).Return(&domain.Commodity{ID: commodityID}, nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, gomock.Any()).Return(nil, errors.New("cache miss")).Times(1)
+		repo.Supply.EXPECT().FindByCommodityID(ctx, commodityID).Return(mocks.Supplies, nil).Times(1)
+		repo.Unit.EXPECT().FindAll(ctx).Return(mocks.Units, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindCityCloses(ctx, commodityID, int64(0), "month", start, end).Return(mocks.Closes, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, gomock.Any(), gomock.Any(), 4*time.Minute).Return(nil).Times(1)
+
+		resp, err := uc.GetPriceIndex(ctx, mocks.Params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), resp.BaseStartDate)
+		assert.Equal(t, 92.11, *resp.Points[0].Index)
+		assert.Equal(t, 100.0, *resp.Points[1].Index)
+	})
+
+	t.Run("should return error when province not found", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, commodityID).Return(&domain.Commodity{ID: commodityID}, nil).Times(1)
+		repo.Province.EXPECT().FindByID(ctx, int64(9)).Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+
+		resp, err := uc.GetPriceIndex(ctx, &dto.PriceIndexParamsDTO{CommodityID: commodityID, ProvinceID: 9})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "province not found")
+	})
+
+	t.Run("should return error when base period is reversed", func(t *testing.T) {
+		resp, err := uc.GetPriceIndex(ctx, &dto.PriceIndexParamsDTO{
+			CommodityID:   commodityID,
+			BaseStartDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			BaseEndDate:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "base_start_date must not be after base_end_date")
+	})
+
+	t.Run("should return error when the range spans too many periods", func(t *testing.T) {
+		resp, err := uc.GetPriceIndex(ctx, &dto.PriceIndexParamsDTO{
+			CommodityID: commodityID,
+			Interval:    "day",
+			StartDate:   time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "the range and the base period must not span more than 1000 periods")
+	})
+
+	t.Run("should return error when the base period is far from the range", func(t *testing.T) {
+		resp, err := uc.GetPriceIndex(ctx, &dto.PriceIndexParamsDTO{
+			CommodityID:   commodityID,
+			Interval:      "week",
+			StartDate:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:       time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			BaseStartDate: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "the range and the base period must not span more than 1000 periods")
+	})
+
+	t.Run("should return error when no city has a supply", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, commodityID).Return(&domain.Commodity{ID: commodityID}, nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, gomock.Any()).Return(nil, errors.New("cache miss")).Times(1)
+		repo.Supply.EXPECT().FindByCommodityID(ctx, commodityID).Return([]*domain.Supply{}, nil).Times(1)
+		repo.Unit.EXPECT().FindAll(ctx).Return(mocks.Units, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindCityCloses(ctx, commodityID, int64(0), "month", start, end).Return(mocks.Closes, nil).Times(1)
+
+		resp, err := uc.GetPriceIndex(ctx, mocks.Params)
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "no city has both a supply and a price in the base period")
+	})
+}
+
+func TestPriceIndexUsecase_GetProvincePriceIndices(t *testing.T) {
+	mocks, repo, uc, _, ctx := PriceIndexUsecaseUtils(t)
+	commodityID := mocks.Params.CommodityID
+
+	t.Run("should return the index of every province with a supply", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, commodityID).Return(&domain.Commodity{ID: commodityID}, nil).Times(1)
+		repo.Cache.EXPECT().Get(ctx, gomock.Any()).Return(nil, errors.New("cache miss")).Times(1)
+		repo.Province.EXPECT().FindAll(ctx).Return([]*domain.Province{
+			{ID: 1, Name: "Jawa Barat"},
+			{ID: 2, Name: "Jawa Tengah"},
+			{ID: 3, Name: "Jawa Timur"},
+		}, nil).Times(1)
+		repo.Supply.EXPECT().FindByCommodityID(ctx, commodityID).Return(mocks.Supplies, nil).Times(1)
+		repo.Unit.EXPECT().FindAll(ctx).Return(mocks.Units, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindCityCloses(ctx, commodityID, int64(0), "month", gomock.Any(), gomock.Any()).Return(mocks.Closes, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, gomock.Any(), gomock.Any(), 4*time.Minute).Return(nil).Times(1)
+
+		resp, err := uc.GetProvincePriceIndices(ctx, mocks.Params)
+
+		assert.NoError(t, err)
+		assert.Len(t, resp, 2)
+		assert.Equal(t, "Jawa Barat", resp[0].Province)
+		assert.Equal(t, 120.0, *resp[0].Points[2].Index)
+		assert.Equal(t, int64(2), *resp[1].ProvinceID)
+		assert.Equal(t, 110.0, *resp[1].Points[1].Index)
+	})
+}
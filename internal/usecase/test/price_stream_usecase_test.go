@@ -0,0 +1,139 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/rabbitmq/amqp091-go"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	usecase_implementation "github.com/ryvasa/go-super-farmer/internal/usecase/implementation"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	mock_pkg "github.com/ryvasa/go-super-farmer/pkg/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func PriceStreamUsecaseUtils(t *testing.T) (chan amqp091.Delivery, *mock_pkg.MockRabbitMQ, usecase_interface.PriceStreamUsecase) {
+	ctrl := gomock.NewController(t)
+	rabbitMQ := mock_pkg.NewMockRabbitMQ(ctrl)
+	msgs := make(chan amqp091.Delivery)
+	rabbitMQ.EXPECT().SubscribeFanout("price-event-exchange").Return((<-chan amqp091.Delivery)(msgs), nil).Times(1)
+	uc := usecase_implementation.NewPriceStreamUsecase(rabbitMQ)
+	return msgs, rabbitMQ, uc
+}
+
+func publishPriceEvent(t *testing.T, msgs chan amqp091.Delivery, event *dto.PriceEventDTO) {
+	body, err := json.Marshal(event)
+	assert.NoError(t, err)
+	msgs <- amqp091.Delivery{Body: body}
+}
+
+func receivePriceEvent(t *testing.T, events <-chan *dto.PriceEventDTO) *dto.PriceEventDTO {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("no price event received")
+		return nil
+	}
+}
+
+func TestPriceStreamUsecase_Subscribe(t *testing.T) {
+	commodityID := uuid.New()
+	bandung := &dto.PriceEventDTO{ID: uuid.New(), Type: dto.PriceEventUpdated, CommodityID: commodityID, CityID: 1, Price: 900}
+	bogor := &dto.PriceEventDTO{ID: uuid.New(), Type: dto.PriceEventCreated, CommodityID: commodityID, CityID: 2, Price: 1200}
+	otherCommodity := &dto.PriceEventDTO{ID: uuid.New(), Type: dto.PriceEventDeleted, CommodityID: uuid.New(), CityID: 1}
+
+	t.Run("should push the events matching the commodity and city", func(t *testing.T) {
+		msgs, _, uc := PriceStreamUsecaseUtils(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		replay, events, err := uc.Subscribe(ctx, &dto.PriceStreamParamsDTO{CommodityID: commodityID, CityID: 2})
+		assert.NoError(t, err)
+		assert.Empty(t, replay)
+
+		publishPriceEvent(t, msgs, bandung)
+		publishPriceEvent(t, msgs, otherCommodity)
+		publishPriceEvent(t, msgs, bogor)
+
+		event := receivePriceEvent(t, events)
+		assert.Equal(t, bogor.ID, event.ID)
+		assert.Equal(t, float64(1200), event.Price)
+	})
+
+	t.Run("should replay the events after the last event id", func(t *testing.T) {
+		msgs, _, uc := PriceStreamUsecaseUtils(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, all, err := uc.Subscribe(ctx, &dto.PriceStreamParamsDTO{})
+		assert.NoError(t, err)
+		for _, event := range []*dto.PriceEventDTO{bandung, otherCommodity, bogor} {
+			publishPriceEvent(t, msgs, event)
+			receivePriceEvent(t, all)
+		}
+
+		replay, _, err := uc.Subscribe(ctx, &dto.PriceStreamParamsDTO{CommodityID: commodityID, LastEventID: bandung.ID.String()})
+
+		assert.NoError(t, err)
+		assert.Len(t, replay, 1)
+		assert.Equal(t, bogor.ID, replay[0].ID)
+	})
+
+	t.Run("should send a reset when the last event is no longer kept", func(t *testing.T) {
+		msgs, _, uc := PriceStreamUsecaseUtils(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, all, err := uc.Subscribe(ctx, &dto.PriceStreamParamsDTO{})
+		assert.NoError(t, err)
+		publishPriceEvent(t, msgs, bandung)
+		receivePriceEvent(t, all)
+
+		replay, _, err := uc.Subscribe(ctx, &dto.PriceStreamParamsDTO{LastEventID: uuid.New().String()})
+
+		assert.NoError(t, err)
+		assert.Len(t, replay, 1)
+		assert.Equal(t, dto.PriceEventReset, replay[0].Type)
+		assert.Equal(t, bandung.ID, replay[0].ID)
+	})
+
+	t.Run("should close the events once the context is done", func(t *testing.T) {
+		_, _, uc := PriceStreamUsecaseUtils(t)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		_, events, err := uc.Subscribe(ctx, &dto.PriceStreamParamsDTO{})
+		assert.NoError(t, err)
+		cancel()
+
+		select {
+		case _, ok := <-events:
+			assert.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("events were not closed")
+		}
+	})
+
+	t.Run("should subscribe again after the subscription is closed", func(t *testing.T) {
+		msgs, rabbitMQ, uc := PriceStreamUsecaseUtils(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, events, err := uc.Subscribe(ctx, &dto.PriceStreamParamsDTO{})
+		assert.NoError(t, err)
+		publishPriceEvent(t, msgs, bandung)
+		receivePriceEvent(t, events)
+
+		close(msgs)
+		_, ok := <-events
+		assert.False(t, ok)
+
+		rabbitMQ.EXPECT().SubscribeFanout("price-event-exchange").Return((<-chan amqp091.Delivery)(make(chan amqp091.Delivery)), nil).Times(1)
+		replay, _, err := uc.Subscribe(ctx, &dto.PriceStreamParamsDTO{LastEventID: bandung.ID.String()})
+
+		assert.NoError(t, err)
+		assert.Len(t, replay, 1)
+		assert.Equal(t, dto.PriceEventReset, replay[0].Type)
+	})
+}
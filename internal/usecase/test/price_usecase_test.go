@@ -3,7 +3,9 @@ package usecase_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,6 +28,9 @@ type PriceRepoMock struct {
 	City         *mock_repo.MockCityRepository
 	Commodity    *mock_repo.MockCommodityRepository
 	PriceHistory *mock_repo.MockPriceHistoryRepository
+	PriceAlert   *mock_repo.MockPriceAlertRepository
+	PriceReview  *mock_repo.MockPriceReviewRepository
+	PriceSchedule *mock_repo.MockPriceScheduleRepository
 	TxManager    *mock_pkg.MockTransactionManager
 	RabbitMQ     *mock_pkg.MockRabbitMQ
 	Cache        *mock_pkg.MockCache
@@ -144,13 +149,16 @@ func PriceUsecaseUtils(t *testing.T) (*PriceIDs, *Pricemocks, *PriceDTOmocks, *P
 	commodityRepo := mock_repo.NewMockCommodityRepository(ctrl)
 	priceRepo := mock_repo.NewMockPriceRepository(ctrl)
 	priceHostoryRepo := mock_repo.NewMockPriceHistoryRepository(ctrl)
+	priceAlertRepo := mock_repo.NewMockPriceAlertRepository(ctrl)
+	priceReviewRepo := mock_repo.NewMockPriceReviewRepository(ctrl)
+	priceScheduleRepo := mock_repo.NewMockPriceScheduleRepository(ctrl)
 	txRepo := mock_pkg.NewMockTransactionManager(ctrl)
 	rabbitMQ := mock_pkg.NewMockRabbitMQ(ctrl)
 	cache := mock_pkg.NewMockCache(ctrl)
 	glob := mock_utils.NewMockGlobFunc(ctrl)
 	env := env.Env{}
 
-	uc := usecase_implementation.NewPriceUsecase(priceRepo, priceHostoryRepo, cityRepo, commodityRepo, rabbitMQ, txRepo, cache, glob, &env)
+	uc := usecase_implementation.NewPriceUsecase(priceRepo, priceHostoryRepo, priceAlertRepo, priceReviewRepo, priceScheduleRepo, cityRepo, commodityRepo, rabbitMQ, txRepo, cache, glob, &env)
 	ctx := context.Background()
 
 	repo := &PriceRepoMock{
@@ -158,6 +166,9 @@ func PriceUsecaseUtils(t *testing.T) (*PriceIDs, *Pricemocks, *PriceDTOmocks, *P
 		City:         cityRepo,
 		Commodity:    commodityRepo,
 		PriceHistory: priceHostoryRepo,
+		PriceAlert:   priceAlertRepo,
+		PriceReview:  priceReviewRepo,
+		PriceSchedule: priceScheduleRepo,
 		TxManager:    txRepo,
 		RabbitMQ:     rabbitMQ,
 		Cache:        cache,
@@ -167,6 +178,16 @@ func PriceUsecaseUtils(t *testing.T) (*PriceIDs, *Pricemocks, *PriceDTOmocks, *P
 	return ids, mocks, dtos, repo, uc, ctx
 }
 
+// capturePriceEvent expects one price event and returns it once published
+func capturePriceEvent(ctx context.Context, rabbitMQ *mock_pkg.MockRabbitMQ) *dto.PriceEventDTO {
+	event := &dto.PriceEventDTO{}
+	rabbitMQ.EXPECT().PublishJSON(ctx, "price-event-exchange", "", gomock.Any()).DoAndReturn(func(ctx context.Context, exchange, key string, data interface{}) error {
+		*event = data.(dto.PriceEventDTO)
+		return nil
+	}).Times(1)
+	return event
+}
+
 func TestPriceUsecase_CreatePrice(t *testing.T) {
 
 	ids, mocks, dto, repo, uc, ctx := PriceUsecaseUtils(t)
@@ -177,6 +198,10 @@ func TestPriceUsecase_CreatePrice(t *testing.T) {
 
 		repo.City.EXPECT().FindByID(ctx, ids.CityID).Return(mocks.City, nil).Times(1)
 
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return([]float64{}, nil).Times(1)
+
+		repo.Price.EXPECT().FindByCommodityID(ctx, ids.CommodityID).Return([]*domain.Price{}, nil).Times(1)
+
 		repo.Price.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, p *domain.Price) error {
 			p.ID = ids.PriceID
 			return nil
@@ -185,6 +210,7 @@ func TestPriceUsecase_CreatePrice(t *testing.T) {
 		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.Price, nil).Times(1)
 
 		repo.Cache.EXPECT().DeleteByPattern(ctx, "price").Return(nil).Times(1)
+		event := capturePriceEvent(ctx, repo.RabbitMQ)
 
 		resp, err := uc.CreatePrice(ctx, dto.Create)
 
@@ -192,6 +218,9 @@ func TestPriceUsecase_CreatePrice(t *testing.T) {
 		assert.Equal(t, dto.Create.CommodityID, resp.CommodityID)
 		assert.Equal(t, dto.Create.CityID, resp.CityID)
 		assert.Equal(t, mocks.Price.ID, resp.ID)
+		assert.Equal(t, "created", event.Type)
+		assert.Equal(t, mocks.Price.ID, event.PriceID)
+		assert.Nil(t, event.PreviousPrice)
 	})
 
 	t.Run("should return error when commodity not found", func(t *testing.T) {
@@ -221,6 +250,10 @@ func TestPriceUsecase_CreatePrice(t *testing.T) {
 
 		repo.City.EXPECT().FindByID(ctx, ids.CityID).Return(mocks.City, nil).Times(1)
 
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return([]float64{}, nil).Times(1)
+
+		repo.Price.EXPECT().FindByCommodityID(ctx, ids.CommodityID).Return([]*domain.Price{}, nil).Times(1)
+
 		repo.Price.EXPECT().Create(ctx, gomock.Any()).Return(utils.NewInternalError("internal error")).Times(1)
 
 		resp, err := uc.CreatePrice(ctx, dto.Create)
@@ -235,6 +268,10 @@ func TestPriceUsecase_CreatePrice(t *testing.T) {
 
 		repo.City.EXPECT().FindByID(ctx, ids.CityID).Return(mocks.City, nil).Times(1)
 
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return([]float64{}, nil).Times(1)
+
+		repo.Price.EXPECT().FindByCommodityID(ctx, ids.CommodityID).Return([]*domain.Price{}, nil).Times(1)
+
 		repo.Price.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, p *domain.Price) error {
 			p.ID = ids.PriceID
 			return nil
@@ -439,6 +476,8 @@ func TestPriceUsecase_UpdatePrice(t *testing.T) {
 
 		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.Price, nil).Times(1)
 
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return([]float64{}, nil).Times(1)
+
 		repo.PriceHistory.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, ph *domain.PriceHistory) error {
 			ph.ID = ids.PriceHistoryID
 			return nil
@@ -453,11 +492,17 @@ func TestPriceUsecase_UpdatePrice(t *testing.T) {
 
 		repo.Cache.EXPECT().DeleteByPattern(ctx, "price").Return(nil).Times(1)
 
+		repo.PriceAlert.EXPECT().FindActiveByCommodityIDAndCityID(ctx, ids.CommodityID, ids.CityID).Return([]*domain.PriceAlert{}, nil).Times(1)
+		event := capturePriceEvent(ctx, repo.RabbitMQ)
+
 		resp, err := uc.UpdatePrice(ctx, ids.PriceID, dtos.Update)
 
 		assert.NoError(t, err)
 		assert.Equal(t, mocks.Price.ID, resp.ID)
 		assert.Equal(t, resp.Price, mocks.UpdatedPrice.Price)
+		assert.Equal(t, dto.PriceEventUpdated, event.Type)
+		assert.Equal(t, mocks.UpdatedPrice.Price, event.Price)
+		assert.Equal(t, mocks.Price.Price, *event.PreviousPrice)
 	})
 
 	t.Run("should return error when price not found", func(t *testing.T) {
@@ -485,6 +530,8 @@ func TestPriceUsecase_UpdatePrice(t *testing.T) {
 
 		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.Price, nil).Times(1)
 
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return([]float64{}, nil).Times(1)
+
 		repo.PriceHistory.EXPECT().Create(ctx, gomock.Any()).Return(utils.NewInternalError("failed to create price history")).Times(1)
 
 		resp, err := uc.UpdatePrice(ctx, ids.PriceID, dtos.Update)
@@ -502,6 +549,8 @@ func TestPriceUsecase_UpdatePrice(t *testing.T) {
 
 		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.Price, nil).Times(1)
 
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return([]float64{}, nil).Times(1)
+
 		repo.PriceHistory.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, ph *domain.PriceHistory) error {
 			ph.ID = ids.PriceHistoryID
 			return nil
@@ -525,6 +574,8 @@ func TestPriceUsecase_UpdatePrice(t *testing.T) {
 
 		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.Price, nil).Times(1)
 
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return([]float64{}, nil).Times(1)
+
 		repo.PriceHistory.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, ph *domain.PriceHistory) error {
 			ph.ID = ids.PriceHistoryID
 			return nil
@@ -564,11 +615,14 @@ func TestPriceUsecase_DeletePrice(t *testing.T) {
 		repo.Price.EXPECT().Delete(ctx, ids.PriceID).Return(nil).Times(1)
 
 		repo.Cache.EXPECT().DeleteByPattern(ctx, "price").Return(nil).Times(1)
+		event := capturePriceEvent(ctx, repo.RabbitMQ)
 
 		err := uc.DeletePrice(ctx, ids.PriceID)
 
 		assert.Nil(t, err)
 		assert.NoError(t, err)
+		assert.Equal(t, dto.PriceEventDeleted, event.Type)
+		assert.Equal(t, ids.CityID, event.CityID)
 	})
 	t.Run("should return error when price not found", func(t *testing.T) {
 
@@ -604,12 +658,14 @@ func TestPriceUsecase_RestorePrice(t *testing.T) {
 		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.Price, nil).Times(1)
 
 		repo.Cache.EXPECT().DeleteByPattern(ctx, "price")
+		event := capturePriceEvent(ctx, repo.RabbitMQ)
 
 		resp, err := uc.RestorePrice(ctx, ids.PriceID)
 
 		assert.NotNil(t, resp)
 		assert.Nil(t, err)
 		assert.NoError(t, err)
+		assert.Equal(t, dto.PriceEventCreated, event.Type)
 	})
 
 	t.Run("should return error when restore price", func(t *testing.T) {
@@ -883,3 +939,909 @@ func TestPriceUsecase_DownloadPriceHistoryByCommodityIDAndCityID(t *testing.T) {
 // 		assert.EqualError(t, err, "Report file not found")
 // 	})
 // }
+
+func TestPriceUsecase_GetPriceHistorySeries(t *testing.T) {
+	ids, _, _, repo, uc, ctx := PriceUsecaseUtils(t)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	params := &dto.PriceHistorySeriesParamsDTO{
+		CommodityID: ids.CommodityID,
+		CityID:      ids.CityID,
+		StartDate:   start,
+		EndDate:     end,
+		Interval:    "month",
+	}
+	cacheKey := fmt.Sprintf("price_series_%s_%d_month_2024-01-01_2024-03-31", ids.CommodityID, ids.CityID)
+	buckets := []*dto.PriceBucketDTO{
+		{Period: start, Open: 100, High: 120, Low: 90, Close: 110, Avg: 105, Count: 4},
+	}
+
+	t.Run("should return buckets from repository", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, cacheKey).Return(nil, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindBuckets(ctx, ids.CommodityID, ids.CityID, "month", start, end.AddDate(0, 0, 1)).Return(buckets, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, cacheKey, gomock.Any(), 4*time.Minute).Return(nil).Times(1)
+
+		resp, err := uc.GetPriceHistorySeries(ctx, params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "month", resp.Interval)
+		assert.Equal(t, buckets, resp.Buckets)
+	})
+
+	t.Run("should return buckets from cache", func(t *testing.T) {
+		cached, _ := json.Marshal(&dto.PriceHistorySeriesDTO{CommodityID: ids.CommodityID, CityID: ids.CityID, Interval: "month", Buckets: buckets})
+		repo.Cache.EXPECT().Get(ctx, cacheKey).Return(cached, nil).Times(1)
+
+		resp, err := uc.GetPriceHistorySeries(ctx, params)
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Buckets, 1)
+		assert.Equal(t, float64(110), resp.Buckets[0].Close)
+	})
+
+	t.Run("should default interval to day", func(t *testing.T) {
+		dayKey := fmt.Sprintf("price_series_%s_%d_day_2024-01-01_2024-03-31", ids.CommodityID, ids.CityID)
+		repo.Cache.EXPECT().Get(ctx, dayKey).Return(nil, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindBuckets(ctx, ids.CommodityID, ids.CityID, "day", start, end.AddDate(0, 0, 1)).Return([]*dto.PriceBucketDTO{}, nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, dayKey, gomock.Any(), 4*time.Minute).Return(nil).Times(1)
+
+		resp, err := uc.GetPriceHistorySeries(ctx, &dto.PriceHistorySeriesParamsDTO{CommodityID: ids.CommodityID, CityID: ids.CityID, StartDate: start, EndDate: end})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "day", resp.Interval)
+		assert.Empty(t, resp.Buckets)
+	})
+
+	t.Run("should return error validation error when interval is invalid", func(t *testing.T) {
+		resp, err := uc.GetPriceHistorySeries(ctx, &dto.PriceHistorySeriesParamsDTO{CommodityID: ids.CommodityID, CityID: ids.CityID, Interval: "hour"})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "Validation failed")
+	})
+
+	t.Run("should return error when start date is after end date", func(t *testing.T) {
+		resp, err := uc.GetPriceHistorySeries(ctx, &dto.PriceHistorySeriesParamsDTO{CommodityID: ids.CommodityID, CityID: ids.CityID, StartDate: end, EndDate: start})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "start_date must not be after end_date")
+	})
+
+	t.Run("should return error internal error when query fails", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, cacheKey).Return(nil, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindBuckets(ctx, ids.CommodityID, ids.CityID, "month", start, end.AddDate(0, 0, 1)).Return(nil, utils.NewInternalError("database error")).Times(1)
+
+		resp, err := uc.GetPriceHistorySeries(ctx, params)
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "database error")
+	})
+}
+
+func TestPriceUsecase_UpdatePriceAlerts(t *testing.T) {
+	ids, mocks, dtos, repo, uc, ctx := PriceUsecaseUtils(t)
+
+	userID := uuid.New()
+	target := float64(500)
+	percent := float64(50)
+	user := &domain.User{ID: userID, Name: "farmer", Email: "farmer@example.com"}
+
+	// mocks.Price (100) is replaced by mocks.UpdatedPrice (900)
+	expectUpdate := func(previous *domain.Price, alerts []*domain.PriceAlert, alertsErr error) {
+		repo.TxManager.EXPECT().
+			WithTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(previous, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return([]float64{}, nil).Times(1)
+		repo.PriceHistory.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(1)
+		repo.Price.EXPECT().Update(ctx, ids.PriceID, gomock.Any()).Return(nil).Times(1)
+		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.UpdatedPrice, nil).Times(1)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "price").Return(nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "price-event-exchange", "", gomock.Any()).Return(nil).Times(1)
+		repo.PriceAlert.EXPECT().FindActiveByCommodityIDAndCityID(ctx, ids.CommodityID, ids.CityID).Return(alerts, alertsErr).Times(1)
+	}
+	dedupKey := func(alertID uuid.UUID) string {
+		return fmt.Sprintf("alert_dedup:%s:900", alertID)
+	}
+	cooldownKey := func(alertID uuid.UUID) string {
+		return fmt.Sprintf("alert_cooldown:%s", alertID)
+	}
+
+	t.Run("should notify when the price crosses above the target", func(t *testing.T) {
+		alert := &domain.PriceAlert{ID: uuid.New(), UserID: userID, User: user, Condition: domain.PriceAlertAbove, TargetPrice: &target, CooldownMinutes: 60}
+		expectUpdate(mocks.Price, []*domain.PriceAlert{alert}, nil)
+		repo.Cache.EXPECT().Incr(ctx, dedupKey(alert.ID), 24*time.Hour).Return(int64(1), nil).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, cooldownKey(alert.ID), time.Hour).Return(int64(1), nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "mail-exchange", "price-alert", gomock.Any()).DoAndReturn(func(ctx context.Context, exchange, key string, data interface{}) error {
+			msg := data.(usecase_implementation.PriceAlertMessage)
+			assert.Equal(t, "farmer@example.com", msg.To)
+			assert.Equal(t, float64(100), msg.ReferencePrice)
+			assert.Equal(t, float64(900), msg.Price)
+			return nil
+		}).Times(1)
+		repo.PriceAlert.EXPECT().UpdateLastTriggered(ctx, alert.ID, gomock.Any()).Return(nil).Times(1)
+
+		_, err := uc.UpdatePrice(ctx, ids.PriceID, dtos.Update)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not notify when the price was already above the target", func(t *testing.T) {
+		alert := &domain.PriceAlert{ID: uuid.New(), UserID: userID, User: user, Condition: domain.PriceAlertAbove, TargetPrice: &target, CooldownMinutes: 60}
+		previous := &domain.Price{ID: ids.PriceID, CommodityID: ids.CommodityID, CityID: ids.CityID, Price: 600}
+		expectUpdate(previous, []*domain.PriceAlert{alert}, nil)
+
+		_, err := uc.UpdatePrice(ctx, ids.PriceID, dtos.Update)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not notify a below alert when the price rises", func(t *testing.T) {
+		alert := &domain.PriceAlert{ID: uuid.New(), UserID: userID, User: user, Condition: domain.PriceAlertBelow, TargetPrice: &target, CooldownMinutes: 60}
+		expectUpdate(mocks.Price, []*domain.PriceAlert{alert}, nil)
+
+		_, err := uc.UpdatePrice(ctx, ids.PriceID, dtos.Update)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not notify within the cooldown", func(t *testing.T) {
+		lastTriggered := time.Now().Add(-10 * time.Minute)
+		alert := &domain.PriceAlert{ID: uuid.New(), UserID: userID, User: user, Condition: domain.PriceAlertAbove, TargetPrice: &target, CooldownMinutes: 60, LastTriggeredAt: &lastTriggered}
+		expectUpdate(mocks.Price, []*domain.PriceAlert{alert}, nil)
+
+		_, err := uc.UpdatePrice(ctx, ids.PriceID, dtos.Update)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not notify the same price twice", func(t *testing.T) {
+		alert := &domain.PriceAlert{ID: uuid.New(), UserID: userID, User: user, Condition: domain.PriceAlertAbove, TargetPrice: &target, CooldownMinutes: 60}
+		expectUpdate(mocks.Price, []*domain.PriceAlert{alert}, nil)
+		repo.Cache.EXPECT().Incr(ctx, dedupKey(alert.ID), 24*time.Hour).Return(int64(2), nil).Times(1)
+
+		_, err := uc.UpdatePrice(ctx, ids.PriceID, dtos.Update)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not notify when another instance just sent the alert", func(t *testing.T) {
+		alert := &domain.PriceAlert{ID: uuid.New(), UserID: userID, User: user, Condition: domain.PriceAlertAbove, TargetPrice: &target, CooldownMinutes: 60}
+		expectUpdate(mocks.Price, []*domain.PriceAlert{alert}, nil)
+		repo.Cache.EXPECT().Incr(ctx, dedupKey(alert.ID), 24*time.Hour).Return(int64(1), nil).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, cooldownKey(alert.ID), time.Hour).Return(int64(2), nil).Times(1)
+
+		_, err := uc.UpdatePrice(ctx, ids.PriceID, dtos.Update)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should skip an alert whose owner was deleted", func(t *testing.T) {
+		alert := &domain.PriceAlert{ID: uuid.New(), UserID: userID, Condition: domain.PriceAlertAbove, TargetPrice: &target, CooldownMinutes: 60}
+		expectUpdate(mocks.Price, []*domain.PriceAlert{alert}, nil)
+		repo.Cache.EXPECT().Incr(ctx, gomock.Any(), gomock.Any()).Times(0)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "mail-exchange", "price-alert", gomock.Any()).Times(0)
+
+		_, err := uc.UpdatePrice(ctx, ids.PriceID, dtos.Update)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should release the dedup and cooldown keys when the email cannot be published", func(t *testing.T) {
+		alert := &domain.PriceAlert{ID: uuid.New(), UserID: userID, User: user, Condition: domain.PriceAlertAbove, TargetPrice: &target, CooldownMinutes: 60}
+		expectUpdate(mocks.Price, []*domain.PriceAlert{alert}, nil)
+		repo.Cache.EXPECT().Incr(ctx, dedupKey(alert.ID), 24*time.Hour).Return(int64(1), nil).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, cooldownKey(alert.ID), time.Hour).Return(int64(1), nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "mail-exchange", "price-alert", gomock.Any()).Return(errors.New("channel closed")).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, dedupKey(alert.ID)).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, cooldownKey(alert.ID)).Return(nil).Times(1)
+		repo.PriceAlert.EXPECT().UpdateLastTriggered(ctx, alert.ID, gomock.Any()).Times(0)
+
+		resp, err := uc.UpdatePrice(ctx, ids.PriceID, dtos.Update)
+
+		assert.NoError(t, err)
+		assert.Equal(t, mocks.UpdatedPrice.Price, resp.Price)
+	})
+
+	t.Run("should measure a change alert against the price at the start of the window", func(t *testing.T) {
+		alert := &domain.PriceAlert{ID: uuid.New(), UserID: userID, User: user, Condition: domain.PriceAlertChange, ChangePercent: &percent, WindowDays: 7, CooldownMinutes: 60}
+		// The replaced price was only set yesterday, a week ago the price was 800
+		previous := &domain.Price{ID: ids.PriceID, CommodityID: ids.CommodityID, CityID: ids.CityID, Price: 100, UpdatedAt: time.Now().AddDate(0, 0, -1)}
+		expectUpdate(previous, []*domain.PriceAlert{alert}, nil)
+		repo.PriceHistory.EXPECT().FindLatestBefore(ctx, ids.CommodityID, ids.CityID, gomock.Any()).Return(&domain.PriceHistory{Price: 800}, nil).Times(1)
+
+		_, err := uc.UpdatePrice(ctx, ids.PriceID, dtos.Update)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should notify a change alert when the price moved more than the percentage", func(t *testing.T) {
+		alert := &domain.PriceAlert{ID: uuid.New(), UserID: userID, User: user, Condition: domain.PriceAlertChange, ChangePercent: &percent, WindowDays: 7, CooldownMinutes: 60}
+		previous := &domain.Price{ID: ids.PriceID, CommodityID: ids.CommodityID, CityID: ids.CityID, Price: 100, UpdatedAt: time.Now().AddDate(0, 0, -1)}
+		expectUpdate(previous, []*domain.PriceAlert{alert}, nil)
+		repo.PriceHistory.EXPECT().FindLatestBefore(ctx, ids.CommodityID, ids.CityID, gomock.Any()).Return(&domain.PriceHistory{Price: 400}, nil).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, dedupKey(alert.ID), 24*time.Hour).Return(int64(1), nil).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, cooldownKey(alert.ID), time.Hour).Return(int64(1), nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "mail-exchange", "price-alert", gomock.Any()).DoAndReturn(func(ctx context.Context, exchange, key string, data interface{}) error {
+			msg := data.(usecase_implementation.PriceAlertMessage)
+			assert.Equal(t, float64(400), msg.ReferencePrice)
+			assert.Equal(t, 7, msg.WindowDays)
+			return nil
+		}).Times(1)
+		repo.PriceAlert.EXPECT().UpdateLastTriggered(ctx, alert.ID, gomock.Any()).Return(nil).Times(1)
+
+		_, err := uc.UpdatePrice(ctx, ids.PriceID, dtos.Update)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should still update the price when loading alerts fails", func(t *testing.T) {
+		expectUpdate(mocks.Price, nil, utils.NewInternalError("database error"))
+
+		resp, err := uc.UpdatePrice(ctx, ids.PriceID, dtos.Update)
+
+		assert.NoError(t, err)
+		assert.Equal(t, mocks.UpdatedPrice.Price, resp.Price)
+	})
+}
+
+func TestPriceUsecase_ImportPrices(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := PriceUsecaseUtils(t)
+
+	rice := &domain.Commodity{ID: ids.CommodityID, Code: "RICE01", Name: "Rice"}
+	bandung := &domain.City{ID: ids.CityID, Name: "Bandung"}
+	bogor := &domain.City{ID: 2, Name: "Bogor"}
+	file := "commodity_code,city,price\nRICE01,bandung,900\n\nRICE01,Bogor,1200\n"
+
+	expectResolve := func() {
+		repo.Commodity.EXPECT().FindByCodes(ctx, []string{"RICE01"}).Return([]*domain.Commodity{rice}, nil).Times(1)
+		repo.City.EXPECT().FindByNames(ctx, []string{"bandung", "bogor"}).Return([]*domain.City{bandung, bogor}, nil).Times(1)
+	}
+	// Every changed row is checked against its recent prices, a new one also against the other cities
+	expectChecks := func(rows, created int) {
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, gomock.Any(), 30).Return([]float64{}, nil).Times(rows)
+		repo.Price.EXPECT().FindByCommodityID(ctx, ids.CommodityID).Return([]*domain.Price{}, nil).Times(created)
+	}
+
+	t.Run("should update existing and create new prices in one transaction", func(t *testing.T) {
+		expectResolve()
+		expectChecks(2, 1)
+		repo.TxManager.EXPECT().
+			WithTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		repo.Price.EXPECT().FindByCommodityIDsAndCityIDs(ctx, []uuid.UUID{ids.CommodityID, ids.CommodityID}, []int64{ids.CityID, 2}).
+			Return([]*domain.Price{mocks.Price}, nil).Times(1)
+		repo.PriceHistory.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, ph *domain.PriceHistory) error {
+			assert.Equal(t, float64(100), ph.Price)
+			return nil
+		}).Times(1)
+		repo.Price.EXPECT().Update(ctx, ids.PriceID, gomock.Any()).DoAndReturn(func(ctx context.Context, id uuid.UUID, p *domain.Price) error {
+			assert.Equal(t, float64(900), p.Price)
+			return nil
+		}).Times(1)
+		repo.Price.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, p *domain.Price) error {
+			assert.Equal(t, int64(2), p.CityID)
+			assert.Equal(t, float64(1200), p.Price)
+			return nil
+		}).Times(1)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "price").Return(nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "price-event-exchange", "", gomock.Any()).Return(nil).Times(2)
+		repo.PriceAlert.EXPECT().FindActiveByCommodityIDAndCityID(ctx, ids.CommodityID, ids.CityID).Return([]*domain.PriceAlert{}, nil).Times(1)
+
+		report, err := uc.ImportPrices(ctx, &dto.PriceImportDTO{FileName: "prices.csv", Content: []byte(file)})
+
+		assert.NoError(t, err)
+		assert.True(t, report.Committed)
+		assert.Equal(t, 2, report.TotalRows)
+		assert.Equal(t, 1, report.Updated)
+		assert.Equal(t, 1, report.Created)
+		assert.Equal(t, 2, report.Rows[0].Row)
+		assert.Equal(t, dto.PriceImportUpdated, report.Rows[0].Status)
+		assert.Equal(t, float64(100), *report.Rows[0].PreviousPrice)
+		assert.Equal(t, 4, report.Rows[1].Row)
+		assert.Equal(t, dto.PriceImportCreated, report.Rows[1].Status)
+	})
+
+	t.Run("should record the audit values and cut a long file name in the reason", func(t *testing.T) {
+		changedBy := uuid.New()
+		fileName := strings.Repeat("harga-beras-", 30) + ".csv"
+		expectResolve()
+		expectChecks(2, 2)
+		repo.TxManager.EXPECT().
+			WithTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		repo.Price.EXPECT().FindByCommodityIDsAndCityIDs(ctx, gomock.Any(), gomock.Any()).Return([]*domain.Price{}, nil).Times(1)
+		repo.Price.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, p *domain.Price) error {
+			assert.Equal(t, &changedBy, p.ChangedBy)
+			assert.Equal(t, domain.PriceSourceImport, p.Source)
+			assert.Len(t, p.Reason, 255)
+			assert.True(t, strings.HasPrefix(p.Reason, "imported from harga-beras-"))
+			return nil
+		}).Times(2)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "price").Return(nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "price-event-exchange", "", gomock.Any()).Return(nil).Times(2)
+
+		report, err := uc.ImportPrices(ctx, &dto.PriceImportDTO{FileName: fileName, Content: []byte(file), ChangedBy: &changedBy})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &changedBy, report.ChangedBy)
+		assert.Equal(t, domain.PriceSourceImport, report.Source)
+		assert.Len(t, report.Reason, 255)
+	})
+
+	t.Run("should hold a row far from the recent prices for review", func(t *testing.T) {
+		changedBy := uuid.New()
+		expectResolve()
+		repo.TxManager.EXPECT().
+			WithTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		repo.Price.EXPECT().FindByCommodityIDsAndCityIDs(ctx, gomock.Any(), gomock.Any()).
+			Return([]*domain.Price{{ID: ids.PriceID, CommodityID: ids.CommodityID, CityID: ids.CityID, Price: 9000}}, nil).Times(1)
+		// Bandung was around 9000, the 900 of the file is a missing digit
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return([]float64{9100, 8900, 9050, 8950}, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, int64(2), 30).Return([]float64{}, nil).Times(1)
+		repo.Price.EXPECT().FindByCommodityID(ctx, ids.CommodityID).Return([]*domain.Price{}, nil).Times(1)
+		repo.PriceReview.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, review *domain.PriceReview) error {
+			assert.Equal(t, domain.PriceReviewPending, review.Status)
+			assert.Equal(t, ids.PriceID, *review.PriceID)
+			assert.Equal(t, float64(900), review.Price)
+			assert.Equal(t, &changedBy, review.ChangedBy)
+			assert.Equal(t, domain.PriceSourceImport, review.Source)
+			return nil
+		}).Times(1)
+		repo.PriceHistory.EXPECT().Create(ctx, gomock.Any()).Times(0)
+		repo.Price.EXPECT().Update(ctx, gomock.Any(), gomock.Any()).Times(0)
+		repo.Price.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(1)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "price").Return(nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "price-event-exchange", "", gomock.Any()).Return(nil).Times(1)
+
+		report, err := uc.ImportPrices(ctx, &dto.PriceImportDTO{FileName: "prices.csv", Content: []byte(file), ChangedBy: &changedBy})
+
+		assert.NoError(t, err)
+		assert.True(t, report.Committed)
+		assert.Equal(t, 1, report.Held)
+		assert.Equal(t, 1, report.Created)
+		assert.Equal(t, dto.PriceImportHeld, report.Rows[0].Status)
+		assert.NotNil(t, report.Rows[0].ReviewID)
+	})
+
+	t.Run("should only report on dry run", func(t *testing.T) {
+		expectResolve()
+		expectChecks(1, 1)
+		repo.Price.EXPECT().FindByCommodityIDsAndCityIDs(ctx, gomock.Any(), gomock.Any()).
+			Return([]*domain.Price{{ID: ids.PriceID, CommodityID: ids.CommodityID, CityID: ids.CityID, Price: 900}}, nil).Times(1)
+
+		report, err := uc.ImportPrices(ctx, &dto.PriceImportDTO{FileName: "prices.csv", Content: []byte(file), DryRun: true})
+
+		assert.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.False(t, report.Committed)
+		assert.Equal(t, 1, report.Unchanged)
+		assert.Equal(t, 1, report.Created)
+	})
+
+	t.Run("should not write anything when a row is invalid", func(t *testing.T) {
+		content := "Commodity Code,City,Price\nRICE01,Bogor,abc\nCORN01,Bogor,1000\nRICE01,Bandung,900\nRICE01,Bandung,950\n"
+		repo.Commodity.EXPECT().FindByCodes(ctx, []string{"RICE01", "CORN01"}).Return([]*domain.Commodity{rice}, nil).Times(1)
+		repo.City.EXPECT().FindByNames(ctx, []string{"bogor", "bandung"}).Return([]*domain.City{bandung, bogor}, nil).Times(1)
+		repo.Price.EXPECT().FindByCommodityIDsAndCityIDs(ctx, gomock.Any(), gomock.Any()).Return([]*domain.Price{}, nil).Times(1)
+		expectChecks(1, 1)
+
+		report, err := uc.ImportPrices(ctx, &dto.PriceImportDTO{FileName: "prices.csv", Content: []byte(content)})
+
+		assert.NoError(t, err)
+		assert.False(t, report.Committed)
+		assert.Equal(t, 3, report.Invalid)
+		assert.Equal(t, []string{"price must be a number"}, report.Rows[0].Errors)
+		assert.Equal(t, []string{"commodity not found"}, report.Rows[1].Errors)
+		assert.Equal(t, dto.PriceImportCreated, report.Rows[2].Status)
+		assert.Equal(t, []string{"duplicate of row 4"}, report.Rows[3].Errors)
+	})
+
+	t.Run("should return error when a column is missing", func(t *testing.T) {
+		report, err := uc.ImportPrices(ctx, &dto.PriceImportDTO{FileName: "prices.csv", Content: []byte("commodity_code,price\nRICE01,900\n")})
+
+		assert.Error(t, err)
+		assert.Nil(t, report)
+		assert.EqualError(t, err, "missing column city")
+	})
+
+	t.Run("should return error when the file type is not supported", func(t *testing.T) {
+		report, err := uc.ImportPrices(ctx, &dto.PriceImportDTO{FileName: "prices.xls", Content: []byte(file)})
+
+		assert.Error(t, err)
+		assert.Nil(t, report)
+		assert.EqualError(t, err, "unsupported file type, use .csv or .xlsx")
+	})
+
+	t.Run("should return error internal error when the transaction fails", func(t *testing.T) {
+		expectResolve()
+		repo.TxManager.EXPECT().
+			WithTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		repo.Price.EXPECT().FindByCommodityIDsAndCityIDs(ctx, gomock.Any(), gomock.Any()).Return([]*domain.Price{}, nil).Times(1)
+		expectChecks(1, 1)
+		repo.Price.EXPECT().Create(ctx, gomock.Any()).Return(utils.NewInternalError("database error")).Times(1)
+
+		report, err := uc.ImportPrices(ctx, &dto.PriceImportDTO{FileName: "prices.csv", Content: []byte(file)})
+
+		assert.Error(t, err)
+		assert.Nil(t, report)
+		assert.EqualError(t, err, "database error")
+	})
+}
+
+func TestPriceUsecase_PriceAnomaly(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := PriceUsecaseUtils(t)
+
+	// With the current price of 100 the median is 100 and the median absolute deviation 2
+	recent := []float64{100, 104, 96, 102}
+	expectTx := func() {
+		repo.TxManager.EXPECT().
+			WithTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+	}
+
+	t.Run("should hold an update far from the recent prices", func(t *testing.T) {
+		expectTx()
+		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.Price, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return(recent, nil).Times(1)
+		repo.PriceReview.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, review *domain.PriceReview) error {
+			assert.Equal(t, domain.PriceReviewPending, review.Status)
+			assert.Equal(t, ids.PriceID, *review.PriceID)
+			assert.Equal(t, float64(100), *review.PreviousPrice)
+			assert.Equal(t, float64(100), review.Median)
+			assert.Equal(t, 303.52, *review.Score)
+			assert.Equal(t, 5, review.Samples)
+			return nil
+		}).Times(1)
+
+		resp, err := uc.UpdatePrice(ctx, ids.PriceID, &dto.PriceUpdateDTO{Price: 1000})
+
+		assert.Nil(t, resp)
+		assert.Error(t, err)
+		appErr, ok := err.(utils.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, "PENDING_REVIEW", appErr.Code)
+		assert.Equal(t, 202, appErr.HttpStatus)
+	})
+
+	t.Run("should apply a forced update and record it", func(t *testing.T) {
+		expectTx()
+		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.Price, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return(recent, nil).Times(1)
+		repo.PriceReview.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, review *domain.PriceReview) error {
+			assert.Equal(t, domain.PriceReviewForced, review.Status)
+			return nil
+		}).Times(1)
+		repo.PriceHistory.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(1)
+		repo.Price.EXPECT().Update(ctx, ids.PriceID, gomock.Any()).Return(nil).Times(1)
+		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.UpdatedPrice, nil).Times(1)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "price").Return(nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "price-event-exchange", "", gomock.Any()).Return(nil).Times(1)
+		repo.PriceAlert.EXPECT().FindActiveByCommodityIDAndCityID(ctx, ids.CommodityID, ids.CityID).Return([]*domain.PriceAlert{}, nil).Times(1)
+
+		resp, err := uc.UpdatePrice(ctx, ids.PriceID, &dto.PriceUpdateDTO{Price: 900, Force: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(900), resp.Price)
+	})
+
+	t.Run("should not hold a small move", func(t *testing.T) {
+		expectTx()
+		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.Price, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return(recent, nil).Times(1)
+		repo.PriceHistory.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(1)
+		repo.Price.EXPECT().Update(ctx, ids.PriceID, gomock.Any()).Return(nil).Times(1)
+		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.Price, nil).Times(1)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "price").Return(nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "price-event-exchange", "", gomock.Any()).Return(nil).Times(1)
+		repo.PriceAlert.EXPECT().FindActiveByCommodityIDAndCityID(ctx, ids.CommodityID, ids.CityID).Return([]*domain.PriceAlert{}, nil).Times(1)
+
+		_, err := uc.UpdatePrice(ctx, ids.PriceID, &dto.PriceUpdateDTO{Price: 110})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should hold a new price far from the other cities", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, ids.CommodityID).Return(mocks.Commodity, nil).Times(1)
+		repo.City.EXPECT().FindByID(ctx, int64(2)).Return(&domain.City{ID: 2}, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, int64(2), 30).Return([]float64{}, nil).Times(1)
+		repo.Price.EXPECT().FindByCommodityID(ctx, ids.CommodityID).Return([]*domain.Price{
+			{CityID: 1, Price: 100},
+			{CityID: 3, Price: 110},
+			{CityID: 4, Price: 90},
+		}, nil).Times(1)
+		repo.PriceReview.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, review *domain.PriceReview) error {
+			assert.Equal(t, domain.PriceReviewPending, review.Status)
+			assert.Nil(t, review.PriceID)
+			assert.Equal(t, 3, review.Samples)
+			return nil
+		}).Times(1)
+
+		resp, err := uc.CreatePrice(ctx, &dto.PriceCreateDTO{CommodityID: ids.CommodityID, CityID: 2, Price: 1000})
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "the price is far from the recent prices and is held for review, send it with force to apply it")
+	})
+}
+
+func TestPriceUsecase_ReviewPrice(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := PriceUsecaseUtils(t)
+	reviewID := uuid.New()
+	reviewerID := uuid.New()
+	changedBy := uuid.New()
+	expectTx := func() {
+		repo.TxManager.EXPECT().
+			WithTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+	}
+
+	t.Run("should apply a held update when approved", func(t *testing.T) {
+		review := &domain.PriceReview{ID: reviewID, PriceID: &ids.PriceID, CommodityID: ids.CommodityID, CityID: ids.CityID, Price: 1000, ChangedBy: &changedBy, Reason: "harvest failed", Status: domain.PriceReviewPending}
+		repo.PriceReview.EXPECT().FindByID(ctx, reviewID).Return(review, nil).Times(1)
+		expectTx()
+		repo.Price.EXPECT().FindByCommodityIDAndCityID(ctx, ids.CommodityID, ids.CityID).Return(mocks.Price, nil).Times(1)
+		repo.PriceHistory.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, history *domain.PriceHistory) error {
+			assert.Equal(t, float64(100), history.Price)
+			return nil
+		}).Times(1)
+		repo.Price.EXPECT().Update(ctx, ids.PriceID, &domain.Price{ID: ids.PriceID, Price: 1000, ChangedBy: &changedBy, Reason: "harvest failed", Source: domain.PriceSourceManual}).Return(nil).Times(1)
+		repo.PriceReview.EXPECT().Update(ctx, review).Return(true, nil).Times(1)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "price").Return(nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "price-event-exchange", "", gomock.Any()).Return(nil).Times(1)
+		repo.PriceAlert.EXPECT().FindActiveByCommodityIDAndCityID(ctx, ids.CommodityID, ids.CityID).Return([]*domain.PriceAlert{}, nil).Times(1)
+
+		resp, err := uc.ApprovePriceReview(ctx, reviewID, reviewerID, &dto.PriceReviewDecisionDTO{Note: "checked with the market"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PriceReviewApproved, resp.Status)
+		assert.Equal(t, reviewerID, *resp.ReviewedBy)
+		assert.NotNil(t, resp.ReviewedAt)
+	})
+
+	t.Run("should create the price when a held new price is approved", func(t *testing.T) {
+		review := &domain.PriceReview{ID: reviewID, CommodityID: ids.CommodityID, CityID: ids.CityID, Price: 1000, Status: domain.PriceReviewPending}
+		repo.PriceReview.EXPECT().FindByID(ctx, reviewID).Return(review, nil).Times(1)
+		expectTx()
+		repo.Price.EXPECT().FindByCommodityIDAndCityID(ctx, ids.CommodityID, ids.CityID).Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+		repo.Price.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(1)
+		repo.PriceReview.EXPECT().Update(ctx, review).Return(true, nil).Times(1)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "price").Return(nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "price-event-exchange", "", gomock.Any()).Return(nil).Times(1)
+
+		resp, err := uc.ApprovePriceReview(ctx, reviewID, reviewerID, &dto.PriceReviewDecisionDTO{})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp.PriceID)
+	})
+
+	t.Run("should reject a held change without applying it", func(t *testing.T) {
+		review := &domain.PriceReview{ID: reviewID, PriceID: &ids.PriceID, Price: 1000, Status: domain.PriceReviewPending}
+		repo.PriceReview.EXPECT().FindByID(ctx, reviewID).Return(review, nil).Times(1)
+		repo.PriceReview.EXPECT().Update(ctx, review).Return(true, nil).Times(1)
+
+		resp, err := uc.RejectPriceReview(ctx, reviewID, reviewerID, &dto.PriceReviewDecisionDTO{Note: "typo"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PriceReviewRejected, resp.Status)
+		assert.Equal(t, "typo", resp.Note)
+	})
+
+	t.Run("should roll back an approval when the review was decided meanwhile", func(t *testing.T) {
+		review := &domain.PriceReview{ID: reviewID, PriceID: &ids.PriceID, CommodityID: ids.CommodityID, CityID: ids.CityID, Price: 1000, Status: domain.PriceReviewPending}
+		repo.PriceReview.EXPECT().FindByID(ctx, reviewID).Return(review, nil).Times(1)
+		repo.TxManager.EXPECT().
+			WithTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				err := fn(ctx)
+				assert.Error(t, err)
+				return err
+			})
+		repo.Price.EXPECT().FindByCommodityIDAndCityID(ctx, ids.CommodityID, ids.CityID).Return(mocks.Price, nil).Times(1)
+		repo.PriceHistory.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(1)
+		repo.Price.EXPECT().Update(ctx, ids.PriceID, gomock.Any()).Return(nil).Times(1)
+		repo.PriceReview.EXPECT().Update(ctx, review).Return(false, nil).Times(1)
+
+		resp, err := uc.ApprovePriceReview(ctx, reviewID, reviewerID, &dto.PriceReviewDecisionDTO{})
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "price review was already decided")
+	})
+
+	t.Run("should return error when review was rejected meanwhile", func(t *testing.T) {
+		review := &domain.PriceReview{ID: reviewID, PriceID: &ids.PriceID, Price: 1000, Status: domain.PriceReviewForced}
+		repo.PriceReview.EXPECT().FindByID(ctx, reviewID).Return(review, nil).Times(1)
+		repo.PriceReview.EXPECT().Update(ctx, review).Return(false, nil).Times(1)
+
+		resp, err := uc.RejectPriceReview(ctx, reviewID, reviewerID, &dto.PriceReviewDecisionDTO{})
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "price review was already decided")
+	})
+
+	t.Run("should return error when review was already decided", func(t *testing.T) {
+		repo.PriceReview.EXPECT().FindByID(ctx, reviewID).Return(&domain.PriceReview{ID: reviewID, Status: domain.PriceReviewRejected}, nil).Times(1)
+
+		resp, err := uc.ApprovePriceReview(ctx, reviewID, reviewerID, &dto.PriceReviewDecisionDTO{})
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "price review is already rejected")
+	})
+
+	t.Run("should return error when status filter is invalid", func(t *testing.T) {
+		resp, err := uc.GetPriceReviews(ctx, "done")
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "status must be pending, forced, approved or rejected")
+	})
+}
+
+func TestPriceUsecase_SchedulePrice(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := PriceUsecaseUtils(t)
+	userID := uuid.New()
+	scheduleID := uuid.New()
+	tomorrow := time.Now().Add(24 * time.Hour)
+
+	t.Run("should keep a change that takes effect later", func(t *testing.T) {
+		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.Price, nil).Times(1)
+		repo.PriceSchedule.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(1)
+
+		resp, err := uc.SchedulePriceUpdate(ctx, ids.PriceID, userID, &dto.PriceUpdateDTO{Price: 900, EffectiveAt: &tomorrow})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PriceSchedulePending, resp.Status)
+		assert.Equal(t, ids.CommodityID, resp.CommodityID)
+		assert.Equal(t, float64(900), resp.Price)
+		assert.Equal(t, userID, *resp.CreatedBy)
+	})
+
+	t.Run("should return error when effective_at is in the past", func(t *testing.T) {
+		yesterday := time.Now().Add(-24 * time.Hour)
+
+		resp, err := uc.SchedulePriceUpdate(ctx, ids.PriceID, userID, &dto.PriceUpdateDTO{Price: 900, EffectiveAt: &yesterday})
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "effective_at must be in the future")
+	})
+
+	t.Run("should return error when price not found", func(t *testing.T) {
+		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+
+		resp, err := uc.SchedulePriceUpdate(ctx, ids.PriceID, userID, &dto.PriceUpdateDTO{Price: 900, EffectiveAt: &tomorrow})
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "price not found")
+	})
+
+	t.Run("should cancel a pending schedule", func(t *testing.T) {
+		schedule := &domain.PriceSchedule{ID: scheduleID, Status: domain.PriceSchedulePending}
+		repo.PriceSchedule.EXPECT().FindByID(ctx, scheduleID).Return(schedule, nil).Times(1)
+		repo.PriceSchedule.EXPECT().Update(ctx, schedule).Return(true, nil).Times(1)
+
+		resp, err := uc.CancelPriceSchedule(ctx, scheduleID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PriceScheduleCancelled, resp.Status)
+		assert.NotNil(t, resp.CancelledAt)
+	})
+
+	t.Run("should return error when schedule was applied before the cancel", func(t *testing.T) {
+		schedule := &domain.PriceSchedule{ID: scheduleID, Status: domain.PriceSchedulePending}
+		repo.PriceSchedule.EXPECT().FindByID(ctx, scheduleID).Return(schedule, nil).Times(1)
+		repo.PriceSchedule.EXPECT().Update(ctx, schedule).Return(false, nil).Times(1)
+
+		resp, err := uc.CancelPriceSchedule(ctx, scheduleID)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "price schedule is no longer pending")
+	})
+
+	t.Run("should return error when status filter is invalid", func(t *testing.T) {
+		resp, err := uc.GetPriceSchedules(ctx, "done")
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "status must be pending, applied, cancelled, held or failed")
+	})
+}
+
+func TestPriceUsecase_ApplyDuePriceSchedules(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := PriceUsecaseUtils(t)
+	expectTx := func(times int) {
+		repo.TxManager.EXPECT().
+			WithTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).Times(times)
+	}
+
+	t.Run("should apply the due schedules and cancel the ones without a price", func(t *testing.T) {
+		createdBy := uuid.New()
+		due := &domain.PriceSchedule{ID: uuid.New(), PriceID: ids.PriceID, Price: 900, Reason: "official price", CreatedBy: &createdBy, Status: domain.PriceSchedulePending}
+		orphan := &domain.PriceSchedule{ID: uuid.New(), PriceID: uuid.New(), Price: 500, Status: domain.PriceSchedulePending}
+		expectTx(3)
+		gomock.InOrder(
+			repo.PriceSchedule.EXPECT().FindDue(ctx, gomock.Any(), 1).Return([]*domain.PriceSchedule{due}, nil),
+			repo.PriceSchedule.EXPECT().FindDue(ctx, gomock.Any(), 1).Return([]*domain.PriceSchedule{orphan}, nil),
+			repo.PriceSchedule.EXPECT().FindDue(ctx, gomock.Any(), 1).Return([]*domain.PriceSchedule{}, nil),
+		)
+		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.Price, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return([]float64{}, nil).Times(1)
+		repo.PriceHistory.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, history *domain.PriceHistory) error {
+			assert.Equal(t, float64(100), history.Price)
+			return nil
+		}).Times(1)
+		repo.Price.EXPECT().Update(ctx, ids.PriceID, &domain.Price{ID: ids.PriceID, Price: 900, ChangedBy: &createdBy, Reason: "official price", Source: domain.PriceSourceScheduler}).Return(nil).Times(1)
+		repo.PriceSchedule.EXPECT().Update(ctx, due).Return(true, nil).Times(1)
+		repo.Price.EXPECT().FindByID(ctx, orphan.PriceID).Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+		repo.PriceSchedule.EXPECT().Update(ctx, orphan).Return(true, nil).Times(1)
+		repo.PriceAlert.EXPECT().FindActiveByCommodityIDAndCityID(ctx, ids.CommodityID, ids.CityID).Return([]*domain.PriceAlert{}, nil).Times(1)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "price").Return(nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "price-event-exchange", "", gomock.Any()).Return(nil).Times(1)
+
+		applied, err := uc.ApplyDuePriceSchedules(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, applied)
+		assert.Equal(t, domain.PriceScheduleApplied, due.Status)
+		assert.NotNil(t, due.AppliedAt)
+		assert.Equal(t, domain.PriceScheduleCancelled, orphan.Status)
+	})
+
+	t.Run("should hold a schedule far from the recent prices for review", func(t *testing.T) {
+		createdBy := uuid.New()
+		due := &domain.PriceSchedule{ID: uuid.New(), PriceID: ids.PriceID, Price: 1000, Reason: "official price", CreatedBy: &createdBy, Status: domain.PriceSchedulePending}
+		expectTx(2)
+		gomock.InOrder(
+			repo.PriceSchedule.EXPECT().FindDue(ctx, gomock.Any(), 1).Return([]*domain.PriceSchedule{due}, nil),
+			repo.PriceSchedule.EXPECT().FindDue(ctx, gomock.Any(), 1).Return([]*domain.PriceSchedule{}, nil),
+		)
+		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.Price, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return([]float64{100, 105, 95, 102}, nil).Times(1)
+		repo.PriceReview.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, review *domain.PriceReview) error {
+			assert.Equal(t, domain.PriceReviewPending, review.Status)
+			assert.Equal(t, ids.PriceID, *review.PriceID)
+			assert.Equal(t, &createdBy, review.ChangedBy)
+			assert.Equal(t, "official price", review.Reason)
+			assert.Equal(t, domain.PriceSourceScheduler, review.Source)
+			assert.Equal(t, float64(1000), review.Price)
+			return nil
+		}).Times(1)
+		repo.PriceSchedule.EXPECT().Update(ctx, due).Return(true, nil).Times(1)
+
+		applied, err := uc.ApplyDuePriceSchedules(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, applied)
+		assert.Equal(t, domain.PriceScheduleHeld, due.Status)
+	})
+
+	t.Run("should mark a failing schedule failed and apply the next one", func(t *testing.T) {
+		failing := &domain.PriceSchedule{ID: uuid.New(), PriceID: ids.PriceID, Price: 900, Status: domain.PriceSchedulePending}
+		next := &domain.PriceSchedule{ID: uuid.New(), PriceID: ids.PriceID, Price: 110, Status: domain.PriceSchedulePending}
+		expectTx(3)
+		gomock.InOrder(
+			repo.PriceSchedule.EXPECT().FindDue(ctx, gomock.Any(), 1).Return([]*domain.PriceSchedule{failing}, nil),
+			repo.PriceSchedule.EXPECT().FindDue(ctx, gomock.Any(), 1).Return([]*domain.PriceSchedule{next}, nil),
+			repo.PriceSchedule.EXPECT().FindDue(ctx, gomock.Any(), 1).Return([]*domain.PriceSchedule{}, nil),
+		)
+		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(mocks.Price, nil).Times(2)
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return([]float64{}, nil).Times(2)
+		gomock.InOrder(
+			repo.PriceHistory.EXPECT().Create(ctx, gomock.Any()).Return(errors.New("database error")),
+			repo.PriceHistory.EXPECT().Create(ctx, gomock.Any()).Return(nil),
+		)
+		repo.Price.EXPECT().Update(ctx, ids.PriceID, gomock.Any()).Return(nil).Times(1)
+		repo.PriceSchedule.EXPECT().Update(ctx, failing).DoAndReturn(func(ctx context.Context, schedule *domain.PriceSchedule) (bool, error) {
+			assert.Equal(t, domain.PriceScheduleFailed, schedule.Status)
+			assert.Nil(t, schedule.AppliedAt)
+			return true, nil
+		}).Times(1)
+		repo.PriceSchedule.EXPECT().Update(ctx, next).Return(true, nil).Times(1)
+		repo.PriceAlert.EXPECT().FindActiveByCommodityIDAndCityID(ctx, ids.CommodityID, ids.CityID).Return([]*domain.PriceAlert{}, nil).Times(1)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "price").Return(nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "price-event-exchange", "", gomock.Any()).Return(nil).Times(1)
+
+		applied, err := uc.ApplyDuePriceSchedules(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, applied)
+		assert.Equal(t, domain.PriceScheduleFailed, failing.Status)
+		assert.Equal(t, domain.PriceScheduleApplied, next.Status)
+	})
+
+	t.Run("should do nothing when no schedule is due", func(t *testing.T) {
+		expectTx(1)
+		repo.PriceSchedule.EXPECT().FindDue(ctx, gomock.Any(), 1).Return([]*domain.PriceSchedule{}, nil).Times(1)
+
+		applied, err := uc.ApplyDuePriceSchedules(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, applied)
+	})
+
+	t.Run("should return error when finding due schedules failed", func(t *testing.T) {
+		expectTx(1)
+		repo.PriceSchedule.EXPECT().FindDue(ctx, gomock.Any(), 1).Return(nil, utils.NewInternalError("database error")).Times(1)
+
+		applied, err := uc.ApplyDuePriceSchedules(ctx)
+
+		assert.EqualError(t, err, "database error")
+		assert.Equal(t, 0, applied)
+	})
+}
+
+func TestPriceUsecase_PriceAudit(t *testing.T) {
+	ids, _, _, repo, uc, ctx := PriceUsecaseUtils(t)
+	previousAuthor := uuid.New()
+	author := uuid.New()
+	existing := &domain.Price{ID: ids.PriceID, CommodityID: ids.CommodityID, CityID: ids.CityID, Price: 100, ChangedBy: &previousAuthor, Reason: "weekly survey", Source: domain.PriceSourceImport}
+
+	t.Run("should keep who set the replaced price and record who changed it", func(t *testing.T) {
+		repo.TxManager.EXPECT().
+			WithTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(existing, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return([]float64{}, nil).Times(1)
+		repo.PriceHistory.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, history *domain.PriceHistory) error {
+			assert.Equal(t, float64(100), history.Price)
+			assert.Equal(t, previousAuthor, *history.ChangedBy)
+			assert.Equal(t, "weekly survey", history.Reason)
+			assert.Equal(t, domain.PriceSourceImport, history.Source)
+			return nil
+		}).Times(1)
+		repo.Price.EXPECT().Update(ctx, ids.PriceID, gomock.Any()).DoAndReturn(func(ctx context.Context, id uuid.UUID, price *domain.Price) error {
+			assert.Equal(t, float64(110), price.Price)
+			assert.Equal(t, author, *price.ChangedBy)
+			assert.Equal(t, "market correction", price.Reason)
+			assert.Equal(t, domain.PriceSourceAPIKey, price.Source)
+			return nil
+		}).Times(1)
+		repo.Price.EXPECT().FindByID(ctx, ids.PriceID).Return(existing, nil).Times(1)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "price").Return(nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "price-event-exchange", "", gomock.Any()).Return(nil).Times(1)
+		repo.PriceAlert.EXPECT().FindActiveByCommodityIDAndCityID(ctx, ids.CommodityID, ids.CityID).Return([]*domain.PriceAlert{}, nil).Times(1)
+
+		_, err := uc.UpdatePrice(ctx, ids.PriceID, &dto.PriceUpdateDTO{Price: 110, Reason: "market correction", ChangedBy: &author, Source: domain.PriceSourceAPIKey})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should record a change without a source as manual", func(t *testing.T) {
+		repo.Commodity.EXPECT().FindByID(ctx, ids.CommodityID).Return(&domain.Commodity{ID: ids.CommodityID}, nil).Times(1)
+		repo.City.EXPECT().FindByID(ctx, ids.CityID).Return(&domain.City{ID: ids.CityID}, nil).Times(1)
+		repo.PriceHistory.EXPECT().FindRecentPrices(ctx, ids.CommodityID, ids.CityID, 30).Return([]float64{}, nil).Times(1)
+		repo.Price.EXPECT().FindByCommodityID(ctx, ids.CommodityID).Return([]*domain.Price{}, nil).Times(1)
+		repo.Price.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, price *domain.Price) error {
+			assert.Equal(t, domain.PriceSourceManual, price.Source)
+			assert.Equal(t, author, *price.ChangedBy)
+			return nil
+		}).Times(1)
+		repo.Price.EXPECT().FindByID(ctx, gomock.Any()).Return(existing, nil).Times(1)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "price").Return(nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "price-event-exchange", "", gomock.Any()).Return(nil).Times(1)
+
+		_, err := uc.CreatePrice(ctx, &dto.PriceCreateDTO{CommodityID: ids.CommodityID, CityID: ids.CityID, Price: 100, ChangedBy: &author})
+
+		assert.NoError(t, err)
+	})
+}
@@ -28,6 +28,7 @@ type SaleIDs struct {
 	SaleID      uuid.UUID
 	CommodityID uuid.UUID
 	CityID      int64
+	BuyerID     uuid.UUID
 }
 
 type SaleDomains struct {
@@ -53,6 +54,7 @@ func SaleUsecaseSetup(t *testing.T) (*SaleIDs, *SaleDomains, *SaleDtos, *SaleRep
 		SaleID:      saleID,
 		CommodityID: commodityID,
 		CityID:      cityID,
+		BuyerID:     uuid.New(),
 	}
 
 	date := "2022-01-01"
@@ -158,7 +160,7 @@ func TestSaleUsecase_CreateSale(t *testing.T) {
 
 		repo.Sale.EXPECT().FindByID(ctx, ids.SaleID).Return(domains.Sale, nil).Times(1)
 
-		resp, err := uc.CreateSale(ctx, dtos.Create)
+		resp, err := uc.CreateSale(ctx, ids.BuyerID, "Admin", dtos.Create)
 
 		assert.NoError(t, err)
 		assert.Equal(t, dtos.Create.CommodityID, resp.CommodityID)
@@ -172,7 +174,7 @@ func TestSaleUsecase_CreateSale(t *testing.T) {
 	})
 
 	t.Run("should return error if validation fails", func(t *testing.T) {
-		resp, err := uc.CreateSale(ctx, &dto.SaleCreateDTO{
+		resp, err := uc.CreateSale(ctx, ids.BuyerID, "Admin", &dto.SaleCreateDTO{
 			CommodityID: ids.CommodityID,
 			CityID:      ids.CityID,
 			Quantity:    -10,
@@ -188,7 +190,7 @@ func TestSaleUsecase_CreateSale(t *testing.T) {
 
 		repo.Commodity.EXPECT().FindByID(ctx, ids.CommodityID).Return(nil, utils.NewNotFoundError("commodity not found")).Times(1)
 
-		resp, err := uc.CreateSale(ctx, dtos.Create)
+		resp, err := uc.CreateSale(ctx, ids.BuyerID, "Admin", dtos.Create)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -198,7 +200,7 @@ func TestSaleUsecase_CreateSale(t *testing.T) {
 	t.Run("should return error if city not found", func(t *testing.T) {
 		repo.City.EXPECT().FindByID(ctx, ids.CityID).Return(nil, utils.NewNotFoundError("city not found")).Times(1)
 
-		resp, err := uc.CreateSale(ctx, dtos.Create)
+		resp, err := uc.CreateSale(ctx, ids.BuyerID, "Admin", dtos.Create)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -210,7 +212,7 @@ func TestSaleUsecase_CreateSale(t *testing.T) {
 
 		repo.Commodity.EXPECT().FindByID(ctx, ids.CommodityID).Return(domains.Commodity, nil).Times(1)
 
-		resp, err := uc.CreateSale(ctx, &dto.SaleCreateDTO{
+		resp, err := uc.CreateSale(ctx, ids.BuyerID, "Admin", &dto.SaleCreateDTO{
 			CommodityID: ids.CommodityID,
 			CityID:      ids.CityID,
 			Quantity:    100,
@@ -231,7 +233,7 @@ func TestSaleUsecase_CreateSale(t *testing.T) {
 
 		repo.Sale.EXPECT().Create(ctx, gomock.Any()).Return(utils.NewInternalError("internal error")).Times(1)
 
-		resp, err := uc.CreateSale(ctx, dtos.Create)
+		resp, err := uc.CreateSale(ctx, ids.BuyerID, "Admin", dtos.Create)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -250,7 +252,7 @@ func TestSaleUsecase_CreateSale(t *testing.T) {
 
 		repo.Sale.EXPECT().FindByID(ctx, ids.SaleID).Return(nil, utils.NewInternalError("internal error")).Times(1)
 
-		resp, err := uc.CreateSale(ctx, dtos.Create)
+		resp, err := uc.CreateSale(ctx, ids.BuyerID, "Admin", dtos.Create)
 
 		assert.Nil(t, resp)
 		assert.Error(t, err)
@@ -258,6 +260,26 @@ func TestSaleUsecase_CreateSale(t *testing.T) {
 	})
 }
 
+func TestSaleUsecase_CreateSaleAsBuyer(t *testing.T) {
+	ids, domains, dtos, repo, uc, ctx := SaleUsecaseSetup(t)
+
+	t.Run("should link the sale to the buyer", func(t *testing.T) {
+		repo.City.EXPECT().FindByID(ctx, ids.CityID).Return(domains.City, nil).Times(1)
+		repo.Commodity.EXPECT().FindByID(ctx, ids.CommodityID).Return(domains.Commodity, nil).Times(1)
+		repo.Sale.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, p *domain.Sale) error {
+			assert.Equal(t, &ids.BuyerID, p.BuyerID)
+			p.ID = ids.SaleID
+			return nil
+		}).Times(1)
+		repo.Sale.EXPECT().FindByID(ctx, ids.SaleID).Return(domains.Sale, nil).Times(1)
+
+		resp, err := uc.CreateSale(ctx, ids.BuyerID, "Buyer", dtos.Create)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domains.Sale, resp)
+	})
+}
+
 func TestSaleUsecase_GetAllSales(t *testing.T) {
 	_, domains, dtos, repo, uc, ctx := SaleUsecaseSetup(t)
 
@@ -381,6 +403,41 @@ func TestSaleUsecase_GetSalesByCityID(t *testing.T) {
 	})
 }
 
+func TestSaleUsecase_GetSalesByBuyerID(t *testing.T) {
+	ids, domains, dtos, repo, uc, ctx := SaleUsecaseSetup(t)
+
+	t.Run("should get sales of the buyer successfully", func(t *testing.T) {
+		repo.Sale.EXPECT().FindAll(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, params *dto.PaginationDTO) ([]*domain.Sale, error) {
+			assert.Equal(t, &ids.BuyerID, params.Filter.BuyerID)
+			return domains.Sales, nil
+		}).Times(1)
+
+		repo.Sale.EXPECT().Count(ctx, gomock.Any()).Return(int64(len(domains.Sales)), nil).Times(1)
+
+		resp, err := uc.GetSalesByBuyerID(ctx, dtos.Pagination, ids.BuyerID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, dtos.PaginationResponse.TotalRows, resp.TotalRows)
+		assert.Equal(t, dtos.PaginationResponse.Data, resp.Data)
+	})
+
+	t.Run("should return error if find sales fails", func(t *testing.T) {
+		repo.Sale.EXPECT().FindAll(ctx, gomock.Any()).Return(nil, utils.NewInternalError("internal error")).Times(1)
+
+		resp, err := uc.GetSalesByBuyerID(ctx, dtos.Pagination, ids.BuyerID)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "internal error")
+	})
+
+	t.Run("should return error if pagination is invalid", func(t *testing.T) {
+		resp, err := uc.GetSalesByBuyerID(ctx, &dto.PaginationDTO{}, ids.BuyerID)
+
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "page must be greater than 0")
+	})
+}
+
 func TestSaleUsecase_UpdateSale(t *testing.T) {
 	ids, domains, dtos, repo, uc, ctx := SaleUsecaseSetup(t)
 
@@ -0,0 +1,107 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+	mock_repo "github.com/ryvasa/go-super-farmer/internal/repository/mock"
+	usecase_implementation "github.com/ryvasa/go-super-farmer/internal/usecase/implementation"
+	usecase_interface "github.com/ryvasa/go-super-farmer/internal/usecase/interface"
+	"github.com/ryvasa/go-super-farmer/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func UnitUsecaseUtils(t *testing.T) (*domain.Unit, *mock_repo.MockUnitRepository, usecase_interface.UnitUsecase, context.Context) {
+	unit := &domain.Unit{Code: "sack", Name: "Sack", Dimension: domain.UnitDimensionMass, Factor: 50}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	unitRepo := mock_repo.NewMockUnitRepository(ctrl)
+	uc := usecase_implementation.NewUnitUsecase(unitRepo)
+	ctx := context.TODO()
+
+	return unit, unitRepo, uc, ctx
+}
+
+func TestUnitUsecase_CreateUnit(t *testing.T) {
+	unit, repo, uc, ctx := UnitUsecaseUtils(t)
+
+	t.Run("should create unit with a normalized code", func(t *testing.T) {
+		repo.EXPECT().FindByCode(ctx, "bag").Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+		repo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(1)
+
+		resp, err := uc.CreateUnit(ctx, &dto.UnitCreateDTO{Code: " BAG ", Name: "Bag", Dimension: domain.UnitDimensionMass, Factor: 25})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "bag", resp.Code)
+		assert.Equal(t, float64(25), resp.Factor)
+	})
+
+	t.Run("should return error validation error when dimension is unknown", func(t *testing.T) {
+		resp, err := uc.CreateUnit(ctx, &dto.UnitCreateDTO{Code: "l", Name: "Liter", Dimension: "volume", Factor: 1})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "Validation failed")
+	})
+
+	t.Run("should return error when unit already exists", func(t *testing.T) {
+		repo.EXPECT().FindByCode(ctx, "sack").Return(unit, nil).Times(1)
+
+		resp, err := uc.CreateUnit(ctx, &dto.UnitCreateDTO{Code: "sack", Name: "Sack", Dimension: domain.UnitDimensionMass, Factor: 50})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "unit already exists")
+	})
+
+	t.Run("should return error internal when create fails", func(t *testing.T) {
+		repo.EXPECT().FindByCode(ctx, "bag").Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+		repo.EXPECT().Create(ctx, gomock.Any()).Return(errors.New("internal error")).Times(1)
+
+		resp, err := uc.CreateUnit(ctx, &dto.UnitCreateDTO{Code: "bag", Name: "Bag", Dimension: domain.UnitDimensionMass, Factor: 25})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "internal error")
+	})
+}
+
+func TestUnitUsecase_UpdateUnit(t *testing.T) {
+	unit, repo, uc, ctx := UnitUsecaseUtils(t)
+
+	t.Run("should update unit factor", func(t *testing.T) {
+		repo.EXPECT().FindByCode(ctx, "sack").Return(unit, nil).Times(1)
+		repo.EXPECT().Update(ctx, "sack", &domain.Unit{Factor: 60}).Return(nil).Times(1)
+
+		resp, err := uc.UpdateUnit(ctx, "SACK", &dto.UnitUpdateDTO{Factor: 60})
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(60), resp.Factor)
+		assert.Equal(t, "Sack", resp.Name)
+	})
+
+	t.Run("should return error when factor of a base unit changes", func(t *testing.T) {
+		repo.EXPECT().FindByCode(ctx, "kg").Return(&domain.Unit{Code: "kg", Factor: 1}, nil).Times(1)
+
+		resp, err := uc.UpdateUnit(ctx, "kg", &dto.UnitUpdateDTO{Factor: 2})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "the factor of a base unit is always 1")
+	})
+
+	t.Run("should return error when unit not found", func(t *testing.T) {
+		repo.EXPECT().FindByCode(ctx, "bag").Return(nil, utils.NewNotFoundError("record not found")).Times(1)
+
+		resp, err := uc.UpdateUnit(ctx, "bag", &dto.UnitUpdateDTO{Name: "Bag"})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "unit not found")
+	})
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/pkg/auth/token"
 	mock_pkg "github.com/ryvasa/go-super-farmer/pkg/mock"
 	"github.com/ryvasa/go-super-farmer/internal/model/domain"
 	"github.com/ryvasa/go-super-farmer/internal/model/dto"
@@ -21,9 +22,11 @@ import (
 )
 
 type UserRepoMock struct {
-	User  *mock_repo.MockUserRepository
-	Hash  *mockUtils.MockHasher
-	Cache *mock_pkg.MockCache
+	User     *mock_repo.MockUserRepository
+	Hash     *mockUtils.MockHasher
+	Cache    *mock_pkg.MockCache
+	OTP      *mockUtils.MockOTP
+	RabbitMQ *mock_pkg.MockRabbitMQ
 }
 
 type UserIDs struct {
@@ -99,10 +102,12 @@ func UserUsecaseUtils(t *testing.T) (*UserIDs, *UserMocks, *MockUserDTOs, *UserR
 	userRepo := mock_repo.NewMockUserRepository(ctrl)
 	hash := mockUtils.NewMockHasher(ctrl)
 	cache := mock_pkg.NewMockCache(ctrl)
-	uc := usecase_implementation.NewUserUsecase(userRepo, hash, cache)
+	otp := mockUtils.NewMockOTP(ctrl)
+	rabbitMQ := mock_pkg.NewMockRabbitMQ(ctrl)
+	uc := usecase_implementation.NewUserUsecase(userRepo, hash, cache, otp, rabbitMQ)
 	ctx := context.TODO()
 
-	repo := &UserRepoMock{User: userRepo, Hash: hash, Cache: cache}
+	repo := &UserRepoMock{User: userRepo, Hash: hash, Cache: cache, OTP: otp, RabbitMQ: rabbitMQ}
 
 	return ids, mocks, dto, repo, uc, ctx
 }
@@ -615,3 +620,235 @@ func TestUserUsecase_RestoreUser(t *testing.T) {
 		assert.EqualError(t, err, "cache delete failed")
 	})
 }
+
+func TestUserUsecase_UpdateProfile(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := UserUsecaseUtils(t)
+
+	req := &dto.UserProfileUpdateDTO{Name: "updated", Phone: "08123456"}
+
+	t.Run("should update profile successfully", func(t *testing.T) {
+		repo.User.EXPECT().FindByID(ctx, ids.UserID).Return(mocks.User, nil).Times(1)
+		repo.User.EXPECT().Update(ctx, ids.UserID, &domain.User{Name: "updated", Phone: &req.Phone}).Return(nil).Times(1)
+		repo.User.EXPECT().FindByID(ctx, ids.UserID).Return(mocks.UpdatedUser, nil).Times(1)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "user").Return(nil).Times(1)
+
+		resp, err := uc.UpdateProfile(ctx, ids.UserID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, mocks.UpdatedUser.Name, resp.Name)
+	})
+
+	t.Run("should return error validation error", func(t *testing.T) {
+		resp, err := uc.UpdateProfile(ctx, ids.UserID, &dto.UserProfileUpdateDTO{Name: "a"})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "Validation failed")
+	})
+
+	t.Run("should return error not found when user does not exist", func(t *testing.T) {
+		repo.User.EXPECT().FindByID(ctx, ids.UserID).Return(nil, utils.NewNotFoundError("user not found")).Times(1)
+
+		resp, err := uc.UpdateProfile(ctx, ids.UserID, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "user not found")
+	})
+}
+
+func TestUserUsecase_ChangePassword(t *testing.T) {
+	ids, _, _, repo, uc, ctx := UserUsecaseUtils(t)
+
+	user := &domain.User{ID: ids.UserID, Password: "old_hash"}
+	req := &dto.UserChangePasswordDTO{CurrentPassword: "password", NewPassword: "new_password"}
+
+	t.Run("should change password successfully", func(t *testing.T) {
+		repo.User.EXPECT().FindAuthByID(ctx, ids.UserID).Return(user, nil).Times(1)
+		repo.Hash.EXPECT().ValidatePassword("password", "old_hash").Return(true).Times(1)
+		repo.Hash.EXPECT().HashPassword("new_password").Return("new_hash", nil).Times(1)
+		repo.User.EXPECT().Update(ctx, ids.UserID, &domain.User{Password: "new_hash"}).Return(nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.RevokedUserKey(ids.UserID.String()), gomock.Any(), token.RefreshTokenTTL).Return(nil).Times(1)
+
+		err := uc.ChangePassword(ctx, ids.UserID, req)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when sessions cannot be revoked", func(t *testing.T) {
+		repo.User.EXPECT().FindAuthByID(ctx, ids.UserID).Return(user, nil).Times(1)
+		repo.Hash.EXPECT().ValidatePassword("password", "old_hash").Return(true).Times(1)
+		repo.Hash.EXPECT().HashPassword("new_password").Return("new_hash", nil).Times(1)
+		repo.User.EXPECT().Update(ctx, ids.UserID, &domain.User{Password: "new_hash"}).Return(nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.RevokedUserKey(ids.UserID.String()), gomock.Any(), token.RefreshTokenTTL).Return(errors.New("redis down")).Times(1)
+
+		err := uc.ChangePassword(ctx, ids.UserID, req)
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "redis down")
+	})
+
+	t.Run("should return error when current password is incorrect", func(t *testing.T) {
+		repo.User.EXPECT().FindAuthByID(ctx, ids.UserID).Return(user, nil).Times(1)
+		repo.Hash.EXPECT().ValidatePassword("password", "old_hash").Return(false).Times(1)
+
+		err := uc.ChangePassword(ctx, ids.UserID, req)
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "current password is incorrect")
+	})
+
+	t.Run("should return error validation error when new password equals current", func(t *testing.T) {
+		err := uc.ChangePassword(ctx, ids.UserID, &dto.UserChangePasswordDTO{CurrentPassword: "password", NewPassword: "password"})
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "Validation failed")
+	})
+}
+
+func TestUserUsecase_RequestEmailChange(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := UserUsecaseUtils(t)
+
+	req := &dto.UserEmailChangeDTO{Email: "new@example.com"}
+
+	t.Run("should send otp to the new email", func(t *testing.T) {
+		repo.User.EXPECT().FindByID(ctx, ids.UserID).Return(mocks.User, nil).Times(1)
+		repo.User.EXPECT().FindByEmail(ctx, req.Email).Return(nil, errors.New("record not found")).Times(1)
+		repo.OTP.EXPECT().GenerateOTP(6).Return("123456", nil).Times(1)
+		pending, _ := json.Marshal(map[string]string{"email": req.Email, "otp": "123456"})
+		repo.Cache.EXPECT().Set(ctx, fmt.Sprintf("email_change:%s", ids.UserID), pending, gomock.Any()).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, fmt.Sprintf("email_change_attempts:%s", ids.UserID)).Return(nil).Times(1)
+		repo.RabbitMQ.EXPECT().PublishJSON(ctx, "mail-exchange", "change-email", gomock.Any()).Return(nil).Times(1)
+
+		err := uc.RequestEmailChange(ctx, ids.UserID, req)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when email already exists", func(t *testing.T) {
+		repo.User.EXPECT().FindByID(ctx, ids.UserID).Return(mocks.User, nil).Times(1)
+		repo.User.EXPECT().FindByEmail(ctx, req.Email).Return(&domain.User{Email: req.Email}, nil).Times(1)
+
+		err := uc.RequestEmailChange(ctx, ids.UserID, req)
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "email already exists")
+	})
+
+	t.Run("should return error when email is the current one", func(t *testing.T) {
+		repo.User.EXPECT().FindByID(ctx, ids.UserID).Return(mocks.User, nil).Times(1)
+
+		err := uc.RequestEmailChange(ctx, ids.UserID, &dto.UserEmailChangeDTO{Email: mocks.User.Email})
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "new email is the same as the current one")
+	})
+}
+
+func TestUserUsecase_ConfirmEmailChange(t *testing.T) {
+	ids, mocks, _, repo, uc, ctx := UserUsecaseUtils(t)
+
+	pendingKey := fmt.Sprintf("email_change:%s", ids.UserID)
+	attemptsKey := fmt.Sprintf("email_change_attempts:%s", ids.UserID)
+	pending, _ := json.Marshal(map[string]string{"email": "new@example.com", "otp": "123456"})
+
+	t.Run("should change email successfully", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, pendingKey).Return(pending, nil).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, attemptsKey, gomock.Any()).Return(int64(1), nil).Times(1)
+		repo.User.EXPECT().FindByEmail(ctx, "new@example.com").Return(nil, errors.New("record not found")).Times(1)
+		repo.User.EXPECT().Update(ctx, ids.UserID, &domain.User{Email: "new@example.com", Verified: true}).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, pendingKey).Return(nil).Times(1)
+		repo.Cache.EXPECT().Delete(ctx, attemptsKey).Return(nil).Times(1)
+		repo.User.EXPECT().FindByID(ctx, ids.UserID).Return(&domain.User{ID: ids.UserID, Email: "new@example.com"}, nil).Times(1)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "user").Return(nil).Times(1)
+
+		resp, err := uc.ConfirmEmailChange(ctx, ids.UserID, &dto.UserEmailConfirmDTO{OTP: "123456"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "new@example.com", resp.Email)
+	})
+
+	t.Run("should return error when otp is invalid", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, pendingKey).Return(pending, nil).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, attemptsKey, gomock.Any()).Return(int64(2), nil).Times(1)
+
+		resp, err := uc.ConfirmEmailChange(ctx, ids.UserID, &dto.UserEmailConfirmDTO{OTP: "654321"})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "invalid OTP")
+	})
+
+	t.Run("should return error when too many attempts", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, pendingKey).Return(pending, nil).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, attemptsKey, gomock.Any()).Return(int64(6), nil).Times(1)
+
+		resp, err := uc.ConfirmEmailChange(ctx, ids.UserID, &dto.UserEmailConfirmDTO{OTP: "123456"})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "too many attempts, request a new code")
+	})
+
+	t.Run("should return error when no change is pending", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, pendingKey).Return(nil, errors.New("redis: nil")).Times(1)
+
+		resp, err := uc.ConfirmEmailChange(ctx, ids.UserID, &dto.UserEmailConfirmDTO{OTP: "123456"})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "no pending email change")
+	})
+
+	t.Run("should return error when email was taken meanwhile", func(t *testing.T) {
+		repo.Cache.EXPECT().Get(ctx, pendingKey).Return(pending, nil).Times(1)
+		repo.Cache.EXPECT().Incr(ctx, attemptsKey, gomock.Any()).Return(int64(1), nil).Times(1)
+		repo.User.EXPECT().FindByEmail(ctx, "new@example.com").Return(mocks.User, nil).Times(1)
+
+		resp, err := uc.ConfirmEmailChange(ctx, ids.UserID, &dto.UserEmailConfirmDTO{OTP: "123456"})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "email already exists")
+	})
+}
+
+func TestUserUsecase_DeleteAccount(t *testing.T) {
+	ids, _, _, repo, uc, ctx := UserUsecaseUtils(t)
+
+	user := &domain.User{ID: ids.UserID, Password: "hash"}
+	req := &dto.UserDeleteAccountDTO{Password: "password"}
+
+	t.Run("should anonymize account and revoke sessions", func(t *testing.T) {
+		repo.User.EXPECT().FindAuthByID(ctx, ids.UserID).Return(user, nil).Times(1)
+		repo.Hash.EXPECT().ValidatePassword("password", "hash").Return(true).Times(1)
+		repo.User.EXPECT().Anonymize(ctx, ids.UserID).Return(nil).Times(1)
+		repo.Cache.EXPECT().Set(ctx, token.RevokedUserKey(ids.UserID.String()), gomock.Any(), token.RefreshTokenTTL).Return(nil).Times(1)
+		repo.Cache.EXPECT().DeleteByPattern(ctx, "user").Return(nil).Times(1)
+
+		err := uc.DeleteAccount(ctx, ids.UserID, req)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when password is incorrect", func(t *testing.T) {
+		repo.User.EXPECT().FindAuthByID(ctx, ids.UserID).Return(user, nil).Times(1)
+		repo.Hash.EXPECT().ValidatePassword("password", "hash").Return(false).Times(1)
+
+		err := uc.DeleteAccount(ctx, ids.UserID, req)
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "password is incorrect")
+	})
+
+	t.Run("should return error internal error when anonymize fails", func(t *testing.T) {
+		repo.User.EXPECT().FindAuthByID(ctx, ids.UserID).Return(user, nil).Times(1)
+		repo.Hash.EXPECT().ValidatePassword("password", "hash").Return(true).Times(1)
+		repo.User.EXPECT().Anonymize(ctx, ids.UserID).Return(errors.New("database error")).Times(1)
+
+		err := uc.DeleteAccount(ctx, ids.UserID, req)
+
+		assert.Error(t, err)
+		assert.EqualError(t, err, "database error")
+	})
+}
@@ -0,0 +1,267 @@
+package casbin
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CasbinRule is one policy line, v0..v5 hold the rule fields in order
+type CasbinRule struct {
+	ID    uint   `gorm:"primaryKey;autoIncrement"`
+	Ptype string `gorm:"size:100;uniqueIndex:idx_casbin_rule"`
+	V0    string `gorm:"size:100;uniqueIndex:idx_casbin_rule"`
+	V1    string `gorm:"size:100;uniqueIndex:idx_casbin_rule"`
+	V2    string `gorm:"size:100;uniqueIndex:idx_casbin_rule"`
+	V3    string `gorm:"size:100;uniqueIndex:idx_casbin_rule"`
+	V4    string `gorm:"size:100;uniqueIndex:idx_casbin_rule"`
+	V5    string `gorm:"size:100;uniqueIndex:idx_casbin_rule"`
+}
+
+func (CasbinRule) TableName() string {
+	return "casbin_rule"
+}
+
+// CasbinSeededRule is a rule of the policy csv that was seeded once, it stays recorded after an admin removes the rule
+// so the next start does not bring it back
+type CasbinSeededRule struct {
+	ID    uint   `gorm:"primaryKey;autoIncrement"`
+	Ptype string `gorm:"size:100;uniqueIndex:idx_casbin_seeded_rule"`
+	V0    string `gorm:"size:100;uniqueIndex:idx_casbin_seeded_rule"`
+	V1    string `gorm:"size:100;uniqueIndex:idx_casbin_seeded_rule"`
+	V2    string `gorm:"size:100;uniqueIndex:idx_casbin_seeded_rule"`
+	V3    string `gorm:"size:100;uniqueIndex:idx_casbin_seeded_rule"`
+	V4    string `gorm:"size:100;uniqueIndex:idx_casbin_seeded_rule"`
+	V5    string `gorm:"size:100;uniqueIndex:idx_casbin_seeded_rule"`
+}
+
+func (CasbinSeededRule) TableName() string {
+	return "casbin_seeded_rule"
+}
+
+type gormAdapter struct {
+	db *gorm.DB
+}
+
+func newGormAdapter(db *gorm.DB) (*gormAdapter, error) {
+	if err := db.AutoMigrate(&CasbinRule{}, &CasbinSeededRule{}); err != nil {
+		return nil, err
+	}
+	return &gormAdapter{db: db}, nil
+}
+
+func newCasbinRule(ptype string, rule []string) CasbinRule {
+	line := CasbinRule{Ptype: ptype}
+	fields := []*string{&line.V0, &line.V1, &line.V2, &line.V3, &line.V4, &line.V5}
+	for i, v := range rule {
+		if i >= len(fields) {
+			break
+		}
+		*fields[i] = v
+	}
+	return line
+}
+
+func (r CasbinRule) rule() []string {
+	rule := []string{r.V0, r.V1, r.V2, r.V3, r.V4, r.V5}
+	for len(rule) > 0 && rule[len(rule)-1] == "" {
+		rule = rule[:len(rule)-1]
+	}
+	return rule
+}
+
+// ruleKey identifies a rule by its type and fields, empty trailing fields left out
+func ruleKey(ptype string, rule []string) string {
+	return ptype + ", " + strings.Join(newCasbinRule(ptype, rule).rule(), ", ")
+}
+
+// seededRules returns the keys of the csv rules seeded before
+func (a *gormAdapter) seededRules() (map[string]bool, error) {
+	var lines []CasbinSeededRule
+	if err := a.db.Order("id").Find(&lines).Error; err != nil {
+		return nil, err
+	}
+	seeded := make(map[string]bool, len(lines))
+	for _, line := range lines {
+		rule := CasbinRule{V0: line.V0, V1: line.V1, V2: line.V2, V3: line.V3, V4: line.V4, V5: line.V5}.rule()
+		seeded[ruleKey(line.Ptype, rule)] = true
+	}
+	return seeded, nil
+}
+
+// recordSeeded marks rules as seeded, another instance may be recording the same ones at startup
+func (a *gormAdapter) recordSeeded(ptype string, rules [][]string) error {
+	if len(rules) == 0 {
+		return nil
+	}
+	lines := make([]CasbinSeededRule, 0, len(rules))
+	for _, rule := range rules {
+		line := newCasbinRule(ptype, rule)
+		lines = append(lines, CasbinSeededRule{Ptype: line.Ptype, V0: line.V0, V1: line.V1, V2: line.V2, V3: line.V3, V4: line.V4, V5: line.V5})
+	}
+	return a.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&lines).Error
+}
+
+// ruleQuery matches a rule exactly, empty trailing fields included
+func (a *gormAdapter) ruleQuery(tx *gorm.DB, line CasbinRule) *gorm.DB {
+	return tx.Model(&CasbinRule{}).Where("ptype = ? AND v0 = ? AND v1 = ? AND v2 = ? AND v3 = ? AND v4 = ? AND v5 = ?",
+		line.Ptype, line.V0, line.V1, line.V2, line.V3, line.V4, line.V5)
+}
+
+func (a *gormAdapter) LoadPolicy(m model.Model) error {
+	var lines []CasbinRule
+	if err := a.db.Order("id").Find(&lines).Error; err != nil {
+		return err
+	}
+	for _, line := range lines {
+		if err := persist.LoadPolicyArray(append([]string{line.Ptype}, line.rule()...), m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *gormAdapter) SavePolicy(m model.Model) error {
+	var lines []CasbinRule
+	for _, sec := range []string{"p", "g"} {
+		for ptype, ast := range m[sec] {
+			for _, rule := range ast.Policy {
+				lines = append(lines, newCasbinRule(ptype, rule))
+			}
+		}
+	}
+
+	return a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&CasbinRule{}).Error; err != nil {
+			return err
+		}
+		if len(lines) == 0 {
+			return nil
+		}
+		return tx.Create(&lines).Error
+	})
+}
+
+func (a *gormAdapter) AddPolicy(sec string, ptype string, rule []string) error {
+	line := newCasbinRule(ptype, rule)
+	return a.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&line).Error
+}
+
+func (a *gormAdapter) AddPolicies(sec string, ptype string, rules [][]string) error {
+	lines := make([]CasbinRule, 0, len(rules))
+	for _, rule := range rules {
+		lines = append(lines, newCasbinRule(ptype, rule))
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	// Another instance may be seeding the same rules at startup
+	return a.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&lines).Error
+}
+
+func (a *gormAdapter) RemovePolicy(sec string, ptype string, rule []string) error {
+	return a.ruleQuery(a.db, newCasbinRule(ptype, rule)).Delete(&CasbinRule{}).Error
+}
+
+func (a *gormAdapter) RemovePolicies(sec string, ptype string, rules [][]string) error {
+	return a.db.Transaction(func(tx *gorm.DB) error {
+		for _, rule := range rules {
+			if err := a.ruleQuery(tx, newCasbinRule(ptype, rule)).Delete(&CasbinRule{}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// filterQuery matches the rules whose fields from fieldIndex on equal fieldValues, empty values match anything
+func (a *gormAdapter) filterQuery(tx *gorm.DB, ptype string, fieldIndex int, fieldValues ...string) (*gorm.DB, error) {
+	if fieldIndex < 0 || fieldIndex+len(fieldValues) > 6 {
+		return nil, errors.New("invalid policy filter")
+	}
+	tx = tx.Model(&CasbinRule{}).Where("ptype = ?", ptype)
+	for i, v := range fieldValues {
+		if v == "" {
+			continue
+		}
+		tx = tx.Where(fmt.Sprintf("v%d = ?", fieldIndex+i), v)
+	}
+	return tx, nil
+}
+
+func (a *gormAdapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	tx, err := a.filterQuery(a.db, ptype, fieldIndex, fieldValues...)
+	if err != nil {
+		return err
+	}
+	return tx.Delete(&CasbinRule{}).Error
+}
+
+func (a *gormAdapter) UpdatePolicy(sec string, ptype string, oldRule, newRule []string) error {
+	return a.UpdatePolicies(sec, ptype, [][]string{oldRule}, [][]string{newRule})
+}
+
+func (a *gormAdapter) UpdatePolicies(sec string, ptype string, oldRules, newRules [][]string) error {
+	if len(oldRules) != len(newRules) {
+		return errors.New("old and new rules must have the same length")
+	}
+	return a.db.Transaction(func(tx *gorm.DB) error {
+		for i := range oldRules {
+			updated := newCasbinRule(ptype, newRules[i])
+			result := a.ruleQuery(tx, newCasbinRule(ptype, oldRules[i])).Updates(map[string]interface{}{
+				"v0": updated.V0, "v1": updated.V1, "v2": updated.V2,
+				"v3": updated.V3, "v4": updated.V4, "v5": updated.V5,
+			})
+			if result.Error != nil {
+				return result.Error
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateFilteredPolicies replaces every rule matching the filter with newRules and returns the replaced rules
+func (a *gormAdapter) UpdateFilteredPolicies(sec string, ptype string, newRules [][]string, fieldIndex int, fieldValues ...string) ([][]string, error) {
+	var oldLines []CasbinRule
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		query, err := a.filterQuery(tx, ptype, fieldIndex, fieldValues...)
+		if err != nil {
+			return err
+		}
+		if err := query.Order("id").Find(&oldLines).Error; err != nil {
+			return err
+		}
+		if len(oldLines) > 0 {
+			ids := make([]uint, len(oldLines))
+			for i, line := range oldLines {
+				ids[i] = line.ID
+			}
+			if err := tx.Where("id IN ?", ids).Delete(&CasbinRule{}).Error; err != nil {
+				return err
+			}
+		}
+
+		lines := make([]CasbinRule, 0, len(newRules))
+		for _, rule := range newRules {
+			lines = append(lines, newCasbinRule(ptype, rule))
+		}
+		if len(lines) == 0 {
+			return nil
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&lines).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	oldRules := make([][]string, len(oldLines))
+	for i, line := range oldLines {
+		oldRules[i] = line.rule()
+	}
+	return oldRules, nil
+}
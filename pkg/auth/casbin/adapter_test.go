@@ -0,0 +1,258 @@
+package casbin
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/ryvasa/go-super-farmer/pkg/database"
+	"github.com/stretchr/testify/assert"
+)
+
+var casbinRuleColumns = []string{"id", "ptype", "v0", "v1", "v2", "v3", "v4", "v5"}
+
+func adapterSetup(t *testing.T) (*database.MockDB, *gormAdapter) {
+	mockDB := database.NewMockDB(t)
+	return mockDB, &gormAdapter{db: mockDB.DB}
+}
+
+func TestGormAdapter_LoadPolicy(t *testing.T) {
+	mockDB, adapter := adapterSetup(t)
+	defer mockDB.SqlDB.Close()
+
+	t.Run("should load every rule into the model", func(t *testing.T) {
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "casbin_rule" ORDER BY id`)).
+			WillReturnRows(sqlmock.NewRows(casbinRuleColumns).
+				AddRow(1, "p", "Farmer", "/api/lands*", "GET", "", "", "").
+				AddRow(2, "g", "alice", "Farmer", "", "", "", ""))
+
+		m, err := model.NewModelFromFile("model.conf")
+		assert.NoError(t, err)
+
+		err = adapter.LoadPolicy(m)
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"Farmer", "/api/lands*", "GET"}}, m["p"]["p"].Policy)
+		assert.Equal(t, [][]string{{"alice", "Farmer"}}, m["g"]["g"].Policy)
+		assert.NoError(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestGormAdapter_AddPolicy(t *testing.T) {
+	mockDB, adapter := adapterSetup(t)
+	defer mockDB.SqlDB.Close()
+
+	t.Run("should insert the rule and ignore duplicates", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "casbin_rule" ("ptype","v0","v1","v2","v3","v4","v5") VALUES ($1,$2,$3,$4,$5,$6,$7) ON CONFLICT DO NOTHING RETURNING "id"`)).
+			WithArgs("p", "Farmer", "/api/lands*", "GET", "", "", "").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mockDB.Mock.ExpectCommit()
+
+		err := adapter.AddPolicy("p", "p", []string{"Farmer", "/api/lands*", "GET"})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should insert several rules in one statement", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "casbin_rule" ("ptype","v0","v1","v2","v3","v4","v5") VALUES ($1,$2,$3,$4,$5,$6,$7),($8,$9,$10,$11,$12,$13,$14) ON CONFLICT DO NOTHING RETURNING "id"`)).
+			WithArgs("p", "Farmer", "/api/lands*", "GET", "", "", "", "p", "Buyer", "/api/sales", "POST", "", "", "").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+		mockDB.Mock.ExpectCommit()
+
+		err := adapter.AddPolicies("p", "p", [][]string{{"Farmer", "/api/lands*", "GET"}, {"Buyer", "/api/sales", "POST"}})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should do nothing without rules", func(t *testing.T) {
+		err := adapter.AddPolicies("p", "p", nil)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestGormAdapter_RemovePolicy(t *testing.T) {
+	mockDB, adapter := adapterSetup(t)
+	defer mockDB.SqlDB.Close()
+
+	t.Run("should delete the exact rule", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "casbin_rule" WHERE ptype = $1 AND v0 = $2 AND v1 = $3 AND v2 = $4 AND v3 = $5 AND v4 = $6 AND v5 = $7`)).
+			WithArgs("p", "Farmer", "/api/lands*", "GET", "", "", "").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mockDB.Mock.ExpectCommit()
+
+		err := adapter.RemovePolicy("p", "p", []string{"Farmer", "/api/lands*", "GET"})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should delete rules matching the filter", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "casbin_rule" WHERE ptype = $1 AND v0 = $2 AND v2 = $3`)).
+			WithArgs("p", "Farmer", "GET").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mockDB.Mock.ExpectCommit()
+
+		err := adapter.RemoveFilteredPolicy("p", "p", 0, "Farmer", "", "GET")
+
+		assert.NoError(t, err)
+		assert.NoError(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when filter is out of range", func(t *testing.T) {
+		err := adapter.RemoveFilteredPolicy("p", "p", 5, "a", "b")
+
+		assert.EqualError(t, err, "invalid policy filter")
+	})
+}
+
+func TestGormAdapter_UpdatePolicy(t *testing.T) {
+	mockDB, adapter := adapterSetup(t)
+	defer mockDB.SqlDB.Close()
+
+	t.Run("should replace the old rule", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(`UPDATE "casbin_rule" SET "v0"=$1,"v1"=$2,"v2"=$3,"v3"=$4,"v4"=$5,"v5"=$6 WHERE ptype = $7 AND v0 = $8 AND v1 = $9 AND v2 = $10 AND v3 = $11 AND v4 = $12 AND v5 = $13`)).
+			WithArgs("Farmer", "/api/lands*", "*", "", "", "", "p", "Farmer", "/api/lands*", "GET", "", "", "").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mockDB.Mock.ExpectCommit()
+
+		err := adapter.UpdatePolicy("p", "p", []string{"Farmer", "/api/lands*", "GET"}, []string{"Farmer", "/api/lands*", "*"})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when rule counts differ", func(t *testing.T) {
+		err := adapter.UpdatePolicies("p", "p", [][]string{{"a"}}, nil)
+
+		assert.EqualError(t, err, "old and new rules must have the same length")
+	})
+}
+
+func TestGormAdapter_UpdateFilteredPolicies(t *testing.T) {
+	mockDB, adapter := adapterSetup(t)
+	defer mockDB.SqlDB.Close()
+
+	t.Run("should replace matching rules and return them", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "casbin_rule" WHERE ptype = $1 AND v0 = $2 ORDER BY id`)).
+			WithArgs("p", "Buyer").
+			WillReturnRows(sqlmock.NewRows(casbinRuleColumns).
+				AddRow(3, "p", "Buyer", "/api/sales", "POST", "", "", "").
+				AddRow(4, "p", "Buyer", "/api/buyer/sales", "GET", "", "", ""))
+		mockDB.Mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "casbin_rule" WHERE id IN ($1,$2)`)).
+			WithArgs(3, 4).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "casbin_rule" ("ptype","v0","v1","v2","v3","v4","v5") VALUES ($1,$2,$3,$4,$5,$6,$7) ON CONFLICT DO NOTHING RETURNING "id"`)).
+			WithArgs("p", "Buyer", "/api/sales*", "*", "", "", "").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+		mockDB.Mock.ExpectCommit()
+
+		oldRules, err := adapter.UpdateFilteredPolicies("p", "p", [][]string{{"Buyer", "/api/sales*", "*"}}, 0, "Buyer")
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"Buyer", "/api/sales", "POST"}, {"Buyer", "/api/buyer/sales", "GET"}}, oldRules)
+		assert.NoError(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when filter is out of range", func(t *testing.T) {
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectRollback()
+
+		oldRules, err := adapter.UpdateFilteredPolicies("p", "p", nil, -1)
+
+		assert.Nil(t, oldRules)
+		assert.EqualError(t, err, "invalid policy filter")
+		assert.NoError(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
+
+func TestSeed(t *testing.T) {
+	mockDB, adapter := adapterSetup(t)
+	defer mockDB.SqlDB.Close()
+
+	policyPath := filepath.Join(t.TempDir(), "policy.csv")
+	err := os.WriteFile(policyPath, []byte("p, Farmer, /api/lands*, GET\np, Buyer, /api/sales, POST\n"), 0o600)
+	assert.NoError(t, err)
+
+	t.Run("should add csv rules that were never seeded", func(t *testing.T) {
+		// The table was seeded before the Buyer rule was added to the csv
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "casbin_rule" ORDER BY id`)).
+			WillReturnRows(sqlmock.NewRows(casbinRuleColumns).
+				AddRow(1, "p", "Farmer", "/api/lands*", "GET", "", "", ""))
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "casbin_seeded_rule" ORDER BY id`)).
+			WillReturnRows(sqlmock.NewRows(casbinRuleColumns).
+				AddRow(1, "p", "Farmer", "/api/lands*", "GET", "", "", ""))
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "casbin_rule" ("ptype","v0","v1","v2","v3","v4","v5") VALUES ($1,$2,$3,$4,$5,$6,$7) ON CONFLICT DO NOTHING RETURNING "id"`)).
+			WithArgs("p", "Buyer", "/api/sales", "POST", "", "", "").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mockDB.Mock.ExpectCommit()
+		mockDB.Mock.ExpectBegin()
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "casbin_seeded_rule" ("ptype","v0","v1","v2","v3","v4","v5") VALUES ($1,$2,$3,$4,$5,$6,$7) ON CONFLICT DO NOTHING RETURNING "id"`)).
+			WithArgs("p", "Buyer", "/api/sales", "POST", "", "", "").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mockDB.Mock.ExpectCommit()
+
+		e, err := casbin.NewSyncedEnforcer("model.conf", adapter)
+		assert.NoError(t, err)
+		allowed, err := e.Enforce("Buyer", "/api/sales", "POST")
+		assert.NoError(t, err)
+		assert.False(t, allowed)
+
+		err = seed(e, adapter, "model.conf", policyPath)
+
+		assert.NoError(t, err)
+		allowed, err = e.Enforce("Buyer", "/api/sales", "POST")
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+		policies, err := e.GetPolicy()
+		assert.NoError(t, err)
+		assert.Len(t, policies, 2)
+		assert.NoError(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should not bring back a seeded rule an admin removed", func(t *testing.T) {
+		// The Farmer rule was removed through /api/policies after it was seeded
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "casbin_rule" ORDER BY id`)).
+			WillReturnRows(sqlmock.NewRows(casbinRuleColumns).
+				AddRow(2, "p", "Buyer", "/api/sales", "POST", "", "", ""))
+		mockDB.Mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "casbin_seeded_rule" ORDER BY id`)).
+			WillReturnRows(sqlmock.NewRows(casbinRuleColumns).
+				AddRow(1, "p", "Farmer", "/api/lands*", "GET", "", "", "").
+				AddRow(2, "p", "Buyer", "/api/sales", "POST", "", "", ""))
+
+		e, err := casbin.NewSyncedEnforcer("model.conf", adapter)
+		assert.NoError(t, err)
+
+		err = seed(e, adapter, "model.conf", policyPath)
+
+		assert.NoError(t, err)
+		allowed, err := e.Enforce("Farmer", "/api/lands", "GET")
+		assert.NoError(t, err)
+		assert.False(t, allowed)
+		assert.NoError(t, mockDB.Mock.ExpectationsWereMet())
+	})
+
+	t.Run("should skip seeding without a policy file", func(t *testing.T) {
+		e, err := casbin.NewSyncedEnforcer("model.conf")
+		assert.NoError(t, err)
+
+		err = seed(e, adapter, "model.conf", "")
+
+		assert.NoError(t, err)
+		assert.NoError(t, mockDB.Mock.ExpectationsWereMet())
+	})
+}
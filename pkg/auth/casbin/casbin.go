@@ -1,15 +1,146 @@
 package casbin
 
-import "github.com/casbin/casbin/v2"
+import (
+	"github.com/casbin/casbin/v2"
+	"github.com/ryvasa/go-super-farmer/pkg/env"
+	"github.com/ryvasa/go-super-farmer/pkg/logrus"
+	"github.com/ryvasa/go-super-farmer/pkg/messages"
+	"gorm.io/gorm"
+)
 
-type Casbin struct {
-	enforcer *casbin.Enforcer
+type Casbin interface {
+	Enforce(sub, obj, act string) (bool, error)
+	GetPolicies(sub string) ([][]string, error)
+	HasPolicy(sub, obj, act string) (bool, error)
+	AddPolicy(sub, obj, act string) (bool, error)
+	UpdatePolicy(oldPolicy, newPolicy []string) (bool, error)
+	RemovePolicy(sub, obj, act string) (bool, error)
 }
 
-func Init(modelPath string, policyPath string) (*casbin.Enforcer, error) {
-	e, err := casbin.NewEnforcer(modelPath, policyPath)
+type CasbinImpl struct {
+	enforcer *casbin.SyncedEnforcer
+}
+
+// NewCasbin loads policies from postgres and keeps them in sync with the other instances through rabbitmq,
+// the rules of the policy csv that were never seeded are added to the table on start
+func NewCasbin(env *env.Env, db *gorm.DB, rabbitMQ messages.RabbitMQ) (Casbin, error) {
+	adapter, err := newGormAdapter(db)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := casbin.NewSyncedEnforcer(env.Casbin.ModelPath, adapter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := seed(e, adapter, env.Casbin.ModelPath, env.Casbin.PolicyPath); err != nil {
+		return nil, err
+	}
+
+	watcher, err := newRabbitWatcher(rabbitMQ)
 	if err != nil {
 		return nil, err
 	}
-	return e, nil
+	if err := e.SetWatcher(watcher); err != nil {
+		return nil, err
+	}
+	// SetWatcher registers the unsynchronized reload, replace it with the locked one
+	if err := watcher.SetUpdateCallback(reloadPolicies(e)); err != nil {
+		return nil, err
+	}
+
+	return &CasbinImpl{enforcer: e}, nil
+}
+
+// reloadPolicies reloads the policies of e when another instance changed them
+func reloadPolicies(e *casbin.SyncedEnforcer) func(string) {
+	return func(instanceID string) {
+		if err := e.LoadPolicy(); err != nil {
+			logrus.Log.Error("failed to reload policies: ", err)
+			return
+		}
+		logrus.Log.Info("policies reloaded after update from ", instanceID)
+	}
+}
+
+// seed adds the rules of the policy csv that were never seeded, so rules added to the file reach existing
+// databases while a seeded rule an admin removed through /api/policies stays removed
+func seed(e *casbin.SyncedEnforcer, a *gormAdapter, modelPath, policyPath string) error {
+	if policyPath == "" {
+		return nil
+	}
+
+	file, err := casbin.NewEnforcer(modelPath, policyPath)
+	if err != nil {
+		return err
+	}
+	seeded, err := a.seededRules()
+	if err != nil {
+		return err
+	}
+
+	policies, err := file.GetPolicy()
+	if err != nil {
+		return err
+	}
+	if policies = unseededRules(seeded, "p", policies); len(policies) > 0 {
+		if _, err := e.AddPoliciesEx(policies); err != nil {
+			return err
+		}
+		if err := a.recordSeeded("p", policies); err != nil {
+			return err
+		}
+	}
+	groupings, err := file.GetGroupingPolicy()
+	if err != nil {
+		return err
+	}
+	if groupings = unseededRules(seeded, "g", groupings); len(groupings) > 0 {
+		if _, err := e.AddGroupingPoliciesEx(groupings); err != nil {
+			return err
+		}
+		if err := a.recordSeeded("g", groupings); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unseededRules(seeded map[string]bool, ptype string, rules [][]string) [][]string {
+	var unseeded [][]string
+	for _, rule := range rules {
+		if !seeded[ruleKey(ptype, rule)] {
+			unseeded = append(unseeded, rule)
+		}
+	}
+	return unseeded
+}
+
+func (c *CasbinImpl) Enforce(sub, obj, act string) (bool, error) {
+	return c.enforcer.Enforce(sub, obj, act)
+}
+
+// GetPolicies returns every policy, or only the ones of sub when it is not empty
+func (c *CasbinImpl) GetPolicies(sub string) ([][]string, error) {
+	if sub == "" {
+		return c.enforcer.GetPolicy()
+	}
+	return c.enforcer.GetFilteredPolicy(0, sub)
+}
+
+func (c *CasbinImpl) HasPolicy(sub, obj, act string) (bool, error) {
+	return c.enforcer.HasPolicy(sub, obj, act)
+}
+
+func (c *CasbinImpl) AddPolicy(sub, obj, act string) (bool, error) {
+	return c.enforcer.AddPolicy(sub, obj, act)
+}
+
+func (c *CasbinImpl) UpdatePolicy(oldPolicy, newPolicy []string) (bool, error) {
+	return c.enforcer.UpdatePolicy(oldPolicy, newPolicy)
+}
+
+func (c *CasbinImpl) RemovePolicy(sub, obj, act string) (bool, error) {
+	return c.enforcer.RemovePolicy(sub, obj, act)
 }
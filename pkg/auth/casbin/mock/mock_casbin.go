@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/auth/casbin/casbin.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCasbin is a mock of Casbin interface.
+type MockCasbin struct {
+	ctrl     *gomock.Controller
+	recorder *MockCasbinMockRecorder
+}
+
+// MockCasbinMockRecorder is the mock recorder for MockCasbin.
+type MockCasbinMockRecorder struct {
+	mock *MockCasbin
+}
+
+// NewMockCasbin creates a new mock instance.
+func NewMockCasbin(ctrl *gomock.Controller) *MockCasbin {
+	mock := &MockCasbin{ctrl: ctrl}
+	mock.recorder = &MockCasbinMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCasbin) EXPECT() *MockCasbinMockRecorder {
+	return m.recorder
+}
+
+// AddPolicy mocks base method.
+func (m *MockCasbin) AddPolicy(sub, obj, act string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPolicy", sub, obj, act)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPolicy indicates an expected call of AddPolicy.
+func (mr *MockCasbinMockRecorder) AddPolicy(sub, obj, act interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPolicy", reflect.TypeOf((*MockCasbin)(nil).AddPolicy), sub, obj, act)
+}
+
+// Enforce mocks base method.
+func (m *MockCasbin) Enforce(sub, obj, act string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enforce", sub, obj, act)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enforce indicates an expected call of Enforce.
+func (mr *MockCasbinMockRecorder) Enforce(sub, obj, act interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enforce", reflect.TypeOf((*MockCasbin)(nil).Enforce), sub, obj, act)
+}
+
+// GetPolicies mocks base method.
+func (m *MockCasbin) GetPolicies(sub string) ([][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicies", sub)
+	ret0, _ := ret[0].([][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicies indicates an expected call of GetPolicies.
+func (mr *MockCasbinMockRecorder) GetPolicies(sub interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicies", reflect.TypeOf((*MockCasbin)(nil).GetPolicies), sub)
+}
+
+// HasPolicy mocks base method.
+func (m *MockCasbin) HasPolicy(sub, obj, act string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPolicy", sub, obj, act)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPolicy indicates an expected call of HasPolicy.
+func (mr *MockCasbinMockRecorder) HasPolicy(sub, obj, act interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPolicy", reflect.TypeOf((*MockCasbin)(nil).HasPolicy), sub, obj, act)
+}
+
+// RemovePolicy mocks base method.
+func (m *MockCasbin) RemovePolicy(sub, obj, act string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePolicy", sub, obj, act)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePolicy indicates an expected call of RemovePolicy.
+func (mr *MockCasbinMockRecorder) RemovePolicy(sub, obj, act interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePolicy", reflect.TypeOf((*MockCasbin)(nil).RemovePolicy), sub, obj, act)
+}
+
+// UpdatePolicy mocks base method.
+func (m *MockCasbin) UpdatePolicy(oldPolicy, newPolicy []string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePolicy", oldPolicy, newPolicy)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePolicy indicates an expected call of UpdatePolicy.
+func (mr *MockCasbinMockRecorder) UpdatePolicy(oldPolicy, newPolicy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicy", reflect.TypeOf((*MockCasbin)(nil).UpdatePolicy), oldPolicy, newPolicy)
+}
@@ -0,0 +1,76 @@
+package casbin
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/pkg/logrus"
+	"github.com/ryvasa/go-super-farmer/pkg/messages"
+)
+
+const policyExchange = "policy-exchange"
+
+type policyUpdateMessage struct {
+	InstanceID string `json:"instance_id"`
+}
+
+// rabbitWatcher tells every other instance to reload its policies after a change
+type rabbitWatcher struct {
+	rabbitMQ   messages.RabbitMQ
+	instanceID string
+	mu         sync.RWMutex
+	callback   func(string)
+}
+
+func newRabbitWatcher(rabbitMQ messages.RabbitMQ) (*rabbitWatcher, error) {
+	w := &rabbitWatcher{
+		rabbitMQ:   rabbitMQ,
+		instanceID: uuid.New().String(),
+	}
+
+	msgs, err := rabbitMQ.SubscribeFanout(policyExchange)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for msg := range msgs {
+			var update policyUpdateMessage
+			if err := json.Unmarshal(msg.Body, &update); err != nil {
+				logrus.Log.Error("failed to decode policy update: ", err)
+				continue
+			}
+			// Our own change is already applied
+			if update.InstanceID == w.instanceID {
+				continue
+			}
+
+			w.mu.RLock()
+			callback := w.callback
+			w.mu.RUnlock()
+			if callback != nil {
+				callback(update.InstanceID)
+			}
+		}
+	}()
+
+	return w, nil
+}
+
+func (w *rabbitWatcher) SetUpdateCallback(callback func(string)) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.callback = callback
+	return nil
+}
+
+func (w *rabbitWatcher) Update() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return w.rabbitMQ.PublishJSON(ctx, policyExchange, "", policyUpdateMessage{InstanceID: w.instanceID})
+}
+
+func (w *rabbitWatcher) Close() {}
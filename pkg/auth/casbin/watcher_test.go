@@ -0,0 +1,110 @@
+package casbin
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/rabbitmq/amqp091-go"
+	mock_pkg "github.com/ryvasa/go-super-farmer/pkg/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func watcherSetup(t *testing.T) (*rabbitWatcher, *mock_pkg.MockRabbitMQ, chan amqp091.Delivery) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	rabbitMQ := mock_pkg.NewMockRabbitMQ(ctrl)
+
+	msgs := make(chan amqp091.Delivery)
+	t.Cleanup(func() { close(msgs) })
+	rabbitMQ.EXPECT().SubscribeFanout(policyExchange).Return((<-chan amqp091.Delivery)(msgs), nil).Times(1)
+
+	w, err := newRabbitWatcher(rabbitMQ)
+	assert.NoError(t, err)
+	return w, rabbitMQ, msgs
+}
+
+func updateDelivery(t *testing.T, instanceID string) amqp091.Delivery {
+	body, err := json.Marshal(policyUpdateMessage{InstanceID: instanceID})
+	assert.NoError(t, err)
+	return amqp091.Delivery{Body: body}
+}
+
+func TestRabbitWatcher_Update(t *testing.T) {
+	w, rabbitMQ, _ := watcherSetup(t)
+
+	t.Run("should publish the instance id", func(t *testing.T) {
+		rabbitMQ.EXPECT().PublishJSON(gomock.Any(), policyExchange, "", policyUpdateMessage{InstanceID: w.instanceID}).Return(nil).Times(1)
+
+		err := w.Update()
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when publish fails", func(t *testing.T) {
+		rabbitMQ.EXPECT().PublishJSON(gomock.Any(), policyExchange, "", gomock.Any()).Return(context.DeadlineExceeded).Times(1)
+
+		err := w.Update()
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestRabbitWatcher_Subscribe(t *testing.T) {
+	w, _, msgs := watcherSetup(t)
+
+	updates := make(chan string, 1)
+	err := w.SetUpdateCallback(func(instanceID string) {
+		updates <- instanceID
+	})
+	assert.NoError(t, err)
+
+	t.Run("should ignore its own and malformed updates", func(t *testing.T) {
+		msgs <- updateDelivery(t, w.instanceID)
+		msgs <- amqp091.Delivery{Body: []byte("not json")}
+		// The channel is unbuffered, so this send means the earlier ones were processed
+		msgs <- updateDelivery(t, "other")
+
+		assert.Equal(t, "other", <-updates)
+		assert.Empty(t, updates)
+	})
+}
+
+func TestReloadPolicies(t *testing.T) {
+	w, _, msgs := watcherSetup(t)
+
+	policyPath := filepath.Join(t.TempDir(), "policy.csv")
+	err := os.WriteFile(policyPath, []byte("p, Farmer, /api/lands*, GET\n"), 0o600)
+	assert.NoError(t, err)
+	e, err := casbin.NewSyncedEnforcer("model.conf", policyPath)
+	assert.NoError(t, err)
+
+	reloaded := make(chan struct{})
+	reload := reloadPolicies(e)
+	err = w.SetUpdateCallback(func(instanceID string) {
+		reload(instanceID)
+		close(reloaded)
+	})
+	assert.NoError(t, err)
+
+	t.Run("should reload policies changed by another instance", func(t *testing.T) {
+		err := os.WriteFile(policyPath, []byte("p, Farmer, /api/lands*, GET\np, Buyer, /api/sales, POST\n"), 0o600)
+		assert.NoError(t, err)
+
+		msgs <- updateDelivery(t, "other")
+
+		select {
+		case <-reloaded:
+		case <-time.After(time.Second):
+			t.Fatal("policies were not reloaded")
+		}
+		allowed, err := e.Enforce("Buyer", "/api/sales", "POST")
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+	})
+}
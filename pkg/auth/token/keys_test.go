@@ -0,0 +1,274 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/ryvasa/go-super-farmer/pkg/env"
+	"github.com/stretchr/testify/assert"
+)
+
+func ed25519PrivatePEM(t *testing.T) (ed25519.PrivateKey, []byte) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	assert.NoError(t, err)
+	return priv, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func publicPEM(t *testing.T, pub interface{}) []byte {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	assert.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func rsaPrivatePEM(t *testing.T) (*rsa.PrivateKey, []byte) {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	return priv, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(priv)})
+}
+
+func writeKey(t *testing.T, dir, name string, data []byte) {
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, name), data, 0o600))
+}
+
+func newKeyToken(t *testing.T, dir, algorithm, kid string) Token {
+	cfg := &env.Env{}
+	cfg.Secret.JwtAlgorithm = algorithm
+	cfg.Secret.JwtKeysPath = dir
+	cfg.Secret.JwtActiveKid = kid
+	tok, err := NewToken(cfg)
+	assert.NoError(t, err)
+	return tok
+}
+
+func TestParseKey(t *testing.T) {
+	t.Run("should parse ed25519 private key", func(t *testing.T) {
+		priv, data := ed25519PrivatePEM(t)
+
+		key, err := parseKey("ed", data)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ed", key.kid)
+		assert.Equal(t, jwt.SigningMethodEdDSA, key.method)
+		assert.NotNil(t, key.private)
+		assert.Equal(t, priv.Public(), key.public)
+	})
+
+	t.Run("should parse rsa private key", func(t *testing.T) {
+		priv, data := rsaPrivatePEM(t)
+
+		key, err := parseKey("rsa", data)
+
+		assert.NoError(t, err)
+		assert.Equal(t, jwt.SigningMethodRS256, key.method)
+		assert.NotNil(t, key.private)
+		assert.Equal(t, &priv.PublicKey, key.public)
+	})
+
+	t.Run("should parse public key without a signer", func(t *testing.T) {
+		priv, _ := ed25519PrivatePEM(t)
+
+		key, err := parseKey("old", publicPEM(t, priv.Public()))
+
+		assert.NoError(t, err)
+		assert.Equal(t, jwt.SigningMethodEdDSA, key.method)
+		assert.Nil(t, key.private)
+		assert.NotNil(t, key.public)
+	})
+
+	t.Run("should return error when data is not pem", func(t *testing.T) {
+		key, err := parseKey("bad", []byte("not a key"))
+
+		assert.Error(t, err)
+		assert.Nil(t, key)
+		assert.EqualError(t, err, "invalid pem data")
+	})
+
+	t.Run("should return error when pem type is unsupported", func(t *testing.T) {
+		data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("cert")})
+
+		key, err := parseKey("cert", data)
+
+		assert.Error(t, err)
+		assert.Nil(t, key)
+		assert.EqualError(t, err, "unsupported pem type CERTIFICATE")
+	})
+}
+
+func TestLoadKeys(t *testing.T) {
+	t.Run("should load every pem file by kid", func(t *testing.T) {
+		dir := t.TempDir()
+		priv, data := ed25519PrivatePEM(t)
+		writeKey(t, dir, "2024-02.pem", data)
+		writeKey(t, dir, "2024-01.pem", publicPEM(t, priv.Public()))
+		writeKey(t, dir, "README.txt", []byte("ignored"))
+
+		keys, err := loadKeys(dir)
+
+		assert.NoError(t, err)
+		assert.Len(t, keys, 2)
+		assert.NotNil(t, keys["2024-02"].private)
+		assert.Nil(t, keys["2024-01"].private)
+	})
+
+	t.Run("should return error when a key is invalid", func(t *testing.T) {
+		dir := t.TempDir()
+		writeKey(t, dir, "broken.pem", []byte("not a key"))
+
+		keys, err := loadKeys(dir)
+
+		assert.Error(t, err)
+		assert.Nil(t, keys)
+		assert.Contains(t, err.Error(), "failed to load key broken")
+	})
+}
+
+func TestToken_ValidateToken_KeyRotation(t *testing.T) {
+	userID := uuid.New()
+
+	oldDir := t.TempDir()
+	oldPriv, oldData := ed25519PrivatePEM(t)
+	writeKey(t, oldDir, "2024-01.pem", oldData)
+	oldToken, err := newKeyToken(t, oldDir, "EdDSA", "2024-01").GenerateToken(userID, "Farmer")
+	assert.NoError(t, err)
+
+	// After the rotation the old key is only kept as a public key
+	dir := t.TempDir()
+	_, newData := ed25519PrivatePEM(t)
+	writeKey(t, dir, "2024-02.pem", newData)
+	writeKey(t, dir, "2024-01.pem", publicPEM(t, oldPriv.Public()))
+	tok := newKeyToken(t, dir, "EdDSA", "2024-02")
+
+	t.Run("should sign new tokens with the active kid", func(t *testing.T) {
+		signed, err := tok.GenerateToken(userID, "Farmer")
+		assert.NoError(t, err)
+
+		parsed, err := tok.ValidateToken(signed)
+
+		assert.NoError(t, err)
+		assert.True(t, parsed.Valid)
+		assert.Equal(t, "2024-02", parsed.Header["kid"])
+	})
+
+	t.Run("should accept token signed with the old kid", func(t *testing.T) {
+		claims, err := tok.ExtractClaims(oldToken)
+
+		assert.NoError(t, err)
+		assert.Equal(t, userID.String(), claims["sub"])
+	})
+
+	t.Run("should keep millisecond precision in iat", func(t *testing.T) {
+		before := time.Now().Truncate(time.Millisecond)
+		signed, err := tok.GenerateToken(userID, "Farmer")
+		assert.NoError(t, err)
+
+		claims, err := tok.ExtractClaims(signed)
+		assert.NoError(t, err)
+
+		assert.False(t, issuedAt(claims).Before(before))
+	})
+
+	t.Run("should reject token with unknown kid", func(t *testing.T) {
+		_, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+		assert.NoError(t, err)
+		unknown := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{"sub": userID.String()})
+		unknown.Header["kid"] = "2023-12"
+		signed, err := unknown.SignedString(otherPriv)
+		assert.NoError(t, err)
+
+		parsed, err := tok.ValidateToken(signed)
+
+		assert.Error(t, err)
+		assert.False(t, parsed.Valid)
+		assert.Contains(t, err.Error(), "unknown kid: 2023-12")
+	})
+
+	t.Run("should reject token without kid", func(t *testing.T) {
+		noKid := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{"sub": userID.String()})
+		signed, err := noKid.SignedString(oldPriv)
+		assert.NoError(t, err)
+
+		_, err = tok.ValidateToken(signed)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "missing kid header")
+	})
+
+	t.Run("should reject hs256 token when signing with keys", func(t *testing.T) {
+		hs := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": userID.String()})
+		hs.Header["kid"] = "2024-01"
+		signed, err := hs.SignedString([]byte("secret"))
+		assert.NoError(t, err)
+
+		_, err = tok.ValidateToken(signed)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestToken_JWKS(t *testing.T) {
+	dir := t.TempDir()
+	edPriv, edData := ed25519PrivatePEM(t)
+	rsaPriv, rsaData := rsaPrivatePEM(t)
+	writeKey(t, dir, "b-ed.pem", edData)
+	writeKey(t, dir, "a-rsa.pem", publicPEM(t, &rsaPriv.PublicKey))
+	writeKey(t, dir, "c-rsa.pem", rsaData)
+	tok := newKeyToken(t, dir, "EdDSA", "b-ed")
+
+	t.Run("should publish every key sorted by kid", func(t *testing.T) {
+		jwks := tok.JWKS()
+
+		assert.Len(t, jwks.Keys, 3)
+		assert.Equal(t, "a-rsa", jwks.Keys[0].Kid)
+		assert.Equal(t, "b-ed", jwks.Keys[1].Kid)
+		assert.Equal(t, "c-rsa", jwks.Keys[2].Kid)
+	})
+
+	t.Run("should describe rsa keys with modulus and exponent", func(t *testing.T) {
+		key := tok.JWKS().Keys[0]
+
+		assert.Equal(t, "RSA", key.Kty)
+		assert.Equal(t, "RS256", key.Alg)
+		assert.Equal(t, "sig", key.Use)
+		assert.Equal(t, "AQAB", key.E)
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		assert.NoError(t, err)
+		assert.Equal(t, rsaPriv.N.Bytes(), n)
+		assert.Empty(t, key.Crv)
+		assert.Empty(t, key.X)
+	})
+
+	t.Run("should describe ed25519 keys with curve and x", func(t *testing.T) {
+		key := tok.JWKS().Keys[1]
+
+		assert.Equal(t, "OKP", key.Kty)
+		assert.Equal(t, "EdDSA", key.Alg)
+		assert.Equal(t, "sig", key.Use)
+		assert.Equal(t, "Ed25519", key.Crv)
+		x, err := base64.RawURLEncoding.DecodeString(key.X)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(edPriv.Public().(ed25519.PublicKey)), x)
+		assert.Empty(t, key.N)
+		assert.Empty(t, key.E)
+	})
+
+	t.Run("should publish no keys when signing with hs256", func(t *testing.T) {
+		cfg := &env.Env{}
+		cfg.Secret.JwtSecretKey = "secret"
+		hs, err := NewToken(cfg)
+		assert.NoError(t, err)
+
+		assert.Empty(t, hs.JWKS().Keys)
+	})
+}
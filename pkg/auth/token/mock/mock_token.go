@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: utils/token.go
+// Source: pkg/auth/token/token.go
 
 // Package mock is a generated GoMock package.
 package mock
@@ -10,6 +10,7 @@ import (
 	jwt "github.com/golang-jwt/jwt/v5"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
+	token "github.com/ryvasa/go-super-farmer/pkg/auth/token"
 )
 
 // MockToken is a mock of Token interface.
@@ -50,6 +51,21 @@ func (mr *MockTokenMockRecorder) ExtractClaims(tokenString interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractClaims", reflect.TypeOf((*MockToken)(nil).ExtractClaims), tokenString)
 }
 
+// GenerateRefreshToken mocks base method.
+func (m *MockToken) GenerateRefreshToken() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateRefreshToken")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateRefreshToken indicates an expected call of GenerateRefreshToken.
+func (mr *MockTokenMockRecorder) GenerateRefreshToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRefreshToken", reflect.TypeOf((*MockToken)(nil).GenerateRefreshToken))
+}
+
 // GenerateToken mocks base method.
 func (m *MockToken) GenerateToken(id uuid.UUID, role string) (string, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +81,20 @@ func (mr *MockTokenMockRecorder) GenerateToken(id, role interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockToken)(nil).GenerateToken), id, role)
 }
 
+// JWKS mocks base method.
+func (m *MockToken) JWKS() *token.JWKS {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS")
+	ret0, _ := ret[0].(*token.JWKS)
+	return ret0
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockTokenMockRecorder) JWKS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockToken)(nil).JWKS))
+}
+
 // ValidateToken mocks base method.
 func (m *MockToken) ValidateToken(tokenString string) (*jwt.Token, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,90 @@
+package token
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	mock_pkg "github.com/ryvasa/go-super-farmer/pkg/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsUserRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_pkg.NewMockCache(ctrl)
+	ctx := context.TODO()
+
+	revokedAt := time.Now()
+	stored := []byte(strconv.FormatInt(revokedAt.UnixMilli(), 10))
+
+	t.Run("should reject credential issued before the revocation", func(t *testing.T) {
+		c.EXPECT().Get(ctx, RevokedUserKey("user")).Return(stored, nil).Times(1)
+
+		revoked, err := IsUserRevoked(ctx, c, "user", revokedAt.Add(-time.Millisecond))
+
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+	})
+
+	t.Run("should accept credential issued in a later millisecond", func(t *testing.T) {
+		c.EXPECT().Get(ctx, RevokedUserKey("user")).Return(stored, nil).Times(1)
+
+		revoked, err := IsUserRevoked(ctx, c, "user", revokedAt.Add(time.Millisecond))
+
+		assert.NoError(t, err)
+		assert.False(t, revoked)
+	})
+
+	t.Run("should accept credential when user was never revoked", func(t *testing.T) {
+		c.EXPECT().Get(ctx, RevokedUserKey("user")).Return(nil, nil).Times(1)
+
+		revoked, err := IsUserRevoked(ctx, c, "user", revokedAt)
+
+		assert.NoError(t, err)
+		assert.False(t, revoked)
+	})
+}
+
+func TestIsRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_pkg.NewMockCache(ctrl)
+	ctx := context.TODO()
+
+	t.Run("should reject blacklisted jti", func(t *testing.T) {
+		c.EXPECT().Get(ctx, RevokedTokenKey("jti")).Return([]byte("1"), nil).Times(1)
+
+		revoked, err := IsRevoked(ctx, c, jwt.MapClaims{"jti": "jti", "sub": "user"})
+
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+	})
+
+	t.Run("should compare iat against the user revocation", func(t *testing.T) {
+		issuedAt := time.Now()
+		stored := []byte(strconv.FormatInt(issuedAt.Add(-time.Second).UnixMilli(), 10))
+		c.EXPECT().Get(ctx, RevokedTokenKey("jti")).Return(nil, nil).Times(1)
+		c.EXPECT().Get(ctx, RevokedUserKey("user")).Return(stored, nil).Times(1)
+
+		revoked, err := IsRevoked(ctx, c, jwt.MapClaims{"jti": "jti", "sub": "user", "iat": float64(issuedAt.Unix())})
+
+		assert.NoError(t, err)
+		assert.False(t, revoked)
+	})
+
+	t.Run("should not revoke a token issued the millisecond after the user revocation", func(t *testing.T) {
+		// 1700000000.29 is decoded just short of .290, jwt would truncate it to .289
+		stored := []byte("1700000000289")
+		c.EXPECT().Get(ctx, RevokedTokenKey("jti")).Return(nil, nil).Times(1)
+		c.EXPECT().Get(ctx, RevokedUserKey("user")).Return(stored, nil).Times(1)
+
+		revoked, err := IsRevoked(ctx, c, jwt.MapClaims{"jti": "jti", "sub": "user", "iat": 1700000000.29})
+
+		assert.NoError(t, err)
+		assert.False(t, revoked)
+	})
+}
@@ -1,7 +1,10 @@
 package token
 
 import (
+	"crypto/rand"
+	"encoding/base64"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -10,41 +13,112 @@ import (
 	"github.com/ryvasa/go-super-farmer/utils"
 )
 
+const (
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 7 * 24 * time.Hour
+
+	// MFAEnrollmentRole is the casbin subject of a session that still has to enroll two-factor authentication
+	MFAEnrollmentRole = "MFAEnrollment"
+)
+
+// Revocation compares issue times in milliseconds, so iat is written with the same precision
+func init() {
+	jwt.TimePrecision = time.Millisecond
+}
+
 type Token interface {
 	GenerateToken(id uuid.UUID, role string) (string, error)
+	GenerateRefreshToken() (string, error)
 	ValidateToken(tokenString string) (*jwt.Token, error)
 	ExtractClaims(tokenString string) (jwt.MapClaims, error)
+	JWKS() *JWKS
 }
 
 type TokenImpl struct {
-	env *env.Env
+	env    *env.Env
+	active *signingKey
+	keys   map[string]*signingKey
 }
 
-func NewToken(cfg *env.Env) Token {
-	return &TokenImpl{
+func NewToken(cfg *env.Env) (Token, error) {
+	t := &TokenImpl{
 		env: cfg,
 	}
+
+	algorithm := cfg.Secret.JwtAlgorithm
+	if algorithm == "" || algorithm == jwt.SigningMethodHS256.Alg() {
+		return t, nil
+	}
+
+	keys, err := loadKeys(cfg.Secret.JwtKeysPath)
+	if err != nil {
+		return nil, err
+	}
+	active, ok := keys[cfg.Secret.JwtActiveKid]
+	if !ok || active.private == nil {
+		return nil, fmt.Errorf("private key for active kid %q not found", cfg.Secret.JwtActiveKid)
+	}
+	if active.method.Alg() != algorithm {
+		return nil, fmt.Errorf("active key %q is %s, expected %s", active.kid, active.method.Alg(), algorithm)
+	}
+	t.active = active
+	t.keys = keys
+
+	return t, nil
 }
 
 func (t *TokenImpl) GenerateToken(id uuid.UUID, role string) (string, error) {
+	now := time.Now()
 	claims := jwt.MapClaims{
 		"iss":  "go-super-farmer",
 		"sub":  id,
-		"iat":  time.Now().Unix(),
-		"exp":  time.Now().Add(24 * time.Hour).Unix(),
+		"jti":  uuid.New().String(),
+		"iat":  jwt.NewNumericDate(now),
+		"exp":  now.Add(AccessTokenTTL).Unix(),
 		"role": role,
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(t.env.Secret.JwtSecretKey))
+	if t.active == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		return token.SignedString([]byte(t.env.Secret.JwtSecretKey))
+	}
+
+	token := jwt.NewWithClaims(t.active.method, claims)
+	token.Header["kid"] = t.active.kid
+	return token.SignedString(t.active.private)
+}
+
+// GenerateRefreshToken returns an opaque random token, the session it belongs to lives in redis
+func (t *TokenImpl) GenerateRefreshToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
 }
 
 func (t *TokenImpl) ValidateToken(tokenString string) (*jwt.Token, error) {
 	return jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		if t.active == nil {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+			}
+			return []byte(t.env.Secret.JwtSecretKey), nil
+		}
+
+		// Every loaded key is accepted so tokens signed before a rotation stay valid
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, fmt.Errorf("missing kid header")
+		}
+		key, ok := t.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown kid: %s", kid)
+		}
+		if token.Method.Alg() != key.method.Alg() {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return []byte(t.env.Secret.JwtSecretKey), nil
+		return key.public, nil
 	})
 }
 
@@ -59,3 +133,15 @@ func (t *TokenImpl) ExtractClaims(tokenString string) (jwt.MapClaims, error) {
 
 	return nil, utils.NewInternalError("Invalid token claims")
 }
+
+// JWKS returns the public part of every verification key, empty when signing with HS256
+func (t *TokenImpl) JWKS() *JWKS {
+	jwks := &JWKS{Keys: []JWK{}}
+	for _, key := range t.keys {
+		jwks.Keys = append(jwks.Keys, key.jwk())
+	}
+	sort.Slice(jwks.Keys, func(i, j int) bool {
+		return jwks.Keys[i].Kid < jwks.Keys[j].Kid
+	})
+	return jwks
+}
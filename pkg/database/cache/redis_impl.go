@@ -25,6 +25,15 @@ func (r *redisCache) Get(ctx context.Context, key string) ([]byte, error) {
 	return val, err
 }
 
+// GetDel reads and removes a key in one step, so only one caller can consume it
+func (r *redisCache) GetDel(ctx context.Context, key string) ([]byte, error) {
+	val, err := r.client.GetDel(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	return val, err
+}
+
 func (r *redisCache) Set(ctx context.Context, key string, value []byte, expiration time.Duration) error {
 	return r.client.Set(ctx, key, value, expiration).Err()
 }
@@ -46,3 +55,18 @@ func (r *redisCache) DeleteByPattern(ctx context.Context, pattern string) error
 	}
 	return nil
 }
+
+// Incr increments a counter, the expiration is only set when the counter is created.
+// The key is created with its expiration and incremented in one transaction, so a counter never lives without one
+func (r *redisCache) Incr(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	var incr *redis.IntCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SetNX(ctx, key, 0, expiration)
+		incr = pipe.Incr(ctx, key)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
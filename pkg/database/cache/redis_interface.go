@@ -7,7 +7,9 @@ import (
 
 type Cache interface {
 	Get(ctx context.Context, key string) ([]byte, error)
+	GetDel(ctx context.Context, key string) ([]byte, error)
 	Set(ctx context.Context, key string, value []byte, expiration time.Duration) error
 	Delete(ctx context.Context, key string) error
 	DeleteByPattern(ctx context.Context, pattern string) error
+	Incr(ctx context.Context, key string, expiration time.Duration) (int64, error)
 }
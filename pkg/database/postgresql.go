@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/ryvasa/go-super-farmer/internal/model/domain"
+	"github.com/ryvasa/go-super-farmer/pkg/database/seeders"
 	"github.com/ryvasa/go-super-farmer/pkg/env"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -45,8 +46,20 @@ func NewPostgres(env *env.Env) (*gorm.DB, error) {
 		&domain.DemandHistory{},
 		&domain.Harvest{},
 		&domain.Sale{},
+		&domain.APIKey{},
+		&domain.PriceAlert{},
+		&domain.Unit{},
+		&domain.Currency{},
+		&domain.ExchangeRate{},
+		&domain.PriceReview{},
+		&domain.PriceSchedule{},
+		&domain.Forecast{},
 	)
 
+	if err := seeders.SeedUnits(db); err != nil {
+		return nil, err
+	}
+
 	// seeders.Seeders(db)
 
 	return db, nil
@@ -672,8 +672,11 @@ var users = []*domain.User{
 }
 
 var roles = []*domain.Role{
-	{ID: 1, Name: "Admin"},
-	{ID: 2, Name: "Farmer"},
+	{ID: 1, Name: domain.RoleAdmin},
+	{ID: 2, Name: domain.RoleFarmer},
+	{ID: 3, Name: domain.RoleBuyer},
+	{ID: 4, Name: domain.RoleFieldOfficer},
+	{ID: 5, Name: domain.RoleMarketAnalyst},
 }
 
 var lands = []*domain.Land{
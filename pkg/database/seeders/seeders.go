@@ -10,26 +10,46 @@ import (
 	"github.com/ryvasa/go-super-farmer/internal/model/domain"
 	"github.com/ryvasa/go-super-farmer/pkg/logrus"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // SeedRoles populates the roles table with predefined roles.
 func SeedRoles(db *gorm.DB) []*domain.Role {
 	predefinedRoles := []*domain.Role{
-		{ID: 1, Name: "Admin"},
-		{ID: 2, Name: "Farmer"},
+		{ID: 1, Name: domain.RoleAdmin},
+		{ID: 2, Name: domain.RoleFarmer},
+		{ID: 3, Name: domain.RoleBuyer},
+		{ID: 4, Name: domain.RoleFieldOfficer},
+		{ID: 5, Name: domain.RoleMarketAnalyst},
 	}
 
-	if isDataSeeded(db, &domain.Role{}) {
-		log.Println("Roles already seeded")
-		return predefinedRoles
-	}
-
-	if err := db.Create(&predefinedRoles).Error; err != nil {
+	// Roles added after the first seed are still inserted on existing databases
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&predefinedRoles).Error; err != nil {
 		log.Fatalf("Error seeding roles: %v", err)
 	}
 	return predefinedRoles
 }
 
+// SeedUnits inserts the unit registry and the base currency, it runs on every start
+// so rows an admin edited are kept and units added to the list reach existing databases
+func SeedUnits(db *gorm.DB) error {
+	units := []*domain.Unit{
+		{Code: domain.BaseUnit, Name: "Kilogram", Dimension: domain.UnitDimensionMass, Factor: 1},
+		{Code: "quintal", Name: "Quintal", Dimension: domain.UnitDimensionMass, Factor: 100},
+		{Code: "ton", Name: "Ton", Dimension: domain.UnitDimensionMass, Factor: 1000},
+		// A sack has no standard size, 50 kg is only the initial factor and admins set the local one through /api/units
+		{Code: "sack", Name: "Sack", Dimension: domain.UnitDimensionMass, Factor: 50},
+		{Code: domain.BaseAreaUnit, Name: "Square meter", Dimension: domain.UnitDimensionArea, Factor: 1},
+		{Code: "ha", Name: "Hectare", Dimension: domain.UnitDimensionArea, Factor: 10000},
+	}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&units).Error; err != nil {
+		return err
+	}
+
+	currency := &domain.Currency{Code: domain.BaseCurrency, Name: "Indonesian Rupiah"}
+	return db.Clauses(clause.OnConflict{DoNothing: true}).Create(currency).Error
+}
+
 // SeedUsers populates the users table with fake data.
 func SeedUsers(db *gorm.DB, roles []*domain.Role) []*domain.User {
 
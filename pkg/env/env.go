@@ -20,6 +20,9 @@ type Env struct {
 	}
 	Secret struct {
 		JwtSecretKey string
+		JwtAlgorithm string
+		JwtKeysPath  string
+		JwtActiveKid string
 	}
 	RabbitMQ struct {
 		Host     string
@@ -57,6 +60,18 @@ type Env struct {
 		Secret   string
 		EndPoint string
 	}
+	PriceIndex struct {
+		BaseStart string
+		BaseEnd   string
+	}
+	PriceSchedule struct {
+		Interval string
+	}
+	Forecast struct {
+		Timeout            string
+		Engine             string
+		EvaluationInterval string
+	}
 }
 
 func LoadEnv() (*Env, error) {
@@ -80,6 +95,9 @@ func LoadEnv() (*Env, error) {
 
 	// Secret
 	env.Secret.JwtSecretKey = os.Getenv("JWT_SECRET_KEY")
+	env.Secret.JwtAlgorithm = os.Getenv("JWT_ALGORITHM")
+	env.Secret.JwtKeysPath = os.Getenv("JWT_KEYS_PATH")
+	env.Secret.JwtActiveKid = os.Getenv("JWT_ACTIVE_KID")
 
 	// RabbitMQ
 	env.RabbitMQ.Host = os.Getenv("RABBITMQ_HOST")
@@ -116,5 +134,19 @@ func LoadEnv() (*Env, error) {
 	env.MinIO.Secret = os.Getenv("MINIO_SECRET")
 	env.MinIO.EndPoint = os.Getenv("MINIO_ENDPOINT")
 
+	// Price index base period, YYYY-MM-DD
+	env.PriceIndex.BaseStart = os.Getenv("PRICE_INDEX_BASE_START")
+	env.PriceIndex.BaseEnd = os.Getenv("PRICE_INDEX_BASE_END")
+
+	// How often due price schedules are applied, a Go duration like 30s
+	env.PriceSchedule.Interval = os.Getenv("PRICE_SCHEDULE_INTERVAL")
+
+	// How long a forecast waits for the prediction service, a Go duration like 10s
+	env.Forecast.Timeout = os.Getenv("FORECAST_TIMEOUT")
+	// prediction-service (the default, falling back to the baseline when it fails) or baseline
+	env.Forecast.Engine = os.Getenv("FORECAST_ENGINE")
+	// How often forecasts whose harvest date passed are compared with the actual price, a Go duration like 1h
+	env.Forecast.EvaluationInterval = os.Getenv("FORECAST_EVALUATION_INTERVAL")
+
 	return env, nil
 }
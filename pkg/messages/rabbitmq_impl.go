@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rabbitmq/amqp091-go"
 	"github.com/ryvasa/go-super-farmer/pkg/env"
 	"github.com/ryvasa/go-super-farmer/pkg/logrus"
@@ -15,6 +18,11 @@ type RabbitMQImpl struct {
 	Connection *amqp091.Connection
 	Channel    *amqp091.Channel
 	env        *env.Env
+
+	// replies to RequestJSON all arrive on one exclusive queue and are matched to their caller by correlation id
+	replyMu    sync.Mutex
+	replyQueue string
+	pending    map[string]chan amqp091.Delivery
 }
 
 func connectRabbitMQ(url string) (*amqp091.Connection, error) {
@@ -78,7 +86,33 @@ func NewRabbitMQ(env *env.Env) (RabbitMQ, error) {
 		return nil, err
 	}
 
-	queues := []string{"price-history-queue", "harvest-queue", "mail-queue", "prediction-input-queue", "prediction-output-queue"}
+	err = ch.ExchangeDeclare(
+		"policy-exchange", // name
+		"fanout",          // type
+		true,              // durable
+		false,             // auto-deleted
+		false,             // internal
+		false,             // no-wait
+		nil,               // arguments
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ch.ExchangeDeclare(
+		"price-event-exchange", // name
+		"fanout",               // type
+		true,                   // durable
+		false,                  // auto-deleted
+		false,                  // internal
+		false,                  // no-wait
+		nil,                    // arguments
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	queues := []string{"price-history-queue", "harvest-queue", "mail-queue", "prediction-input-queue"}
 	for _, queueName := range queues {
 		_, err = ch.QueueDeclare(
 			queueName,
@@ -105,9 +139,9 @@ func NewRabbitMQ(env *env.Env) (RabbitMQ, error) {
 	}
 
 	err = ch.QueueBind(
-		"prediction-input-queue", // queue name
-		"prediction-input",       // routing key
-		"prediction-exchange",    // exchange
+		"mail-queue",    // queue name
+		"change-email",  // routing key
+		"mail-exchange", // exchange
 		false,
 		nil,
 	)
@@ -116,9 +150,31 @@ func NewRabbitMQ(env *env.Env) (RabbitMQ, error) {
 	}
 
 	err = ch.QueueBind(
-		"prediction-output-queue", // queue name
-		"prediction-output",       // routing key
-		"prediction-exchange",     // exchange
+		"mail-queue",     // queue name
+		"reset-password", // routing key
+		"mail-exchange",  // exchange
+		false,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ch.QueueBind(
+		"mail-queue",    // queue name
+		"price-alert",   // routing key
+		"mail-exchange", // exchange
+		false,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ch.QueueBind(
+		"prediction-input-queue", // queue name
+		"prediction-input",       // routing key
+		"prediction-exchange",    // exchange
 		false,
 		nil,
 	)
@@ -129,6 +185,7 @@ func NewRabbitMQ(env *env.Env) (RabbitMQ, error) {
 	return &RabbitMQImpl{
 		Connection: conn,
 		Channel:    ch,
+		pending:    map[string]chan amqp091.Delivery{},
 	}, nil
 }
 
@@ -183,6 +240,133 @@ func (r *RabbitMQImpl) ConsumeMessages(queueName string) (<-chan amqp091.Deliver
 	)
 }
 
+// SubscribeFanout binds an exclusive server named queue to a fanout exchange so every instance gets its own copy
+func (r *RabbitMQImpl) SubscribeFanout(exchange string) (<-chan amqp091.Delivery, error) {
+	q, err := r.Channel.QueueDeclare(
+		"",    // name
+		false, // durable
+		true,  // delete when unused
+		true,  // exclusive
+		false, // no-wait
+		nil,   // arguments
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.Channel.QueueBind(q.Name, "", exchange, false, nil); err != nil {
+		return nil, err
+	}
+
+	return r.ConsumeMessages(q.Name)
+}
+
+// RequestJSON publishes data with a correlation id and reply_to set to the reply queue of this instance, then waits
+// for the reply carrying the same correlation id until ctx is done. The request expires with ctx, so a busy
+// consumer drops it instead of answering a caller that already gave up
+func (r *RabbitMQImpl) RequestJSON(ctx context.Context, exchange, routingKey string, data interface{}, reply interface{}) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	replyQueue, err := r.declareReplyQueue()
+	if err != nil {
+		return err
+	}
+
+	correlationID := uuid.New().String()
+	replies := make(chan amqp091.Delivery, 1)
+	r.replyMu.Lock()
+	r.pending[correlationID] = replies
+	r.replyMu.Unlock()
+	defer func() {
+		r.replyMu.Lock()
+		delete(r.pending, correlationID)
+		r.replyMu.Unlock()
+	}()
+
+	publishing := amqp091.Publishing{
+		ContentType:   "application/json",
+		CorrelationId: correlationID,
+		ReplyTo:       replyQueue,
+		Body:          body,
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		expiration := time.Until(deadline).Milliseconds()
+		if expiration <= 0 {
+			return context.DeadlineExceeded
+		}
+		publishing.Expiration = strconv.FormatInt(expiration, 10)
+	}
+	if err := r.Channel.PublishWithContext(ctx, exchange, routingKey, false, false, publishing); err != nil {
+		return err
+	}
+
+	select {
+	case msg, ok := <-replies:
+		if !ok {
+			return fmt.Errorf("reply queue closed before the reply to %s arrived", correlationID)
+		}
+		return json.Unmarshal(msg.Body, reply)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// declareReplyQueue declares the exclusive reply queue on first use and again once its consumer is gone
+func (r *RabbitMQImpl) declareReplyQueue() (string, error) {
+	r.replyMu.Lock()
+	defer r.replyMu.Unlock()
+	if r.replyQueue != "" {
+		return r.replyQueue, nil
+	}
+
+	q, err := r.Channel.QueueDeclare(
+		"",    // name
+		false, // durable
+		true,  // delete when unused
+		true,  // exclusive
+		false, // no-wait
+		nil,   // arguments
+	)
+	if err != nil {
+		return "", err
+	}
+	msgs, err := r.ConsumeMessages(q.Name)
+	if err != nil {
+		return "", err
+	}
+	r.replyQueue = q.Name
+
+	go func() {
+		for msg := range msgs {
+			r.replyMu.Lock()
+			replies, ok := r.pending[msg.CorrelationId]
+			r.replyMu.Unlock()
+			if !ok {
+				// The caller timed out already
+				logrus.Log.Warn("dropping reply without a waiting request: ", msg.CorrelationId)
+				continue
+			}
+			select {
+			case replies <- msg:
+			default:
+			}
+		}
+
+		r.replyMu.Lock()
+		defer r.replyMu.Unlock()
+		r.replyQueue = ""
+		for correlationID, replies := range r.pending {
+			close(replies)
+			delete(r.pending, correlationID)
+		}
+	}()
+
+	return q.Name, nil
+}
+
 func (r *RabbitMQImpl) Close() {
 	if r.Channel != nil {
 		r.Channel.Close()
@@ -11,5 +11,8 @@ type RabbitMQ interface {
 	PublishJSON(ctx context.Context, exchange, routingKey string, data interface{}) error
 	DeclareQueue(name string) (amqp091.Queue, error)
 	ConsumeMessages(queueName string) (<-chan amqp091.Delivery, error)
+	SubscribeFanout(exchange string) (<-chan amqp091.Delivery, error)
+	// RequestJSON publishes data and decodes the reply matching its correlation id into reply
+	RequestJSON(ctx context.Context, exchange, routingKey string, data interface{}, reply interface{}) error
 	Close()
 }
@@ -104,3 +104,32 @@ func (mr *MockRabbitMQMockRecorder) PublishJSON(ctx, exchange, routingKey, data
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishJSON", reflect.TypeOf((*MockRabbitMQ)(nil).PublishJSON), ctx, exchange, routingKey, data)
 }
+
+// RequestJSON mocks base method.
+func (m *MockRabbitMQ) RequestJSON(ctx context.Context, exchange, routingKey string, data, reply interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestJSON", ctx, exchange, routingKey, data, reply)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestJSON indicates an expected call of RequestJSON.
+func (mr *MockRabbitMQMockRecorder) RequestJSON(ctx, exchange, routingKey, data, reply interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestJSON", reflect.TypeOf((*MockRabbitMQ)(nil).RequestJSON), ctx, exchange, routingKey, data, reply)
+}
+
+// SubscribeFanout mocks base method.
+func (m *MockRabbitMQ) SubscribeFanout(exchange string) (<-chan amqp091.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeFanout", exchange)
+	ret0, _ := ret[0].(<-chan amqp091.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeFanout indicates an expected call of SubscribeFanout.
+func (mr *MockRabbitMQMockRecorder) SubscribeFanout(exchange interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeFanout", reflect.TypeOf((*MockRabbitMQ)(nil).SubscribeFanout), exchange)
+}
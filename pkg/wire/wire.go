@@ -9,9 +9,11 @@ import (
 	"github.com/ryvasa/go-super-farmer/internal/delivery/http/handler"
 	handler_implementation "github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/implementation"
 	"github.com/ryvasa/go-super-farmer/internal/delivery/http/route"
+	"github.com/ryvasa/go-super-farmer/internal/delivery/scheduler"
 	"github.com/ryvasa/go-super-farmer/internal/repository"
 	repository_implementation "github.com/ryvasa/go-super-farmer/internal/repository/implementation"
 	usecase_implementation "github.com/ryvasa/go-super-farmer/internal/usecase/implementation"
+	"github.com/ryvasa/go-super-farmer/pkg/auth/casbin"
 	"github.com/ryvasa/go-super-farmer/pkg/auth/token"
 	"github.com/ryvasa/go-super-farmer/pkg/database"
 	"github.com/ryvasa/go-super-farmer/pkg/database/cache"
@@ -27,11 +29,16 @@ var tokenSet = wire.NewSet(
 	token.NewToken,
 )
 
+var casbinSet = wire.NewSet(
+	casbin.NewCasbin,
+)
+
 var utilSet = wire.NewSet(
 	utils.NewAuthUtil,
 	utils.NewHasher,
 	utils.NewOTPGenerator,
 	utils.NewGlobFunc,
+	utils.NewTOTP,
 )
 
 var repositorySet = wire.NewSet(
@@ -51,6 +58,13 @@ var repositorySet = wire.NewSet(
 	repository_implementation.NewSupplyHistoryRepository,
 	repository_implementation.NewHarvestRepository,
 	repository_implementation.NewSaleRepository,
+	repository_implementation.NewAPIKeyRepository,
+	repository_implementation.NewPriceAlertRepository,
+	repository_implementation.NewUnitRepository,
+	repository_implementation.NewCurrencyRepository,
+	repository_implementation.NewPriceReviewRepository,
+	repository_implementation.NewPriceScheduleRepository,
+	repository_implementation.NewForecastRepository,
 )
 
 var usecaseSet = wire.NewSet(
@@ -68,6 +82,16 @@ var usecaseSet = wire.NewSet(
 	usecase_implementation.NewHarvestUsecase,
 	usecase_implementation.NewSaleUsecase,
 	usecase_implementation.NewForecastsUsecase,
+	usecase_implementation.NewAPIKeyUsecase,
+	usecase_implementation.NewPolicyUsecase,
+	usecase_implementation.NewOfficerUsecase,
+	usecase_implementation.NewAnalystUsecase,
+	usecase_implementation.NewPriceAlertUsecase,
+	usecase_implementation.NewUnitUsecase,
+	usecase_implementation.NewCurrencyUsecase,
+	usecase_implementation.NewConversionUsecase,
+	usecase_implementation.NewPriceIndexUsecase,
+	usecase_implementation.NewPriceStreamUsecase,
 )
 
 var handlerSet = wire.NewSet(
@@ -85,6 +109,15 @@ var handlerSet = wire.NewSet(
 	handler_implementation.NewHarvestHandler,
 	handler_implementation.NewSaleHandler,
 	handler_implementation.NewForecastsHandler,
+	handler_implementation.NewAPIKeyHandler,
+	handler_implementation.NewPolicyHandler,
+	handler_implementation.NewOfficerHandler,
+	handler_implementation.NewAnalystHandler,
+	handler_implementation.NewPriceAlertHandler,
+	handler_implementation.NewUnitHandler,
+	handler_implementation.NewCurrencyHandler,
+	handler_implementation.NewPriceIndexHandler,
+	handler_implementation.NewPriceStreamHandler,
 )
 
 var rabbitMQSet = wire.NewSet(
@@ -108,6 +141,11 @@ var txManagerSet = wire.NewSet(
 	transaction.NewTransactionManager,
 )
 
+var schedulerSet = wire.NewSet(
+	scheduler.NewPriceScheduler,
+	scheduler.NewForecastEvaluator,
+)
+
 func InitializeApp() (*app.App, error) {
 	wire.Build(
 		env.LoadEnv,
@@ -118,6 +156,7 @@ func InitializeApp() (*app.App, error) {
 		databaseSet,
 		rabbitMQSet,
 		tokenSet,
+		casbinSet,
 		utilSet,
 		repositorySet,
 		usecaseSet,
@@ -125,6 +164,7 @@ func InitializeApp() (*app.App, error) {
 		cacheSet,
 		txManagerSet,
 		monioSet,
+		schedulerSet,
 	)
 	return nil, nil
 }
@@ -12,9 +12,11 @@ import (
 	"github.com/ryvasa/go-super-farmer/internal/delivery/http/handler"
 	"github.com/ryvasa/go-super-farmer/internal/delivery/http/handler/implementation"
 	"github.com/ryvasa/go-super-farmer/internal/delivery/http/route"
+	"github.com/ryvasa/go-super-farmer/internal/delivery/scheduler"
 	"github.com/ryvasa/go-super-farmer/internal/repository"
 	"github.com/ryvasa/go-super-farmer/internal/repository/implementation"
 	"github.com/ryvasa/go-super-farmer/internal/usecase/implementation"
+	"github.com/ryvasa/go-super-farmer/pkg/auth/casbin"
 	"github.com/ryvasa/go-super-farmer/pkg/auth/token"
 	"github.com/ryvasa/go-super-farmer/pkg/database"
 	"github.com/ryvasa/go-super-farmer/pkg/database/cache"
@@ -44,39 +46,49 @@ func InitializeApp() (*app.App, error) {
 	hasher := utils.NewHasher()
 	client := database.NewRedisClient(envEnv)
 	cacheCache := cache.NewRedisCache(client)
-	userUsecase := usecase_implementation.NewUserUsecase(userRepository, hasher, cacheCache)
-	tokenToken := token.NewToken(envEnv)
 	rabbitMQ, err := messages.NewRabbitMQ(envEnv)
 	if err != nil {
 		return nil, err
 	}
 	otp := utils.NewOTPGenerator()
-	authUsecase := usecase_implementation.NewAuthUsecase(userRepository, tokenToken, hasher, rabbitMQ, cacheCache, otp)
+	userUsecase := usecase_implementation.NewUserUsecase(userRepository, hasher, cacheCache, otp, rabbitMQ)
+	tokenToken, err := token.NewToken(envEnv)
+	if err != nil {
+		return nil, err
+	}
+	totp := utils.NewTOTP()
+	authUsecase := usecase_implementation.NewAuthUsecase(userRepository, tokenToken, hasher, rabbitMQ, cacheCache, otp, totp)
 	authUtil := utils.NewAuthUtil()
 	userHandler := handler_implementation.NewUserHandler(userUsecase, authUsecase, authUtil)
 	landRepository := repository_implementation.NewLandRepository(db)
-	landUsecase := usecase_implementation.NewLandUsecase(landRepository, userRepository)
+	cityRepository := repository_implementation.NewCityRepository(db)
+	landUsecase := usecase_implementation.NewLandUsecase(landRepository, userRepository, cityRepository)
 	landHandler := handler_implementation.NewLandHandler(landUsecase, authUtil)
-	authHandler := handler_implementation.NewAuthHandler(authUsecase)
+	authHandler := handler_implementation.NewAuthHandler(authUsecase, authUtil)
 	commodityRepository := repository_implementation.NewCommodityRepository(db)
 	commodityUsecase := usecase_implementation.NewCommodityUsecase(commodityRepository, cacheCache)
 	commodityHandler := handler_implementation.NewCommodityHandler(commodityUsecase)
 	landCommodityRepository := repository_implementation.NewLandCommodityRepository(db)
-	cityRepository := repository_implementation.NewCityRepository(db)
 	landCommodityUsecase := usecase_implementation.NewLandCommodityUsecase(landCommodityRepository, landRepository, cityRepository, commodityRepository, cacheCache)
-	landCommodityHandler := handler_implementation.NewLandCommodityHandler(landCommodityUsecase)
+	landCommodityHandler := handler_implementation.NewLandCommodityHandler(landCommodityUsecase, authUtil)
 	baseRepository := repository.NewBaseRepository(db)
 	priceRepository := repository_implementation.NewPriceRepository(baseRepository)
 	priceHistoryRepository := repository_implementation.NewPriceHistoryRepository(baseRepository)
 	transactionManager := transaction.NewTransactionManager(db)
 	globFunc := utils.NewGlobFunc()
-	priceUsecase := usecase_implementation.NewPriceUsecase(priceRepository, priceHistoryRepository, cityRepository, commodityRepository, rabbitMQ, transactionManager, cacheCache, globFunc, envEnv)
+	priceAlertRepository := repository_implementation.NewPriceAlertRepository(db)
+	priceReviewRepository := repository_implementation.NewPriceReviewRepository(baseRepository)
+	priceScheduleRepository := repository_implementation.NewPriceScheduleRepository(baseRepository)
+	priceUsecase := usecase_implementation.NewPriceUsecase(priceRepository, priceHistoryRepository, priceAlertRepository, priceReviewRepository, priceScheduleRepository, cityRepository, commodityRepository, rabbitMQ, transactionManager, cacheCache, globFunc, envEnv)
 	reportServiceClient, err := grpc.InitGRPCClient(envEnv)
 	if err != nil {
 		return nil, err
 	}
 	minioClient := minio.NewMinioClient(envEnv)
-	priceHandler := handler_implementation.NewPriceHandler(priceUsecase, reportServiceClient, minioClient)
+	unitRepository := repository_implementation.NewUnitRepository(db)
+	currencyRepository := repository_implementation.NewCurrencyRepository(db)
+	conversionUsecase := usecase_implementation.NewConversionUsecase(unitRepository, currencyRepository)
+	priceHandler := handler_implementation.NewPriceHandler(priceUsecase, conversionUsecase, reportServiceClient, minioClient, authUtil)
 	provinceRepository := repository_implementation.NewProvinceRepository(db)
 	provinceUsecase := usecase_implementation.NewProvinceUsecase(provinceRepository)
 	provinceHandler := handler_implementation.NewProvinceHandler(provinceUsecase)
@@ -85,22 +97,48 @@ func InitializeApp() (*app.App, error) {
 	demandRepository := repository_implementation.NewDemandRepository(baseRepository)
 	demandHistoryRepository := repository_implementation.NewDemandHistoryRepository(baseRepository)
 	demandUsecase := usecase_implementation.NewDemandUsecase(demandRepository, demandHistoryRepository, commodityRepository, cityRepository, transactionManager)
-	demandHandler := handler_implementation.NewDemandHandler(demandUsecase)
+	demandHandler := handler_implementation.NewDemandHandler(demandUsecase, conversionUsecase)
 	supplyRepository := repository_implementation.NewSupplyRepository(baseRepository)
 	supplyHistoryRepository := repository_implementation.NewSupplyHistoryRepository(baseRepository)
 	supplyUsecase := usecase_implementation.NewSupplyUsecase(supplyRepository, supplyHistoryRepository, commodityRepository, cityRepository, transactionManager)
-	supplyHandler := handler_implementation.NewSupplyHandler(supplyUsecase)
+	supplyHandler := handler_implementation.NewSupplyHandler(supplyUsecase, conversionUsecase)
 	harvestRepository := repository_implementation.NewHarvestRepository(db)
 	harvestUsecase := usecase_implementation.NewHarvestUsecase(harvestRepository, cityRepository, landCommodityRepository, rabbitMQ, cacheCache, globFunc, envEnv, transactionManager)
-	harvestHandler := handler_implementation.NewHarvestHandler(harvestUsecase, reportServiceClient, minioClient)
+	harvestHandler := handler_implementation.NewHarvestHandler(harvestUsecase, reportServiceClient, minioClient, authUtil)
 	saleRepository := repository_implementation.NewSaleRepository(baseRepository)
 	saleUsecase := usecase_implementation.NewSaleUsecase(saleRepository, cityRepository, commodityRepository, cacheCache)
-	saleHandler := handler_implementation.NewSaleHandler(saleUsecase)
-	forecastsUsecase := usecase_implementation.NewForecastsUsecase(landCommodityRepository, cityRepository, priceRepository, priceHistoryRepository, demandRepository, demandHistoryRepository, supplyRepository, supplyHistoryRepository, saleRepository, harvestRepository, commodityRepository, rabbitMQ)
-	forecastsHandler := handler_implementation.NewForecastsHandler(forecastsUsecase)
-	handlers := handler.NewHandlers(roleHandler, userHandler, landHandler, authHandler, commodityHandler, landCommodityHandler, priceHandler, provinceHandler, cityHandler, demandHandler, supplyHandler, harvestHandler, saleHandler, forecastsHandler)
-	engine := route.NewRouter(handlers)
-	appApp := app.NewApp(engine, envEnv, db, rabbitMQ, reportServiceClient)
+	saleHandler := handler_implementation.NewSaleHandler(saleUsecase, conversionUsecase, authUtil)
+	forecastRepository := repository_implementation.NewForecastRepository(baseRepository)
+	forecastsUsecase := usecase_implementation.NewForecastsUsecase(landCommodityRepository, cityRepository, priceRepository, priceHistoryRepository, demandRepository, demandHistoryRepository, supplyRepository, supplyHistoryRepository, saleRepository, harvestRepository, commodityRepository, provinceRepository, unitRepository, forecastRepository, rabbitMQ, envEnv)
+	forecastsHandler := handler_implementation.NewForecastsHandler(forecastsUsecase, authUtil)
+	apiKeyRepository := repository_implementation.NewAPIKeyRepository(db)
+	apiKeyUsecase := usecase_implementation.NewAPIKeyUsecase(apiKeyRepository, userRepository)
+	apiKeyHandler := handler_implementation.NewAPIKeyHandler(apiKeyUsecase, authUtil)
+	casbinCasbin, err := casbin.NewCasbin(envEnv, db, rabbitMQ)
+	if err != nil {
+		return nil, err
+	}
+	policyUsecase := usecase_implementation.NewPolicyUsecase(casbinCasbin)
+	policyHandler := handler_implementation.NewPolicyHandler(policyUsecase)
+	officerUsecase := usecase_implementation.NewOfficerUsecase(userRepository, provinceRepository, landRepository)
+	officerHandler := handler_implementation.NewOfficerHandler(officerUsecase, authUtil)
+	analystUsecase := usecase_implementation.NewAnalystUsecase(commodityRepository, priceRepository, supplyRepository, demandRepository, unitRepository)
+	analystHandler := handler_implementation.NewAnalystHandler(analystUsecase)
+	priceAlertUsecase := usecase_implementation.NewPriceAlertUsecase(priceAlertRepository, commodityRepository, cityRepository)
+	priceAlertHandler := handler_implementation.NewPriceAlertHandler(priceAlertUsecase, authUtil)
+	unitUsecase := usecase_implementation.NewUnitUsecase(unitRepository)
+	unitHandler := handler_implementation.NewUnitHandler(unitUsecase)
+	currencyUsecase := usecase_implementation.NewCurrencyUsecase(currencyRepository)
+	currencyHandler := handler_implementation.NewCurrencyHandler(currencyUsecase)
+	priceIndexUsecase := usecase_implementation.NewPriceIndexUsecase(priceHistoryRepository, supplyRepository, commodityRepository, provinceRepository, unitRepository, cacheCache, envEnv)
+	priceIndexHandler := handler_implementation.NewPriceIndexHandler(priceIndexUsecase)
+	priceStreamUsecase := usecase_implementation.NewPriceStreamUsecase(rabbitMQ)
+	priceStreamHandler := handler_implementation.NewPriceStreamHandler(priceStreamUsecase)
+	handlers := handler.NewHandlers(roleHandler, userHandler, landHandler, authHandler, commodityHandler, landCommodityHandler, priceHandler, provinceHandler, cityHandler, demandHandler, supplyHandler, harvestHandler, saleHandler, forecastsHandler, apiKeyHandler, policyHandler, officerHandler, analystHandler, priceAlertHandler, unitHandler, currencyHandler, priceIndexHandler, priceStreamHandler)
+	engine := route.NewRouter(handlers, cacheCache, apiKeyUsecase, casbinCasbin)
+	priceScheduler := scheduler.NewPriceScheduler(priceUsecase, envEnv)
+	forecastEvaluator := scheduler.NewForecastEvaluator(forecastsUsecase, envEnv)
+	appApp := app.NewApp(engine, envEnv, db, rabbitMQ, reportServiceClient, priceScheduler, forecastEvaluator)
 	return appApp, nil
 }
 
@@ -108,13 +146,15 @@ func InitializeApp() (*app.App, error) {
 
 var tokenSet = wire.NewSet(token.NewToken)
 
-var utilSet = wire.NewSet(utils.NewAuthUtil, utils.NewHasher, utils.NewOTPGenerator, utils.NewGlobFunc)
+var casbinSet = wire.NewSet(casbin.NewCasbin)
 
-var repositorySet = wire.NewSet(repository.NewBaseRepository, repository_implementation.NewRoleRepository, repository_implementation.NewUserRepository, repository_implementation.NewLandRepository, repository_implementation.NewCommodityRepository, repository_implementation.NewLandCommodityRepository, repository_implementation.NewPriceRepository, repository_implementation.NewProvinceRepository, repository_implementation.NewCityRepository, repository_implementation.NewPriceHistoryRepository, repository_implementation.NewDemandRepository, repository_implementation.NewSupplyRepository, repository_implementation.NewDemandHistoryRepository, repository_implementation.NewSupplyHistoryRepository, repository_implementation.NewHarvestRepository, repository_implementation.NewSaleRepository)
+var utilSet = wire.NewSet(utils.NewAuthUtil, utils.NewHasher, utils.NewOTPGenerator, utils.NewGlobFunc, utils.NewTOTP)
 
-var usecaseSet = wire.NewSet(usecase_implementation.NewRoleUsecase, usecase_implementation.NewUserUsecase, usecase_implementation.NewLandUsecase, usecase_implementation.NewAuthUsecase, usecase_implementation.NewCommodityUsecase, usecase_implementation.NewLandCommodityUsecase, usecase_implementation.NewPriceUsecase, usecase_implementation.NewProvinceUsecase, usecase_implementation.NewCityUsecase, usecase_implementation.NewDemandUsecase, usecase_implementation.NewSupplyUsecase, usecase_implementation.NewHarvestUsecase, usecase_implementation.NewSaleUsecase, usecase_implementation.NewForecastsUsecase)
+var repositorySet = wire.NewSet(repository.NewBaseRepository, repository_implementation.NewRoleRepository, repository_implementation.NewUserRepository, repository_implementation.NewLandRepository, repository_implementation.NewCommodityRepository, repository_implementation.NewLandCommodityRepository, repository_implementation.NewPriceRepository, repository_implementation.NewProvinceRepository, repository_implementation.NewCityRepository, repository_implementation.NewPriceHistoryRepository, repository_implementation.NewDemandRepository, repository_implementation.NewSupplyRepository, repository_implementation.NewDemandHistoryRepository, repository_implementation.NewSupplyHistoryRepository, repository_implementation.NewHarvestRepository, repository_implementation.NewSaleRepository, repository_implementation.NewAPIKeyRepository, repository_implementation.NewPriceAlertRepository, repository_implementation.NewUnitRepository, repository_implementation.NewCurrencyRepository, repository_implementation.NewPriceReviewRepository, repository_implementation.NewPriceScheduleRepository, repository_implementation.NewForecastRepository)
 
-var handlerSet = wire.NewSet(handler_implementation.NewRoleHandler, handler_implementation.NewUserHandler, handler_implementation.NewLandHandler, handler_implementation.NewAuthHandler, handler_implementation.NewCommodityHandler, handler_implementation.NewLandCommodityHandler, handler_implementation.NewPriceHandler, handler_implementation.NewProvinceHandler, handler_implementation.NewCityHandler, handler_implementation.NewDemandHandler, handler_implementation.NewSupplyHandler, handler_implementation.NewHarvestHandler, handler_implementation.NewSaleHandler, handler_implementation.NewForecastsHandler)
+var usecaseSet = wire.NewSet(usecase_implementation.NewRoleUsecase, usecase_implementation.NewUserUsecase, usecase_implementation.NewLandUsecase, usecase_implementation.NewAuthUsecase, usecase_implementation.NewCommodityUsecase, usecase_implementation.NewLandCommodityUsecase, usecase_implementation.NewPriceUsecase, usecase_implementation.NewProvinceUsecase, usecase_implementation.NewCityUsecase, usecase_implementation.NewDemandUsecase, usecase_implementation.NewSupplyUsecase, usecase_implementation.NewHarvestUsecase, usecase_implementation.NewSaleUsecase, usecase_implementation.NewForecastsUsecase, usecase_implementation.NewAPIKeyUsecase, usecase_implementation.NewPolicyUsecase, usecase_implementation.NewOfficerUsecase, usecase_implementation.NewAnalystUsecase, usecase_implementation.NewPriceAlertUsecase, usecase_implementation.NewUnitUsecase, usecase_implementation.NewCurrencyUsecase, usecase_implementation.NewConversionUsecase, usecase_implementation.NewPriceIndexUsecase, usecase_implementation.NewPriceStreamUsecase)
+
+var handlerSet = wire.NewSet(handler_implementation.NewRoleHandler, handler_implementation.NewUserHandler, handler_implementation.NewLandHandler, handler_implementation.NewAuthHandler, handler_implementation.NewCommodityHandler, handler_implementation.NewLandCommodityHandler, handler_implementation.NewPriceHandler, handler_implementation.NewProvinceHandler, handler_implementation.NewCityHandler, handler_implementation.NewDemandHandler, handler_implementation.NewSupplyHandler, handler_implementation.NewHarvestHandler, handler_implementation.NewSaleHandler, handler_implementation.NewForecastsHandler, handler_implementation.NewAPIKeyHandler, handler_implementation.NewPolicyHandler, handler_implementation.NewOfficerHandler, handler_implementation.NewAnalystHandler, handler_implementation.NewPriceAlertHandler, handler_implementation.NewUnitHandler, handler_implementation.NewCurrencyHandler, handler_implementation.NewPriceIndexHandler, handler_implementation.NewPriceStreamHandler)
 
 var rabbitMQSet = wire.NewSet(messages.NewRabbitMQ)
 
@@ -125,3 +165,5 @@ var cacheSet = wire.NewSet(cache.NewRedisCache)
 var databaseSet = wire.NewSet(database.NewPostgres, database.NewRedisClient)
 
 var txManagerSet = wire.NewSet(transaction.NewTransactionManager)
+
+var schedulerSet = wire.NewSet(scheduler.NewPriceScheduler, scheduler.NewForecastEvaluator)
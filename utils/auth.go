@@ -9,6 +9,7 @@ import (
 type AuthUtil interface {
 	GetAuthUserID(c *gin.Context) (uuid.UUID, error)
 	GetAuthRole(c *gin.Context) (string, error)
+	GetAuthClaims(c *gin.Context) (jwt.MapClaims, error)
 }
 
 type AuthUtilImpl struct{}
@@ -56,3 +57,16 @@ func (a *AuthUtilImpl) GetAuthRole(c *gin.Context) (string, error) {
 
 	return role, nil
 }
+
+func (a *AuthUtilImpl) GetAuthClaims(c *gin.Context) (jwt.MapClaims, error) {
+	value, exists := c.Get("user")
+	if !exists {
+		return nil, NewUnauthorizedError("unauthorized")
+	}
+	claims, ok := value.(jwt.MapClaims)
+	if !ok {
+		return nil, NewUnauthorizedError("invalid claims type")
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,15 @@
+package utils
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/ryvasa/go-super-farmer/internal/model/dto"
+)
+
+// GetConversionParams returns the ?unit= and ?currency= of the request, nil when neither is set
+func GetConversionParams(c *gin.Context) *dto.ConversionParamsDTO {
+	params := &dto.ConversionParamsDTO{Unit: c.Query("unit"), Currency: c.Query("currency")}
+	if params.Unit == "" && params.Currency == "" {
+		return nil
+	}
+	return params
+}
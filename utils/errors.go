@@ -50,6 +50,20 @@ func NewForbiddenError(message string) error {
 	return NewAppError(http.StatusForbidden, "FORBIDDEN", message, nil)
 }
 
+func NewTooManyRequestsError(message string) error {
+	return NewAppError(http.StatusTooManyRequests, "TOO_MANY_REQUESTS", message, nil)
+}
+
+// NewPendingReviewError tells the caller a write was held for review instead of applied, details holds the review
+func NewPendingReviewError(message string, details interface{}) error {
+	return NewAppError(http.StatusAccepted, "PENDING_REVIEW", message, details)
+}
+
+// NewAuthFailedError is returned for every failed login or OTP attempt so callers can not tell which part was wrong
+func NewAuthFailedError() error {
+	return NewAppError(http.StatusUnauthorized, "AUTH_FAILED", "invalid credentials", nil)
+}
+
 func GetStatusCode(err error) int {
 	if appErr, ok := err.(AppError); ok {
 		return appErr.HttpStatus
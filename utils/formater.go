@@ -7,13 +7,13 @@ import (
 
 func UserDtoFormat(data *domain.User) *dto.UserResponseDTO {
 	return &dto.UserResponseDTO{
-		ID:        data.ID,
-		Name:      data.Name,
-		Email:     data.Email,
-		Phone:     data.Phone,
-		Password:  data.Password,
-		CreatedAt: data.CreatedAt,
-		UpdatedAt: data.UpdatedAt,
+		ID:         data.ID,
+		Name:       data.Name,
+		Email:      data.Email,
+		Phone:      data.Phone,
+		ProvinceID: data.ProvinceID,
+		CreatedAt:  data.CreatedAt,
+		UpdatedAt:  data.UpdatedAt,
 	}
 }
 
@@ -26,9 +26,10 @@ func UserDtoFormat(data *domain.User) *dto.UserResponseDTO {
 // 	}
 // }
 
-func AuthDtoFormat(user *domain.User, token string) *dto.AuthResponseDTO {
+func AuthDtoFormat(user *domain.User, token, refreshToken string) *dto.AuthResponseDTO {
 	return &dto.AuthResponseDTO{
-		User:  UserDtoFormat(user),
-		Token: token,
+		User:         UserDtoFormat(user),
+		Token:        token,
+		RefreshToken: refreshToken,
 	}
 }
@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	gin "github.com/gin-gonic/gin"
+	jwt "github.com/golang-jwt/jwt/v5"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -35,6 +36,21 @@ func (m *MockAuthUtil) EXPECT() *MockAuthUtilMockRecorder {
 	return m.recorder
 }
 
+// GetAuthClaims mocks base method.
+func (m *MockAuthUtil) GetAuthClaims(c *gin.Context) (jwt.MapClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthClaims", c)
+	ret0, _ := ret[0].(jwt.MapClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthClaims indicates an expected call of GetAuthClaims.
+func (mr *MockAuthUtilMockRecorder) GetAuthClaims(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthClaims", reflect.TypeOf((*MockAuthUtil)(nil).GetAuthClaims), c)
+}
+
 // GetAuthRole mocks base method.
 func (m *MockAuthUtil) GetAuthRole(c *gin.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -42,6 +42,9 @@ func ApplyFilters(filter *dto.ParamFilterDTO) func(db *gorm.DB) *gorm.DB {
 		if filter.CommodityID != nil {
 			db = db.Where("commodity_id = ?", filter.CommodityID)
 		}
+		if filter.BuyerID != nil {
+			db = db.Where("buyer_id = ?", filter.BuyerID)
+		}
 		if !filter.StartDate.IsZero() {
 			db = db.Where("created_at >= ?", filter.StartDate)
 		}
@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+</Types>`
+	xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`
+	// The first sheet of the workbook is prices.xml, sheet1.xml is the second one
+	xlsxWorkbookXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets>
+<sheet name="Prices" sheetId="1" r:id="rId3"/>
+<sheet name="Notes" sheetId="2" r:id="rId2"/>
+</sheets>
+</workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/sharedStrings" Target="sharedStrings.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/prices.xml"/>
+</Relationships>`
+	xlsxSharedStringsXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" count="4" uniqueCount="4">
+<si><t>commodity_code</t></si>
+<si><t>city</t></si>
+<si><t>price</t></si>
+<si><r><t>Ban</t></r><r><t>dung</t></r></si>
+</sst>`
+	// Row 3 is empty and not written, C4 has no B4 before it
+	xlsxPricesSheet = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="s"><v>2</v></c></row>
+<row r="2"><c r="A2" t="inlineStr"><is><t>RICE01</t></is></c><c r="B2" t="s"><v>3</v></c><c r="C2"><v>900</v></c></row>
+<row r="4"><c r="A4" t="inlineStr"><is><t>RICE01</t></is></c><c r="C4"><v>1200</v></c></row>
+</sheetData>
+</worksheet>`
+	xlsxNotesSheet = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<sheetData><row r="1"><c r="A1" t="inlineStr"><is><t>notes</t></is></c></row></sheetData>
+</worksheet>`
+)
+
+func buildXLSX(t *testing.T, parts map[string]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range parts {
+		f, err := w.Create(name)
+		assert.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func workbookParts() map[string]string {
+	return map[string]string{
+		"[Content_Types].xml":        xlsxContentTypes,
+		"_rels/.rels":                xlsxRootRels,
+		"xl/workbook.xml":            xlsxWorkbookXML,
+		"xl/_rels/workbook.xml.rels": xlsxWorkbookRels,
+		"xl/sharedStrings.xml":       xlsxSharedStringsXML,
+		"xl/worksheets/prices.xml":   xlsxPricesSheet,
+		"xl/worksheets/sheet1.xml":   xlsxNotesSheet,
+	}
+}
+
+func TestReadSpreadsheet_XLSX(t *testing.T) {
+	t.Run("should read the first sheet of the workbook", func(t *testing.T) {
+		rows, err := ReadSpreadsheet("prices.xlsx", buildXLSX(t, workbookParts()))
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"commodity_code", "city", "price"},
+			{"RICE01", "Bandung", "900"},
+			nil,
+			{"RICE01", "", "1200"},
+		}, rows)
+	})
+
+	t.Run("should fall back to sheet1 without workbook relationships", func(t *testing.T) {
+		parts := workbookParts()
+		delete(parts, "xl/_rels/workbook.xml.rels")
+
+		rows, err := ReadSpreadsheet("prices.XLSX", buildXLSX(t, parts))
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"notes"}}, rows)
+	})
+
+	t.Run("should return error when a shared string is out of range", func(t *testing.T) {
+		parts := workbookParts()
+		parts["xl/worksheets/prices.xml"] = `<worksheet><sheetData><row r="1"><c r="A1" t="s"><v>9</v></c></row></sheetData></worksheet>`
+
+		rows, err := ReadSpreadsheet("prices.xlsx", buildXLSX(t, parts))
+
+		assert.Nil(t, rows)
+		assert.EqualError(t, err, "invalid shared string in cell A1")
+	})
+
+	t.Run("should return error when the workbook is missing", func(t *testing.T) {
+		parts := workbookParts()
+		delete(parts, "xl/workbook.xml")
+
+		rows, err := ReadSpreadsheet("prices.xlsx", buildXLSX(t, parts))
+
+		assert.Nil(t, rows)
+		assert.EqualError(t, err, "invalid xlsx file, workbook not found")
+	})
+
+	t.Run("should return error when the file is not a zip", func(t *testing.T) {
+		rows, err := ReadSpreadsheet("prices.xlsx", []byte("commodity_code,city,price"))
+
+		assert.Nil(t, rows)
+		assert.EqualError(t, err, "invalid xlsx file")
+	})
+}
+
+func TestReadSpreadsheet_CSV(t *testing.T) {
+	t.Run("should keep line numbers across empty lines", func(t *testing.T) {
+		rows, err := ReadSpreadsheet("prices.csv", []byte("\xef\xbb\xbfcommodity_code,city,price\n\nRICE01, Bogor,1200\n"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"commodity_code", "city", "price"}, nil, {"RICE01", "Bogor", "1200"}}, rows)
+	})
+
+	t.Run("should return error when the extension is not supported", func(t *testing.T) {
+		rows, err := ReadSpreadsheet("prices.xls", nil)
+
+		assert.Nil(t, rows)
+		assert.EqualError(t, err, "unsupported file type, use .csv or .xlsx")
+	})
+}
+
+func TestXLSXColumn(t *testing.T) {
+	for ref, want := range map[string]int{"A1": 0, "C4": 2, "Z9": 25, "AA10": 26, "AB12": 27, "XFD1": 16383} {
+		col, err := xlsxColumn(ref)
+
+		assert.NoError(t, err, ref)
+		assert.Equal(t, want, col, ref)
+	}
+
+	for _, ref := range []string{"", "12", "a1", "ABCD1"} {
+		_, err := xlsxColumn(ref)
+
+		assert.Error(t, err, ref)
+	}
+}